@@ -1,15 +1,40 @@
 package main
 
 import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata"
+
 	_ "github.com/go-sql-driver/mysql"
 
 	"scheduling/internal/infra/database"
 	http "scheduling/internal/infra/gin"
 	ginadapter "scheduling/internal/infra/gin/adapter"
+	"scheduling/internal/infra/gin/stdlib"
+	"scheduling/internal/infra/hashing"
+	"scheduling/internal/infra/health"
+	"scheduling/internal/infra/jwt"
 	"scheduling/internal/infra/logger"
 	"scheduling/internal/infra/middleware"
+	"scheduling/internal/infra/notification"
+	"scheduling/internal/infra/oidc"
+	"scheduling/internal/infra/server"
+	"scheduling/internal/infra/tracing"
 
+	"scheduling/internal/app/apikey"
+	"scheduling/internal/app/appointment"
+	availableslot "scheduling/internal/app/available_slot"
+	"scheduling/internal/app/location"
+	"scheduling/internal/app/resource"
+	"scheduling/internal/app/settings"
+	staffbreak "scheduling/internal/app/staff_break"
 	"scheduling/internal/app/user"
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
 	"scheduling/internal/domain/services"
 	"scheduling/internal/infra/http/handler"
 	"scheduling/internal/infra/persistence"
@@ -19,25 +44,330 @@ func main() {
 
 	logger := logger.SetupLogger();
 
+	// antes do banco, para que o driver instrumentado use o provedor global
+	tracingConfig, err := tracing.ConfigFromEnv()
+	if err != nil {
+		logger.Error("Erro ao configurar o tracing", "error", err.Error())
+		os.Exit(1)
+	}
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		logger.Error("Erro ao configurar o tracing", "error", err.Error())
+		os.Exit(1)
+	}
+
 	db := database.Connect();
+	// aplicado a cada subida; a verificação "migrations" do /readyz só passa
+	// depois disso
+	database.Migrate(db)
 
 	userRepo := persistence.NewUserMySQLRepository(db)
+	slotRepo := persistence.NewAvailableSlotMySQLRepository(db)
+	breakRepo := persistence.NewStaffBreakMySQLRepository(db)
+	appointmentRepo := persistence.NewAppointmentMySQLRepository(db)
+	serviceRepo := persistence.NewServiceMySQLRepository(db)
+	resourceRepo := persistence.NewResourceMySQLRepository(db)
+	bookingRepo := persistence.NewBookingMySQLRepository(db)
+	locationRepo := persistence.NewLocationMySQLRepository(db)
+	tenantRepo := persistence.NewTenantMySQLRepository(db)
+	settingsRepo := persistence.NewBusinessSettingsMySQLRepository(db)
+	refreshTokenRepo := persistence.NewRefreshTokenMySQLRepository(db)
+	externalIdentityRepo := persistence.NewExternalIdentityMySQLRepository(db)
+	userTokenRepo := persistence.NewUserTokenMySQLRepository(db)
+	twoFactorRepo := persistence.NewTwoFactorMySQLRepository(db)
+	auditRepo := persistence.NewAuditMySQLRepository(db)
+	apiKeyRepo := persistence.NewAPIKeyMySQLRepository(db)
+
+	hasher, err := hashing.NewHasherFromEnv()
+	if err != nil {
+		logger.Error("Erro ao configurar o hash de senhas", "error", err.Error())
+		os.Exit(1)
+	}
+
+	tokens, err := jwt.NewManagerFromEnv()
+	if err != nil {
+		logger.Error("Erro ao configurar os tokens de acesso", "error", err.Error())
+		os.Exit(1)
+	}
+
+	relyingParty, err := oidc.NewRelyingPartyFromEnv()
+	if err != nil {
+		logger.Error("Erro ao configurar os provedores de login", "error", err.Error())
+		os.Exit(1)
+	}
+
+	refreshTTL := services.DefaultRefreshTTL
+	if value := os.Getenv("JWT_REFRESH_TTL"); value != "" {
+		refreshTTL, err = time.ParseDuration(value)
+		if err != nil {
+			logger.Error("JWT_REFRESH_TTL inválido", "error", err.Error())
+			os.Exit(1)
+		}
+	}
+
+	unverifiedPolicy, err := auth.ParseUnverifiedPolicy(os.Getenv("UNVERIFIED_ACCOUNT_POLICY"))
+	if err != nil {
+		logger.Error("UNVERIFIED_ACCOUNT_POLICY inválido", "error", err.Error())
+		os.Exit(1)
+	}
+
+	mfaPolicy, err := auth.ParseMFAPolicy(os.Getenv("MFA_REQUIRED_ROLES"))
+	if err != nil {
+		logger.Error("MFA_REQUIRED_ROLES inválido", "error", err.Error())
+		os.Exit(1)
+	}
+
+	totpIssuer := os.Getenv("TOTP_ISSUER")
+	if totpIssuer == "" {
+		totpIssuer = "Scheduling"
+	}
+
+	// memory atende uma única instância; com várias, use mysql para que todas
+	// vejam as mesmas falhas
+	var loginAttemptRepo repositories.LoginAttemptRepository
+	switch store := os.Getenv("LOGIN_ATTEMPTS_STORE"); store {
+	case "", "mysql":
+		loginAttemptRepo = persistence.NewLoginAttemptMySQLRepository(db)
+	case "memory":
+		loginAttemptRepo = persistence.NewLoginAttemptMemoryRepository()
+	default:
+		logger.Error("LOGIN_ATTEMPTS_STORE inválido", "error", "valores aceitos: mysql, memory", "value", store)
+		os.Exit(1)
+	}
 
-	userService := services.NewUserService(logger, userRepo)
+	accountLinks := services.AccountLinks{
+		PasswordResetURL:     os.Getenv("PASSWORD_RESET_URL"),
+		EmailVerificationURL: os.Getenv("EMAIL_VERIFICATION_URL"),
+	}
+	if accountLinks.PasswordResetURL == "" {
+		accountLinks.PasswordResetURL = "http://localhost:3000/reset-password"
+	}
+	if accountLinks.EmailVerificationURL == "" {
+		accountLinks.EmailVerificationURL = "http://localhost:3000/verify-email"
+	}
 
-	userUseCase := user.NewCreateUserUseCase(userService)
+	notifier := notification.NewNotifierFromEnv(logger)
 
-	userHandler := handler.NewUserCreateHandler(userUseCase)
+	userService := services.NewUserService(logger, userRepo, hasher)
+	sessionService := services.NewSessionService(logger, userRepo, refreshTokenRepo, twoFactorRepo, tokens, refreshTTL, unverifiedPolicy)
+	accountService := services.NewAccountService(logger, userRepo, userTokenRepo, refreshTokenRepo, hasher, notifier, accountLinks)
+	twoFactorService := services.NewTwoFactorService(logger, userRepo, twoFactorRepo, userTokenRepo, refreshTokenRepo, mfaPolicy, totpIssuer)
+	loginThrottleService := services.NewLoginThrottleService(logger, userRepo, loginAttemptRepo, auditRepo, services.DefaultAccountThrottle, services.DefaultIPThrottle)
+	apiKeyService := services.NewAPIKeyService(logger, apiKeyRepo, auditRepo)
+	externalLoginService := services.NewExternalLoginService(logger, userRepo, externalIdentityRepo)
+	settingsService := services.NewBusinessSettingsService(logger, settingsRepo)
+	availabilityService := services.NewAvailabilityService(logger, slotRepo, breakRepo, appointmentRepo, serviceRepo, resourceRepo, locationRepo, settingsService)
+	staffBreakService := services.NewStaffBreakService(logger, slotRepo, breakRepo)
+	appointmentService := services.NewAppointmentService(logger, appointmentRepo)
+	bookingService := services.NewBookingService(logger, availabilityService, serviceRepo, resourceRepo, bookingRepo, settingsService)
+	resourceService := services.NewResourceService(logger, resourceRepo)
+	locationService := services.NewLocationService(logger, locationRepo, serviceRepo)
 
-	router := ginadapter.NewRouter()
+	userUseCase := user.NewCreateUserUseCase(userService, accountService)
+	adminUserUseCase := user.NewCreateUserByAdminUseCase(userService, accountService)
+	authUseCase := user.NewAuthUseCase(userService, sessionService, twoFactorService, loginThrottleService)
+	refreshTokenUseCase := user.NewRefreshTokenUseCase(sessionService)
+	logoutUseCase := user.NewLogoutUseCase(sessionService)
+	externalLoginUseCase := user.NewExternalLoginUseCase(externalLoginService, sessionService, twoFactorService)
+	requestPasswordResetUseCase := user.NewRequestPasswordResetUseCase(accountService)
+	resetPasswordUseCase := user.NewResetPasswordUseCase(accountService)
+	requestEmailVerificationUseCase := user.NewRequestEmailVerificationUseCase(accountService)
+	verifyEmailUseCase := user.NewVerifyEmailUseCase(accountService)
+	verifyMFAUseCase := user.NewVerifyMFAUseCase(twoFactorService, sessionService, loginThrottleService)
+	enrollTOTPUseCase := user.NewEnrollTOTPUseCase(twoFactorService)
+	confirmTOTPUseCase := user.NewConfirmTOTPUseCase(twoFactorService, sessionService)
+	disableTOTPUseCase := user.NewDisableTOTPUseCase(twoFactorService)
+	regenerateRecoveryCodesUseCase := user.NewRegenerateRecoveryCodesUseCase(twoFactorService)
+	updatePreferencesUseCase := user.NewUpdatePreferencesUseCase(userService)
+	unlockAccountUseCase := user.NewUnlockAccountUseCase(loginThrottleService)
+	unlockIPUseCase := user.NewUnlockIPUseCase(loginThrottleService)
+	createAPIKeyUseCase := apikey.NewCreateAPIKeyUseCase(apiKeyService)
+	listAPIKeysUseCase := apikey.NewListAPIKeysUseCase(apiKeyService)
+	rotateAPIKeyUseCase := apikey.NewRotateAPIKeyUseCase(apiKeyService)
+	revokeAPIKeyUseCase := apikey.NewRevokeAPIKeyUseCase(apiKeyService)
+	listAvailableSlotsUseCase := availableslot.NewListAvailableSlotsUseCase(availabilityService)
+	createStaffBreakUseCase := staffbreak.NewCreateStaffBreakUseCase(staffBreakService)
+	createAppointmentUseCase := appointment.NewCreateAppointmentUseCase(bookingService)
+	listClientAppointmentsUseCase := appointment.NewListClientAppointmentsUseCase(appointmentService)
+	createResourceUseCase := resource.NewCreateResourceUseCase(resourceService)
+	requireResourceUseCase := resource.NewRequireResourceUseCase(resourceService)
+	createLocationUseCase := location.NewCreateLocationUseCase(locationService)
+	addLocationServiceUseCase := location.NewAddLocationServiceUseCase(locationService)
+	setTravelTimeUseCase := location.NewSetTravelTimeUseCase(locationService)
+	getSettingsUseCase := settings.NewGetSettingsUseCase(settingsService)
+	updateSettingsUseCase := settings.NewUpdateSettingsUseCase(settingsService)
 
-	router.Use(middleware.TraceIDMiddleware())
+	userHandler := handler.NewUserCreateHandler(userUseCase, adminUserUseCase)
+	authHandler := handler.NewUserAuthHandler(authUseCase, refreshTokenUseCase, logoutUseCase)
+	accountHandler := handler.NewAccountHandler(requestPasswordResetUseCase, resetPasswordUseCase, requestEmailVerificationUseCase, verifyEmailUseCase)
+	twoFactorHandler := handler.NewTwoFactorHandler(verifyMFAUseCase, enrollTOTPUseCase, confirmTOTPUseCase, disableTOTPUseCase, regenerateRecoveryCodesUseCase)
+	preferencesHandler := handler.NewPreferencesHandler(updatePreferencesUseCase)
+	lockoutHandler := handler.NewLockoutHandler(unlockAccountUseCase, unlockIPUseCase)
+	apiKeyHandler := handler.NewAPIKeyHandler(createAPIKeyUseCase, listAPIKeysUseCase, rotateAPIKeyUseCase, revokeAPIKeyUseCase)
+	availableSlotHandler := handler.NewAvailableSlotListHandler(listAvailableSlotsUseCase)
+	staffBreakHandler := handler.NewStaffBreakCreateHandler(createStaffBreakUseCase)
+	appointmentHandler := handler.NewAppointmentCreateHandler(createAppointmentUseCase)
+	appointmentListHandler := handler.NewAppointmentListHandler(listClientAppointmentsUseCase)
+	resourceHandler := handler.NewResourceCreateHandler(createResourceUseCase, requireResourceUseCase)
+	locationHandler := handler.NewLocationCreateHandler(createLocationUseCase, addLocationServiceUseCase, setTravelTimeUseCase)
+	settingsHandler := handler.NewSettingsHandler(getSettingsUseCase, updateSettingsUseCase)
+	jwksHandler := handler.NewJWKSHandler(tokens)
+	oidcHandler := handler.NewOIDCHandler(relyingParty, externalLoginUseCase)
+
+	// as verificações de prontidão são registradas depois de criar o servidor
+	readiness := health.NewChecker()
+	healthHandler := handler.NewHealthHandler(readiness)
+
+	// HTTP_ROUTER escolhe o adaptador das rotas: gin (padrão) ou stdlib, o
+	// ServeMux de net/http
+	var router http.Router
+	switch name := os.Getenv("HTTP_ROUTER"); name {
+	case "", "gin":
+		router = ginadapter.NewRouter()
+	case "stdlib":
+		router = stdlib.NewRouter()
+	default:
+		logger.Error("HTTP_ROUTER inválido", "error", "valores aceitos: gin, stdlib", "value", name)
+		os.Exit(1)
+	}
+
+	router.Use(middleware.TraceIDMiddleware(), middleware.LocaleMiddleware())
 
 	router.GET("/", func(ctx http.Context) error {
 		return ctx.JSON(200, map[string]string{"message": "Alive S2!"})
 	})
 
-	router.POST("/user", userHandler.Create)
+	// fora do TenantMiddleware: o orquestrador consulta pelo IP do pod
+	router.GET("/healthz", healthHandler.Live)
+	router.GET("/readyz", healthHandler.Ready)
+	router.GET("/version", healthHandler.Version)
+
+	router.GET("/.well-known/jwks.json", jwksHandler.Get)
+	router.GET("/auth/oidc/:provider/callback", oidcHandler.Callback)
+
+	api := router.Group("")
+	api.Use(middleware.TenantMiddleware(tenantRepo, tokens, os.Getenv("TENANT_BASE_DOMAIN")))
+
+	api.POST("/user", userHandler.Create)
+	api.POST("/auth/login", authHandler.Login)
+	api.POST("/auth/refresh", authHandler.Refresh)
+	api.POST("/auth/logout", authHandler.Logout)
+	api.GET("/auth/oidc/:provider", oidcHandler.Start)
+	api.POST("/auth/password/forgot", accountHandler.RequestPasswordReset)
+	api.POST("/auth/password/reset", accountHandler.ResetPassword)
+	api.POST("/auth/email/verify", accountHandler.VerifyEmail)
+	api.POST("/auth/mfa/verify", twoFactorHandler.Verify)
+
+	// continua pública; chaves de API precisam do escopo de leitura
+	availability := api.Group("")
+	availability.Use(middleware.OptionalAuthenticate(tokens, apiKeyService), middleware.RequireScope(entities.ScopeAvailabilityRead))
+	availability.GET("/staff/:staff_id/availability", availableSlotHandler.List)
+
+	authenticated := api.Group("")
+	authenticated.Use(middleware.Authenticate(tokens, apiKeyService))
+
+	authenticated.POST("/auth/email/verification", accountHandler.RequestEmailVerification)
+	authenticated.PUT("/me/preferences", preferencesHandler.Update)
+
+	// fora de RequireMFA para que quem é obrigado possa cadastrar o autenticador
+	authenticated.POST("/auth/mfa/totp", twoFactorHandler.Enroll)
+	authenticated.POST("/auth/mfa/totp/confirm", twoFactorHandler.Confirm)
+	authenticated.DELETE("/auth/mfa/totp", twoFactorHandler.Disable)
+	authenticated.POST("/auth/mfa/recovery-codes", twoFactorHandler.RegenerateRecoveryCodes)
+
+	booking := authenticated.Group("")
+	booking.Use(
+		middleware.RequireRoleOrScope(entities.ScopeAppointmentsWrite, entities.RoleClient, entities.RoleStaff, entities.RoleAdmin),
+		middleware.RequireVerifiedEmail(unverifiedPolicy),
+		middleware.RequireMFA(mfaPolicy),
+	)
+	booking.POST("/appointments", appointmentHandler.Create)
+
+	clients := authenticated.Group("")
+	clients.Use(middleware.RequireClient(), middleware.RequireVerifiedEmail(unverifiedPolicy), middleware.RequireMFA(mfaPolicy))
+
+	ownClient := clients.Group("/clients/:client_id")
+	ownClient.Use(middleware.RequireOwnerOrRole("client_id", entities.RoleStaff, entities.RoleAdmin))
+	ownClient.GET("/appointments", appointmentListHandler.ListByClient)
+
+	ownStaff := authenticated.Group("/staff/:staff_id")
+	ownStaff.Use(middleware.RequireStaff(), middleware.RequireMFA(mfaPolicy), middleware.RequireOwnerOrRole("staff_id", entities.RoleAdmin))
+	ownStaff.POST("/breaks", staffBreakHandler.Create)
+
+	manageServices := authenticated.Group("")
+	manageServices.Use(middleware.RequireRoleOrScope(entities.ScopeServicesManage, entities.RoleAdmin), middleware.RequireMFA(mfaPolicy))
+	manageServices.POST("/services/:service_id/resources", resourceHandler.Require)
+	manageServices.POST("/locations/:location_id/services", locationHandler.AddService)
+
+	admin := authenticated.Group("")
+	admin.Use(middleware.RequireAdmin(), middleware.RequireMFA(mfaPolicy))
+
+	admin.POST("/resources", resourceHandler.Create)
+
+	admin.POST("/locations", locationHandler.Create)
+	admin.POST("/locations/:location_id/travel-times", locationHandler.SetTravelTime)
+
+	admin.POST("/admin/users", userHandler.CreateByAdmin)
+	admin.DELETE("/admin/users/:user_id/lockout", lockoutHandler.UnlockAccount)
+	admin.DELETE("/admin/ip-lockouts/:ip", lockoutHandler.UnlockIP)
+
+	admin.POST("/admin/api-keys", apiKeyHandler.Create)
+	admin.GET("/admin/api-keys", apiKeyHandler.List)
+	admin.POST("/admin/api-keys/:key_id/rotate", apiKeyHandler.Rotate)
+	admin.DELETE("/admin/api-keys/:key_id", apiKeyHandler.Revoke)
+
+	admin.GET("/admin/settings", settingsHandler.Get)
+	admin.PUT("/admin/settings", settingsHandler.Update)
+
+	serverConfig, err := server.ConfigFromEnv()
+	if err != nil {
+		logger.Error("Erro ao configurar o servidor HTTP", "error", err.Error())
+		os.Exit(1)
+	}
+
+	srv := server.New(logger, router, serverConfig)
+	// registrado primeiro para rodar por último e enviar também os spans do
+	// encerramento
+	srv.OnShutdown("tracing", shutdownTracing)
+	srv.OnShutdown("database", func(ctx context.Context) error {
+		return db.Close()
+	})
+
+	// tempo 0 usa health.DefaultTimeout
+	readiness.Add("database", 0, db.PingContext)
+	// confirma o schema aplicado por database.Migrate no início do main
+	readiness.Add("migrations", 0, func(ctx context.Context) error {
+		return database.CheckMigrations(ctx, db)
+	})
+	readiness.Add("workers", 0, srv.CheckWorkers)
+	readiness.Add("server", 0, srv.CheckServing)
+
+	// SIGHUP relê JWT_KEYS_DIR, permitindo rotacionar as chaves sem reiniciar
+	srv.Go("jwt-keys-reload", func(ctx context.Context) error {
+		reloadKeys := make(chan os.Signal, 1)
+		signal.Notify(reloadKeys, syscall.SIGHUP)
+		defer signal.Stop(reloadKeys)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-reloadKeys:
+				if err := tokens.Reload(); err != nil {
+					logger.Error("Erro ao recarregar as chaves de assinatura", "error", err.Error())
+					continue
+				}
+				logger.Info("Chaves de assinatura recarregadas")
+			}
+		}
+	})
+
+	// SIGINT e SIGTERM iniciam o encerramento gracioso
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	router.Run(":8080")
+	if err := srv.Run(ctx); err != nil {
+		logger.Error("Erro no servidor HTTP", "error", err.Error())
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,33 @@
+package apikey
+
+import (
+	"context"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/services"
+)
+
+type CreateAPIKeyUseCase struct {
+	APIKeyService *services.APIKeyService
+}
+
+func NewCreateAPIKeyUseCase(apiKeyService *services.APIKeyService) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{APIKeyService: apiKeyService}
+}
+
+func (useCase *CreateAPIKeyUseCase) Execute(ctx context.Context, input APIKeyInput) (*IssuedAPIKeyOutput, error) {
+	ctx, span := tracer.Start(ctx, "CreateAPIKeyUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	issued, err := useCase.APIKeyService.Create(ctx, principal.UserID, input.Name, input.Scopes, input.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIssuedAPIKeyOutput(issued), nil
+}
@@ -0,0 +1,54 @@
+package apikey
+
+import (
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/services"
+)
+
+type APIKeyInput struct {
+	Name   string   `json:"name" validate:"required,max=100"`
+	Scopes []string `json:"scopes" validate:"min=1"`
+	IP     string   `json:"-"`
+}
+
+type APIKeyActionInput struct {
+	ID int
+	IP string
+}
+
+type APIKeyOutput struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// IssuedAPIKeyOutput traz a chave completa, exibida só na criação e na
+// rotação.
+type IssuedAPIKeyOutput struct {
+	APIKeyOutput
+	Key string `json:"key"`
+}
+
+func NewAPIKeyOutput(key *entities.APIKey) APIKeyOutput {
+	return APIKeyOutput{
+		ID:         key.ID(),
+		Name:       key.Name(),
+		Prefix:     key.Prefix(),
+		Scopes:     key.Scopes(),
+		ExpiresAt:  key.ExpiresAt(),
+		LastUsedAt: key.LastUsedAt(),
+		RevokedAt:  key.RevokedAt(),
+		CreatedAt:  key.CreatedAt(),
+	}
+}
+
+func newIssuedAPIKeyOutput(issued *services.IssuedAPIKey) *IssuedAPIKeyOutput {
+	return &IssuedAPIKeyOutput{APIKeyOutput: NewAPIKeyOutput(issued.Key), Key: issued.Secret}
+}
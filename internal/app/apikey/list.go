@@ -0,0 +1,32 @@
+package apikey
+
+import (
+	"context"
+
+	"scheduling/internal/domain/services"
+)
+
+type ListAPIKeysUseCase struct {
+	APIKeyService *services.APIKeyService
+}
+
+func NewListAPIKeysUseCase(apiKeyService *services.APIKeyService) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{APIKeyService: apiKeyService}
+}
+
+func (useCase *ListAPIKeysUseCase) Execute(ctx context.Context) ([]APIKeyOutput, error) {
+	ctx, span := tracer.Start(ctx, "ListAPIKeysUseCase.Execute")
+	defer span.End()
+
+	keys, err := useCase.APIKeyService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]APIKeyOutput, 0, len(keys))
+	for _, key := range keys {
+		output = append(output, NewAPIKeyOutput(key))
+	}
+
+	return output, nil
+}
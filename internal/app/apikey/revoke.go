@@ -0,0 +1,55 @@
+package apikey
+
+import (
+	"context"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/services"
+)
+
+// RotateAPIKeyUseCase emite uma nova chave no lugar de outra, que ainda vale
+// durante o prazo de transição.
+type RotateAPIKeyUseCase struct {
+	APIKeyService *services.APIKeyService
+}
+
+func NewRotateAPIKeyUseCase(apiKeyService *services.APIKeyService) *RotateAPIKeyUseCase {
+	return &RotateAPIKeyUseCase{APIKeyService: apiKeyService}
+}
+
+func (useCase *RotateAPIKeyUseCase) Execute(ctx context.Context, input APIKeyActionInput) (*IssuedAPIKeyOutput, error) {
+	ctx, span := tracer.Start(ctx, "RotateAPIKeyUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	issued, err := useCase.APIKeyService.Rotate(ctx, principal.UserID, input.ID, input.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	return newIssuedAPIKeyOutput(issued), nil
+}
+
+type RevokeAPIKeyUseCase struct {
+	APIKeyService *services.APIKeyService
+}
+
+func NewRevokeAPIKeyUseCase(apiKeyService *services.APIKeyService) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{APIKeyService: apiKeyService}
+}
+
+func (useCase *RevokeAPIKeyUseCase) Execute(ctx context.Context, input APIKeyActionInput) error {
+	ctx, span := tracer.Start(ctx, "RevokeAPIKeyUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.ErrUnauthenticated
+	}
+
+	return useCase.APIKeyService.Revoke(ctx, principal.UserID, input.ID, input.IP)
+}
@@ -0,0 +1,5 @@
+package apikey
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("scheduling/internal/app/apikey")
@@ -0,0 +1,56 @@
+package appointment
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/services"
+)
+
+type CreateAppointmentUseCase struct {
+	BookingService *services.BookingService
+}
+
+func NewCreateAppointmentUseCase(
+	bookingService *services.BookingService,
+) *CreateAppointmentUseCase {
+	return &CreateAppointmentUseCase{
+		BookingService: bookingService,
+	}
+}
+
+func (useCase *CreateAppointmentUseCase) Execute(ctx context.Context, input AppointmentInput) (*AppointmentOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "CreateAppointmentUseCase.Execute")
+	defer span.End()
+
+	// clientes só agendam para si; profissionais, administradores e
+	// integrações com o escopo de agendamento podem agendar em nome de
+	// qualquer cliente
+	if principal, ok := auth.FromContext(ctx); ok &&
+		!principal.HasScope(entities.ScopeAppointmentsWrite) &&
+		!principal.CanActFor(input.ClientID, entities.RoleStaff, entities.RoleAdmin) {
+		return nil, auth.ErrForbidden
+	}
+
+	scheduledAt, err := time.Parse(time.RFC3339, input.ScheduledAt)
+	if err != nil {
+		return nil, errs.Validation("appointment.invalid_date").With("value", input.ScheduledAt)
+	}
+
+	appointment, err := entities.NewAppointment(input.ClientID, input.StaffID, input.ServiceID, scheduledAt)
+	if err != nil {
+		return nil, err
+	}
+	appointment.SetLocationID(input.LocationID)
+
+	err = useCase.BookingService.Book(ctx, appointment)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAppointmentOutput(appointment), nil
+}
@@ -7,10 +7,11 @@ import (
 
 type AppointmentInput struct {
 	AppointmentID int    `json:"appointment_id,omitempty"`
-	ClientID      int    `json:"client_id"`
-	StaffID       int    `json:"staff_id"`
-	ServiceID     int    `json:"service_id"`
-	ScheduledAt   string `json:"scheduled_at"`
+	ClientID      int    `json:"client_id" validate:"required"`
+	StaffID       int    `json:"staff_id" validate:"required"`
+	ServiceID     int    `json:"service_id" validate:"required"`
+	LocationID    int    `json:"location_id,omitempty"`
+	ScheduledAt   string `json:"scheduled_at" validate:"required"`
 }
 
 type AppointmentOutput struct {
@@ -18,6 +19,7 @@ type AppointmentOutput struct {
 	ClientID    int       `json:"client_id"`
 	StaffID     int       `json:"staff_id"`
 	ServiceID   int       `json:"service_id"`
+	LocationID  int       `json:"location_id,omitempty"`
 	ScheduledAt time.Time `json:"scheduled_at"`
 	Status      string    `json:"status"`
 	CreatedAt   time.Time `json:"created_at"`
@@ -29,6 +31,7 @@ func NewAppointmentOutput(appointment *entities.Appointment) *AppointmentOutput
 		ClientID:    appointment.ClientID(),
 		StaffID:     appointment.StaffID(),
 		ServiceID:   appointment.ServiceID(),
+		LocationID:  appointment.LocationID(),
 		ScheduledAt: appointment.ScheduledAt(),
 		Status:      string(appointment.Status()),
 		CreatedAt:   appointment.CreatedAt(),
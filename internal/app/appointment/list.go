@@ -0,0 +1,37 @@
+package appointment
+
+import (
+	"context"
+
+	"scheduling/internal/domain/services"
+)
+
+type ListClientAppointmentsUseCase struct {
+	AppointmentService *services.AppointmentService
+}
+
+func NewListClientAppointmentsUseCase(
+	appointmentService *services.AppointmentService,
+) *ListClientAppointmentsUseCase {
+	return &ListClientAppointmentsUseCase{
+		AppointmentService: appointmentService,
+	}
+}
+
+func (useCase *ListClientAppointmentsUseCase) Execute(ctx context.Context, clientID int) ([]*AppointmentOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "ListClientAppointmentsUseCase.Execute")
+	defer span.End()
+
+	appointments, err := useCase.AppointmentService.ListByClient(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]*AppointmentOutput, 0, len(appointments))
+	for _, appointment := range appointments {
+		output = append(output, NewAppointmentOutput(appointment))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,5 @@
+package appointment
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("scheduling/internal/app/appointment")
@@ -3,10 +3,12 @@ package availableslot
 import "time"
 
 type AvailableSlotsInput struct {
-	StaffID int       `json:"staff_id"`
-	Date    time.Time `json:"date"`
+	StaffID    int       `json:"staff_id"`
+	ServiceID  int       `json:"service_id"`
+	LocationID int       `json:"location_id,omitempty"`
+	Date       time.Time `json:"date"`
 }
 
 type AvailableSlotOutput struct {
 	Time time.Time `json:"time"`
-}
\ No newline at end of file
+}
@@ -0,0 +1,36 @@
+package availableslot
+
+import (
+	"context"
+	"scheduling/internal/domain/services"
+)
+
+type ListAvailableSlotsUseCase struct {
+	AvailabilityService *services.AvailabilityService
+}
+
+func NewListAvailableSlotsUseCase(
+	availabilityService *services.AvailabilityService,
+) *ListAvailableSlotsUseCase {
+	return &ListAvailableSlotsUseCase{
+		AvailabilityService: availabilityService,
+	}
+}
+
+func (useCase *ListAvailableSlotsUseCase) Execute(ctx context.Context, input AvailableSlotsInput) ([]AvailableSlotOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "ListAvailableSlotsUseCase.Execute")
+	defer span.End()
+
+	times, err := useCase.AvailabilityService.AvailableTimes(ctx, input.StaffID, input.ServiceID, input.LocationID, input.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]AvailableSlotOutput, 0, len(times))
+	for _, t := range times {
+		output = append(output, AvailableSlotOutput{Time: t})
+	}
+
+	return output, nil
+}
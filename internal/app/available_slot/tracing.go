@@ -0,0 +1,5 @@
+package availableslot
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("scheduling/internal/app/available_slot")
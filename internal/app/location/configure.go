@@ -0,0 +1,47 @@
+package location
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/services"
+)
+
+type AddLocationServiceUseCase struct {
+	LocationService *services.LocationService
+}
+
+func NewAddLocationServiceUseCase(
+	locationService *services.LocationService,
+) *AddLocationServiceUseCase {
+	return &AddLocationServiceUseCase{
+		LocationService: locationService,
+	}
+}
+
+func (useCase *AddLocationServiceUseCase) Execute(ctx context.Context, input LocationServiceInput) error {
+	ctx, span := tracer.Start(ctx, "AddLocationServiceUseCase.Execute")
+	defer span.End()
+
+	return useCase.LocationService.AddService(ctx, input.LocationID, input.ServiceID)
+}
+
+type SetTravelTimeUseCase struct {
+	LocationService *services.LocationService
+}
+
+func NewSetTravelTimeUseCase(
+	locationService *services.LocationService,
+) *SetTravelTimeUseCase {
+	return &SetTravelTimeUseCase{
+		LocationService: locationService,
+	}
+}
+
+func (useCase *SetTravelTimeUseCase) Execute(ctx context.Context, input TravelTimeInput) error {
+	ctx, span := tracer.Start(ctx, "SetTravelTimeUseCase.Execute")
+	defer span.End()
+
+	travel := time.Duration(input.Minutes) * time.Minute
+	return useCase.LocationService.SetTravelTime(ctx, input.FromLocationID, input.ToLocationID, travel)
+}
@@ -0,0 +1,38 @@
+package location
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/services"
+)
+
+type CreateLocationUseCase struct {
+	LocationService *services.LocationService
+}
+
+func NewCreateLocationUseCase(
+	locationService *services.LocationService,
+) *CreateLocationUseCase {
+	return &CreateLocationUseCase{
+		LocationService: locationService,
+	}
+}
+
+func (useCase *CreateLocationUseCase) Execute(ctx context.Context, input LocationInput) (*LocationOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "CreateLocationUseCase.Execute")
+	defer span.End()
+
+	location, err := entities.NewLocation(0, input.Name, input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = useCase.LocationService.Create(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLocationOutput(location), nil
+}
@@ -0,0 +1,39 @@
+package location
+
+import (
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type LocationInput struct {
+	Name    string `json:"name" validate:"required,max=255"`
+	Address string `json:"address"`
+}
+
+type LocationOutput struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewLocationOutput(location *entities.Location) *LocationOutput {
+	return &LocationOutput{
+		ID:        location.ID(),
+		Name:      location.Name(),
+		Address:   location.Address(),
+		CreatedAt: location.CreatedAt(),
+	}
+}
+
+type LocationServiceInput struct {
+	LocationID int `json:"location_id"`
+	ServiceID  int `json:"service_id" validate:"required"`
+}
+
+type TravelTimeInput struct {
+	FromLocationID int `json:"from_location_id"`
+	ToLocationID   int `json:"to_location_id" validate:"required"`
+	Minutes        int `json:"minutes" validate:"gte=0"`
+}
@@ -0,0 +1,38 @@
+package resource
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/services"
+)
+
+type CreateResourceUseCase struct {
+	ResourceService *services.ResourceService
+}
+
+func NewCreateResourceUseCase(
+	resourceService *services.ResourceService,
+) *CreateResourceUseCase {
+	return &CreateResourceUseCase{
+		ResourceService: resourceService,
+	}
+}
+
+func (useCase *CreateResourceUseCase) Execute(ctx context.Context, input ResourceInput) (*ResourceOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "CreateResourceUseCase.Execute")
+	defer span.End()
+
+	resource, err := entities.NewResource(0, input.Name, input.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = useCase.ResourceService.Create(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResourceOutput(resource), nil
+}
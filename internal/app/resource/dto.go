@@ -0,0 +1,48 @@
+package resource
+
+import (
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type ResourceInput struct {
+	Name     string `json:"name" validate:"required,max=255"`
+	Capacity int    `json:"capacity" validate:"gt=0"`
+}
+
+type ResourceOutput struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Capacity  int       `json:"capacity"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewResourceOutput(resource *entities.Resource) *ResourceOutput {
+	return &ResourceOutput{
+		ID:        resource.ID(),
+		Name:      resource.Name(),
+		Capacity:  resource.Capacity(),
+		CreatedAt: resource.CreatedAt(),
+	}
+}
+
+type RequirementInput struct {
+	ServiceID  int `json:"service_id"`
+	ResourceID int `json:"resource_id" validate:"required"`
+	Quantity   int `json:"quantity" validate:"gt=0"`
+}
+
+type RequirementOutput struct {
+	ServiceID  int `json:"service_id"`
+	ResourceID int `json:"resource_id"`
+	Quantity   int `json:"quantity"`
+}
+
+func NewRequirementOutput(requirement *entities.ResourceRequirement) *RequirementOutput {
+	return &RequirementOutput{
+		ServiceID:  requirement.ServiceID(),
+		ResourceID: requirement.ResourceID(),
+		Quantity:   requirement.Quantity(),
+	}
+}
@@ -0,0 +1,38 @@
+package resource
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/services"
+)
+
+type RequireResourceUseCase struct {
+	ResourceService *services.ResourceService
+}
+
+func NewRequireResourceUseCase(
+	resourceService *services.ResourceService,
+) *RequireResourceUseCase {
+	return &RequireResourceUseCase{
+		ResourceService: resourceService,
+	}
+}
+
+func (useCase *RequireResourceUseCase) Execute(ctx context.Context, input RequirementInput) (*RequirementOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "RequireResourceUseCase.Execute")
+	defer span.End()
+
+	requirement, err := entities.NewResourceRequirement(input.ServiceID, input.ResourceID, input.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	err = useCase.ResourceService.Require(ctx, requirement)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRequirementOutput(requirement), nil
+}
@@ -0,0 +1,40 @@
+package settings
+
+import (
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+// SettingsInput aceita atualização parcial: campos ausentes mantêm o valor
+// atual do negócio.
+type SettingsInput struct {
+	SlotStepMinutes           *int    `json:"slot_step_minutes" validate:"omitnil,min=1,max=240"`
+	Timezone                  *string `json:"timezone" validate:"omitnil,timezone"`
+	Currency                  *string `json:"currency" validate:"omitnil,len=3"`
+	CancellationNoticeMinutes *int    `json:"cancellation_notice_minutes" validate:"omitnil,gte=0"`
+	BookingHorizonDays        *int    `json:"booking_horizon_days" validate:"omitnil,min=1,max=730"`
+	BrandingName              *string `json:"branding_name" validate:"omitnil,max=100"`
+}
+
+type SettingsOutput struct {
+	SlotStepMinutes           int       `json:"slot_step_minutes"`
+	Timezone                  string    `json:"timezone"`
+	Currency                  string    `json:"currency"`
+	CancellationNoticeMinutes int       `json:"cancellation_notice_minutes"`
+	BookingHorizonDays        int       `json:"booking_horizon_days"`
+	BrandingName              string    `json:"branding_name"`
+	UpdatedAt                 time.Time `json:"updated_at"`
+}
+
+func NewSettingsOutput(settings *entities.BusinessSettings) *SettingsOutput {
+	return &SettingsOutput{
+		SlotStepMinutes:           int(settings.SlotStep() / time.Minute),
+		Timezone:                  settings.Timezone().String(),
+		Currency:                  settings.Currency(),
+		CancellationNoticeMinutes: int(settings.CancellationNotice() / time.Minute),
+		BookingHorizonDays:        settings.BookingHorizonDays(),
+		BrandingName:              settings.BrandingName(),
+		UpdatedAt:                 settings.UpdatedAt(),
+	}
+}
@@ -0,0 +1,32 @@
+package settings
+
+import (
+	"context"
+
+	"scheduling/internal/domain/services"
+)
+
+type GetSettingsUseCase struct {
+	SettingsService *services.BusinessSettingsService
+}
+
+func NewGetSettingsUseCase(
+	settingsService *services.BusinessSettingsService,
+) *GetSettingsUseCase {
+	return &GetSettingsUseCase{
+		SettingsService: settingsService,
+	}
+}
+
+func (useCase *GetSettingsUseCase) Execute(ctx context.Context) (*SettingsOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "GetSettingsUseCase.Execute")
+	defer span.End()
+
+	settings, err := useCase.SettingsService.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSettingsOutput(settings), nil
+}
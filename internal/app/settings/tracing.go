@@ -0,0 +1,5 @@
+package settings
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("scheduling/internal/app/settings")
@@ -0,0 +1,70 @@
+package settings
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/services"
+)
+
+type UpdateSettingsUseCase struct {
+	SettingsService *services.BusinessSettingsService
+}
+
+func NewUpdateSettingsUseCase(
+	settingsService *services.BusinessSettingsService,
+) *UpdateSettingsUseCase {
+	return &UpdateSettingsUseCase{
+		SettingsService: settingsService,
+	}
+}
+
+func (useCase *UpdateSettingsUseCase) Execute(ctx context.Context, input SettingsInput) (*SettingsOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "UpdateSettingsUseCase.Execute")
+	defer span.End()
+
+	current, err := useCase.SettingsService.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := NewSettingsOutput(current)
+	if input.SlotStepMinutes != nil {
+		merged.SlotStepMinutes = *input.SlotStepMinutes
+	}
+	if input.Timezone != nil {
+		merged.Timezone = *input.Timezone
+	}
+	if input.Currency != nil {
+		merged.Currency = *input.Currency
+	}
+	if input.CancellationNoticeMinutes != nil {
+		merged.CancellationNoticeMinutes = *input.CancellationNoticeMinutes
+	}
+	if input.BookingHorizonDays != nil {
+		merged.BookingHorizonDays = *input.BookingHorizonDays
+	}
+	if input.BrandingName != nil {
+		merged.BrandingName = *input.BrandingName
+	}
+
+	settings, err := entities.NewBusinessSettings(
+		merged.SlotStepMinutes,
+		merged.Timezone,
+		merged.Currency,
+		merged.CancellationNoticeMinutes,
+		merged.BookingHorizonDays,
+		merged.BrandingName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = useCase.SettingsService.Update(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSettingsOutput(settings), nil
+}
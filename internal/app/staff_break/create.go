@@ -0,0 +1,58 @@
+package staffbreak
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/services"
+)
+
+const clockLayout = "15:04"
+
+type CreateStaffBreakUseCase struct {
+	StaffBreakService *services.StaffBreakService
+}
+
+func NewCreateStaffBreakUseCase(
+	staffBreakService *services.StaffBreakService,
+) *CreateStaffBreakUseCase {
+	return &CreateStaffBreakUseCase{
+		StaffBreakService: staffBreakService,
+	}
+}
+
+func (useCase *CreateStaffBreakUseCase) Execute(ctx context.Context, input StaffBreakInput) (*StaffBreakOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "CreateStaffBreakUseCase.Execute")
+	defer span.End()
+
+	start, err := time.Parse(clockLayout, input.StartTime)
+	if err != nil {
+		return nil, errs.Validation("staff_break.invalid_start_time").With("value", input.StartTime)
+	}
+
+	end, err := time.Parse(clockLayout, input.EndTime)
+	if err != nil {
+		return nil, errs.Validation("staff_break.invalid_end_time").With("value", input.EndTime)
+	}
+
+	staffBreak, err := entities.NewStaffBreak(
+		input.StaffID,
+		entities.Weekday(input.Weekday),
+		start,
+		end,
+		input.DurationMinutes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = useCase.StaffBreakService.Create(ctx, staffBreak)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStaffBreakOutput(staffBreak), nil
+}
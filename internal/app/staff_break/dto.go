@@ -0,0 +1,35 @@
+package staffbreak
+
+import (
+	"scheduling/internal/domain/entities"
+)
+
+type StaffBreakInput struct {
+	StaffID         int    `json:"staff_id"`
+	Weekday         string `json:"weekday" validate:"required"`
+	StartTime       string `json:"start_time" validate:"required"`
+	EndTime         string `json:"end_time" validate:"required"`
+	DurationMinutes int    `json:"duration_minutes" validate:"gte=0"`
+}
+
+type StaffBreakOutput struct {
+	ID              int    `json:"id"`
+	StaffID         int    `json:"staff_id"`
+	Weekday         string `json:"weekday"`
+	StartTime       string `json:"start_time"`
+	EndTime         string `json:"end_time"`
+	DurationMinutes int    `json:"duration_minutes"`
+	Floating        bool   `json:"floating"`
+}
+
+func NewStaffBreakOutput(staffBreak *entities.StaffBreak) *StaffBreakOutput {
+	return &StaffBreakOutput{
+		ID:              staffBreak.ID(),
+		StaffID:         staffBreak.StaffID(),
+		Weekday:         string(staffBreak.Weekday()),
+		StartTime:       staffBreak.StartTime().Format(clockLayout),
+		EndTime:         staffBreak.EndTime().Format(clockLayout),
+		DurationMinutes: staffBreak.DurationMinutes(),
+		Floating:        staffBreak.IsFloating(),
+	}
+}
@@ -0,0 +1,5 @@
+package staffbreak
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("scheduling/internal/app/staff_break")
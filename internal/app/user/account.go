@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/services"
+)
+
+type RequestPasswordResetUseCase struct {
+	AccountService *services.AccountService
+}
+
+func NewRequestPasswordResetUseCase(accountService *services.AccountService) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{AccountService: accountService}
+}
+
+func (useCase RequestPasswordResetUseCase) Execute(ctx context.Context, input PasswordResetRequestInput) error {
+	ctx, span := tracer.Start(ctx, "RequestPasswordResetUseCase.Execute")
+	defer span.End()
+
+	return useCase.AccountService.RequestPasswordReset(ctx, input.Email)
+}
+
+type ResetPasswordUseCase struct {
+	AccountService *services.AccountService
+}
+
+func NewResetPasswordUseCase(accountService *services.AccountService) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{AccountService: accountService}
+}
+
+func (useCase ResetPasswordUseCase) Execute(ctx context.Context, input PasswordResetInput) error {
+	ctx, span := tracer.Start(ctx, "ResetPasswordUseCase.Execute")
+	defer span.End()
+
+	return useCase.AccountService.ResetPassword(ctx, input.Token, input.Password)
+}
+
+// RequestEmailVerificationUseCase reenvia o link de verificação para o
+// usuário autenticado.
+type RequestEmailVerificationUseCase struct {
+	AccountService *services.AccountService
+}
+
+func NewRequestEmailVerificationUseCase(accountService *services.AccountService) *RequestEmailVerificationUseCase {
+	return &RequestEmailVerificationUseCase{AccountService: accountService}
+}
+
+func (useCase RequestEmailVerificationUseCase) Execute(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "RequestEmailVerificationUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.ErrUnauthenticated
+	}
+
+	return useCase.AccountService.RequestEmailVerification(ctx, principal.UserID)
+}
+
+type VerifyEmailUseCase struct {
+	AccountService *services.AccountService
+}
+
+func NewVerifyEmailUseCase(accountService *services.AccountService) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{AccountService: accountService}
+}
+
+func (useCase VerifyEmailUseCase) Execute(ctx context.Context, input VerifyEmailInput) error {
+	ctx, span := tracer.Start(ctx, "VerifyEmailUseCase.Execute")
+	defer span.End()
+
+	return useCase.AccountService.VerifyEmail(ctx, input.Token)
+}
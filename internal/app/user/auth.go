@@ -2,56 +2,71 @@ package user
 
 import (
 	"context"
-	"fmt"
-	"scheduling/internal/domain/entities"
+	"errors"
 	"scheduling/internal/domain/services"
-	"scheduling/internal/infra/jwt"
 )
 
 
 type AuthUseCase struct {
-	UserService *services.UserService
+	UserService          *services.UserService
+	SessionService       *services.SessionService
+	TwoFactorService     *services.TwoFactorService
+	LoginThrottleService *services.LoginThrottleService
 }
 
 func NewAuthUseCase(
 	userSerivce *services.UserService,
+	sessionService *services.SessionService,
+	twoFactorService *services.TwoFactorService,
+	loginThrottleService *services.LoginThrottleService,
 ) *AuthUseCase {
 	return &AuthUseCase{
-		UserService: userSerivce,
+		UserService:          userSerivce,
+		SessionService:       sessionService,
+		TwoFactorService:     twoFactorService,
+		LoginThrottleService: loginThrottleService,
 	}
 }
 
 func (useCase AuthUseCase) Execute(ctx context.Context, input UserAuthInput) (*UserAuthOutput, error) {
 
-	user, err := entities.NewUser(
-		0,
-		"",
-		input.Email,
-		input.Password,
-		"",
-	)
+	ctx, span := tracer.Start(ctx, "AuthUseCase.Execute")
+	defer span.End()
 
-	if err != nil {
+	if err := useCase.LoginThrottleService.Check(ctx, input.Email, input.IP); err != nil {
 		return nil, err
 	}
 
-	ok, err := useCase.UserService.Authentication(ctx, *user)
+	user, err := useCase.UserService.Authentication(ctx, input.Email, input.Password)
+	if errors.Is(err, services.ErrInvalidCredentials) {
+		if recordErr := useCase.LoginThrottleService.RecordFailure(ctx, input.Email, input.IP); recordErr != nil {
+			return nil, recordErr
+		}
+		return nil, err
+	}
 	if err != nil {
 		return  nil, err
 	}
 
-	if !ok {
-		return &UserAuthOutput{
-			Token: "",
-		}, nil
+	// com a verificação em duas etapas as falhas só são zeradas depois do
+	// código (ver VerifyMFAUseCase); zerar aqui deixaria quem sabe a senha
+	// tentar códigos sem nunca chegar ao bloqueio
+	challenge, err := useCase.TwoFactorService.Challenge(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != "" {
+		return &UserAuthOutput{MFARequired: true, MFAToken: challenge}, nil
+	}
+
+	if err := useCase.LoginThrottleService.RecordSuccess(ctx, input.Email); err != nil {
+		return nil, err
 	}
 
-	token, err := jwt.CreateToken(user.Name())
+	session, err := useCase.SessionService.Start(ctx, user)
 	if err != nil {
-		return  nil, fmt.Errorf("Erro ao criar o token de autenticação %s", err.Error())
+		return  nil, err
 	}
 
-	return &UserAuthOutput{
-		Token: token,
-	}, nil
+	return toUserAuthOutput(session), nil
 }
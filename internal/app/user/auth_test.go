@@ -0,0 +1,143 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/services"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/valueobject"
+)
+
+// memoryTokens guarda os desafios de verificação em duas etapas em memória.
+func memoryTokens() *mocks.MockUserTokenRepository {
+	stored := map[int]*entities.UserToken{}
+	repo := mocks.NewMockUserTokenRepository()
+
+	repo.CreateFunc = func(ctx context.Context, t *entities.UserToken) error {
+		t.SetID(len(stored) + 1)
+		stored[t.ID()] = t
+		return nil
+	}
+	repo.FindByHashFunc = func(ctx context.Context, purpose, hash string) (*entities.UserToken, error) {
+		for _, t := range stored {
+			if t.Purpose() == purpose && t.TokenHash() == hash {
+				return t, nil
+			}
+		}
+		return nil, nil
+	}
+	repo.ConsumeFunc = func(ctx context.Context, id int) (bool, error) {
+		t, ok := stored[id]
+		if !ok || t.IsUsed() {
+			return false, nil
+		}
+		now := time.Now()
+		stored[id] = entities.RebuildUserToken(t.ID(), t.UserID(), t.Purpose(), t.TokenHash(), t.ExpiresAt(), &now, t.CreatedAt())
+		return true, nil
+	}
+
+	return repo
+}
+
+// memoryAttempts conta as falhas de login em memória, sem separar tenants.
+func memoryAttempts() *mocks.MockLoginAttemptRepository {
+	type record struct {
+		failures    int
+		lockedUntil *time.Time
+	}
+	records := map[string]*record{}
+	repo := mocks.NewMockLoginAttemptRepository()
+
+	repo.FindFunc = func(ctx context.Context, scope, key string) (*entities.LoginAttempts, error) {
+		r, ok := records[scope+"|"+key]
+		if !ok {
+			return nil, nil
+		}
+		return entities.RebuildLoginAttempts(scope, key, r.failures, time.Now(), r.lockedUntil), nil
+	}
+	repo.RecordFailureFunc = func(ctx context.Context, scope, key string, at, since time.Time) (int, error) {
+		r, ok := records[scope+"|"+key]
+		if !ok {
+			r = &record{}
+			records[scope+"|"+key] = r
+		}
+		r.failures++
+		return r.failures, nil
+	}
+	repo.LockFunc = func(ctx context.Context, scope, key string, until time.Time) error {
+		records[scope+"|"+key].lockedUntil = &until
+		return nil
+	}
+	repo.ResetFunc = func(ctx context.Context, scope, key string) error {
+		delete(records, scope+"|"+key)
+		return nil
+	}
+
+	return repo
+}
+
+func TestVerifyMFAUseCase_WrongCodesLockAccount(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	email, _ := valueobject.NewEmail("ana@example.com")
+	stored := entities.RebuildUser(9, "Ana", email, entities.RoleClient)
+	stored.SetPasswordHash("hash:segredo123")
+
+	users := mocks.NewMockUserRepository()
+	users.FindByEmailFunc = func(ctx context.Context, email string) (entities.User, error) {
+		return *stored, nil
+	}
+	users.FindByIDFunc = func(ctx context.Context, id int) (*entities.User, error) {
+		user := *stored
+		return &user, nil
+	}
+
+	enabledAt := time.Now()
+	twoFactorRepo := mocks.NewMockTwoFactorRepository()
+	twoFactorRepo.FindByUserIDFunc = func(ctx context.Context, userID int) (*entities.TOTPCredential, error) {
+		return entities.RebuildTOTPCredential(userID, "JBSWY3DPEHPK3PXP", &enabledAt, 0, enabledAt), nil
+	}
+
+	tokens := memoryTokens()
+	refreshRepo := mocks.NewMockRefreshTokenRepository()
+	accountRule := services.ThrottleRule{MaxFailures: 3, LockoutDuration: time.Hour, Window: time.Hour}
+
+	userService := services.NewUserService(logger, users, plainHasher{})
+	twoFactorService := services.NewTwoFactorService(logger, users, twoFactorRepo, tokens, refreshRepo, auth.MFAPolicy{}, "Agenda")
+	sessionService := services.NewSessionService(logger, users, refreshRepo, twoFactorRepo, nil, time.Hour, auth.UnverifiedAllow)
+	throttle := services.NewLoginThrottleService(logger, users, memoryAttempts(), mocks.NewMockAuditRepository(), accountRule, services.DefaultIPThrottle)
+
+	login := NewAuthUseCase(userService, sessionService, twoFactorService, throttle)
+	verify := NewVerifyMFAUseCase(twoFactorService, sessionService, throttle)
+
+	// cada tentativa volta à senha para obter um desafio novo
+	for i := 0; i < accountRule.MaxFailures; i++ {
+		output, err := login.Execute(ctx, UserAuthInput{Email: "ana@example.com", Password: "segredo123", IP: "203.0.113.7"})
+		if err != nil {
+			t.Fatalf("tentativa %d: login com a senha certa deveria pedir o código: %v", i+1, err)
+		}
+		if !output.MFARequired {
+			t.Fatalf("tentativa %d: o login deveria exigir o segundo fator", i+1)
+		}
+
+		_, err = verify.Execute(ctx, MFAVerifyInput{MFAToken: output.MFAToken, Code: "999999x", IP: "203.0.113.7"})
+		if !errors.Is(err, services.ErrInvalidMFACode) {
+			t.Fatalf("tentativa %d: esperava código inválido, obtido %v", i+1, err)
+		}
+	}
+
+	_, err := login.Execute(ctx, UserAuthInput{Email: "ana@example.com", Password: "segredo123", IP: "203.0.113.7"})
+	var locked *services.LoginLockedError
+	if !errors.As(err, &locked) {
+		t.Fatalf("códigos errados seguidos deveriam bloquear a conta, obtido %v", err)
+	}
+}
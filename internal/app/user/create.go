@@ -2,43 +2,98 @@ package user
 
 import (
 	"context"
+	"scheduling/internal/domain/auth"
 	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/i18n"
 	"scheduling/internal/domain/services"
 )
 
 type CreateUserUseCase struct {
-	UserService *services.UserService
+	UserService    *services.UserService
+	AccountService *services.AccountService
 }
 
 func NewCreateUserUseCase(
 	userSerivce *services.UserService,
+	accountService *services.AccountService,
 ) *CreateUserUseCase {
 	return &CreateUserUseCase{
-		UserService: userSerivce,
+		UserService:    userSerivce,
+		AccountService: accountService,
 	}
 }
 
+// Execute é o cadastro público. O papel não vem da requisição: quem se
+// cadastra sozinho é sempre cliente.
 func (useCase *CreateUserUseCase) Execute(ctx context.Context, input UserInput) (*UserOutput, error) {
 	
-	user, err := entities.NewUser(
-		input.ID,
-		input.Name,
-		input.Email,
-		input.Password,
-		input.Role,
-	)
+	ctx, span := tracer.Start(ctx, "CreateUserUseCase.Execute")
+	defer span.End()
 
+	return createUser(ctx, useCase.UserService, useCase.AccountService, input.Name, input.Email, input.Password, entities.RoleClient, input.Locale)
+}
+
+// CreateUserByAdminUseCase cadastra contas de qualquer papel, inclusive
+// profissionais e administradores. A rota fica atrás de RequireAdmin.
+type CreateUserByAdminUseCase struct {
+	UserService    *services.UserService
+	AccountService *services.AccountService
+}
+
+func NewCreateUserByAdminUseCase(
+	userService *services.UserService,
+	accountService *services.AccountService,
+) *CreateUserByAdminUseCase {
+	return &CreateUserByAdminUseCase{
+		UserService:    userService,
+		AccountService: accountService,
+	}
+}
+
+func (useCase *CreateUserByAdminUseCase) Execute(ctx context.Context, input AdminUserInput) (*UserOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "CreateUserByAdminUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+	if !principal.HasRole(entities.RoleAdmin) {
+		return nil, auth.ErrForbidden
+	}
+
+	return createUser(ctx, useCase.UserService, useCase.AccountService, input.Name, input.Email, input.Password, input.Role, input.Locale)
+}
+
+func createUser(
+	ctx context.Context,
+	userService *services.UserService,
+	accountService *services.AccountService,
+	name, email, password, role, locale string,
+) (*UserOutput, error) {
+	user, err := entities.NewUser(0, name, email, password, role)
 	if err != nil {
 		return nil, err
 	}
 
-	err = useCase.UserService.Create(ctx, *user)
+	if locale == "" {
+		locale = string(i18n.FromContext(ctx))
+	}
+	if err := user.SetLocale(locale); err != nil {
+		return nil, err
+	}
+
+	err = userService.Create(ctx, user)
 	if err != nil {
-		return  nil, err
+		return nil, err
 	}
 
+	// a conta já existe; se o envio falhar, o usuário pode pedir outro link
+	_ = accountService.RequestEmailVerification(ctx, user.ID())
+
 	return &UserOutput{
-		ID:       user.ID(),
-		Name:     user.Name(),
+		ID:   user.ID(),
+		Name: user.Name(),
 	}, nil
-}
\ No newline at end of file
+}
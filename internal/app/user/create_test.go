@@ -1 +1,95 @@
-package user
\ No newline at end of file
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/services"
+)
+
+type plainHasher struct{}
+
+func (plainHasher) Hash(plain string) (string, error)       { return "hash:" + plain, nil }
+func (plainHasher) Verify(hash, plain string) (bool, error) { return hash == "hash:"+plain, nil }
+func (plainHasher) NeedsRehash(hash string) bool            { return false }
+
+// newUserServices devolve os serviços de cadastro e a função que informa o
+// papel do último usuário gravado.
+func newUserServices() (*services.UserService, *services.AccountService, func() string) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	var created *entities.User
+	users := mocks.NewMockUserRepository()
+	users.CreateFunc = func(ctx context.Context, user *entities.User) error {
+		created = user
+		return nil
+	}
+
+	userService := services.NewUserService(logger, users, plainHasher{})
+	accountService := services.NewAccountService(logger, users, mocks.NewMockUserTokenRepository(), mocks.NewMockRefreshTokenRepository(), plainHasher{}, nil, services.AccountLinks{})
+	return userService, accountService, func() string {
+		if created == nil {
+			return ""
+		}
+		return created.Role()
+	}
+}
+
+func TestCreateUserUseCase_AlwaysClient(t *testing.T) {
+	userService, accountService, createdRole := newUserServices()
+	useCase := NewCreateUserUseCase(userService, accountService)
+
+	_, err := useCase.Execute(context.Background(), UserInput{Name: "Ana", Email: "ana@example.com", Password: "segredo123"})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if createdRole() != entities.RoleClient {
+		t.Errorf("cadastro público deveria criar cliente, criou %q", createdRole())
+	}
+}
+
+func TestCreateUserByAdminUseCase(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		role      string
+		wantErr   error
+	}{
+		{name: "administrador cria profissional", principal: &auth.Principal{UserID: 1, Role: entities.RoleAdmin}, role: entities.RoleStaff},
+		{name: "administrador cria administrador", principal: &auth.Principal{UserID: 1, Role: entities.RoleAdmin}, role: entities.RoleAdmin},
+		{name: "profissional não cria contas", principal: &auth.Principal{UserID: 2, Role: entities.RoleStaff}, role: entities.RoleAdmin, wantErr: auth.ErrForbidden},
+		{name: "sem autenticação", role: entities.RoleAdmin, wantErr: auth.ErrUnauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userService, accountService, createdRole := newUserServices()
+			useCase := NewCreateUserByAdminUseCase(userService, accountService)
+
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = auth.WithPrincipal(ctx, *tt.principal)
+			}
+
+			_, err := useCase.Execute(ctx, AdminUserInput{Name: "Bia", Email: "bia@example.com", Password: "segredo123", Role: tt.role})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("erro esperado %v, obtido %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				if createdRole() != "" {
+					t.Errorf("nenhum usuário deveria ser criado, criado %q", createdRole())
+				}
+				return
+			}
+			if createdRole() != tt.role {
+				t.Errorf("papel esperado %s, criado %q", tt.role, createdRole())
+			}
+		})
+	}
+}
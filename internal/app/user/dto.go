@@ -1,11 +1,22 @@
 package user
 
+// UserInput é o cadastro público: a conta criada é sempre de cliente.
 type UserInput struct {
-	ID       int    `json:"id"`
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"passowrd"`
-	Role     string `json:"role"`
+	Name     string `json:"name" validate:"required,max=255"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+	// Locale é o idioma preferido; sem ele, vale o idioma da requisição.
+	Locale string `json:"locale"`
+}
+
+// AdminUserInput é o cadastro feito por um administrador, que escolhe o
+// papel da conta.
+type AdminUserInput struct {
+	Name     string `json:"name" validate:"required,max=255"`
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+	Role     string `json:"role" validate:"required,oneof=client staff admin"`
+	Locale   string `json:"locale"`
 }
 
 type UserOutput struct {
@@ -15,10 +26,83 @@ type UserOutput struct {
 }
 
 type UserAuthInput struct {
-	Email    string `json:"email"`
-	Password string `json:"passowrd"`
+	Email    string `json:"email" validate:"required"`
+	Password string `json:"password" validate:"required"`
+	IP       string `json:"-"`
 }
 
+// UserAuthOutput traz a sessão aberta ou, quando o usuário tem a verificação
+// em duas etapas ativa, só o token do desafio a ser respondido em
+// /auth/mfa/verify.
 type UserAuthOutput struct {
-	Token string `json:"token"`
+	AccessToken  string `json:"access_token,omitempty"`
+	TokenType    string `json:"token_type,omitempty"`
+	ExpiresIn    int    `json:"expires_in,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	MFARequired  bool   `json:"mfa_required,omitempty"`
+	MFAToken     string `json:"mfa_token,omitempty"`
+}
+
+type RefreshTokenInput struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+type ExternalLoginInput struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type PasswordResetRequestInput struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+type PasswordResetInput struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+type VerifyEmailInput struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type MFAVerifyInput struct {
+	MFAToken     string `json:"mfa_token" validate:"required"`
+	Code         string `json:"code" validate:"required_without=RecoveryCode"`
+	RecoveryCode string `json:"recovery_code" validate:"required_without=Code"`
+	IP           string `json:"-"`
+}
+
+type TOTPCodeInput struct {
+	Code string `json:"code" validate:"required"`
+}
+
+type TOTPEnrollmentOutput struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type RecoveryCodesOutput struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type TOTPActivationOutput struct {
+	RecoveryCodes []string        `json:"recovery_codes"`
+	Session       *UserAuthOutput `json:"session"`
+}
+
+type PreferencesInput struct {
+	Locale string `json:"locale"`
+}
+
+type UnlockAccountInput struct {
+	UserID int
+	IP     string
+}
+
+type UnlockIPInput struct {
+	Address string
+	IP      string
 }
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+
+	"scheduling/internal/domain/services"
+)
+
+type ExternalLoginUseCase struct {
+	ExternalLoginService *services.ExternalLoginService
+	SessionService       *services.SessionService
+	TwoFactorService     *services.TwoFactorService
+}
+
+func NewExternalLoginUseCase(
+	externalLoginService *services.ExternalLoginService,
+	sessionService *services.SessionService,
+	twoFactorService *services.TwoFactorService,
+) *ExternalLoginUseCase {
+	return &ExternalLoginUseCase{
+		ExternalLoginService: externalLoginService,
+		SessionService:       sessionService,
+		TwoFactorService:     twoFactorService,
+	}
+}
+
+func (useCase ExternalLoginUseCase) Execute(ctx context.Context, input ExternalLoginInput) (*UserAuthOutput, error) {
+
+	ctx, span := tracer.Start(ctx, "ExternalLoginUseCase.Execute")
+	defer span.End()
+
+	user, err := useCase.ExternalLoginService.Login(ctx, services.ExternalProfile{
+		Provider:      input.Provider,
+		Subject:       input.Subject,
+		Email:         input.Email,
+		EmailVerified: input.EmailVerified,
+		Name:          input.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// o provedor externo não dispensa o autenticador cadastrado aqui
+	challenge, err := useCase.TwoFactorService.Challenge(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != "" {
+		return &UserAuthOutput{MFARequired: true, MFAToken: challenge}, nil
+	}
+
+	session, err := useCase.SessionService.Start(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserAuthOutput(session), nil
+}
@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/services"
+)
+
+// UnlockAccountUseCase libera uma conta bloqueada por falhas de login. O
+// administrador autenticado fica registrado na auditoria.
+type UnlockAccountUseCase struct {
+	LoginThrottleService *services.LoginThrottleService
+}
+
+func NewUnlockAccountUseCase(loginThrottleService *services.LoginThrottleService) *UnlockAccountUseCase {
+	return &UnlockAccountUseCase{LoginThrottleService: loginThrottleService}
+}
+
+func (useCase UnlockAccountUseCase) Execute(ctx context.Context, input UnlockAccountInput) error {
+	ctx, span := tracer.Start(ctx, "UnlockAccountUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.ErrUnauthenticated
+	}
+
+	return useCase.LoginThrottleService.UnlockAccount(ctx, principal.UserID, input.UserID, input.IP)
+}
+
+type UnlockIPUseCase struct {
+	LoginThrottleService *services.LoginThrottleService
+}
+
+func NewUnlockIPUseCase(loginThrottleService *services.LoginThrottleService) *UnlockIPUseCase {
+	return &UnlockIPUseCase{LoginThrottleService: loginThrottleService}
+}
+
+func (useCase UnlockIPUseCase) Execute(ctx context.Context, input UnlockIPInput) error {
+	ctx, span := tracer.Start(ctx, "UnlockIPUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.ErrUnauthenticated
+	}
+
+	return useCase.LoginThrottleService.UnlockIP(ctx, principal.UserID, input.Address, input.IP)
+}
@@ -0,0 +1,33 @@
+package user
+
+import (
+	"context"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/services"
+)
+
+type UpdatePreferencesUseCase struct {
+	UserService *services.UserService
+}
+
+func NewUpdatePreferencesUseCase(userService *services.UserService) *UpdatePreferencesUseCase {
+	return &UpdatePreferencesUseCase{UserService: userService}
+}
+
+// Execute altera as preferências do próprio usuário. Chaves de API não têm
+// usuário, então não têm preferências.
+func (useCase UpdatePreferencesUseCase) Execute(ctx context.Context, input PreferencesInput) error {
+	ctx, span := tracer.Start(ctx, "UpdatePreferencesUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.ErrUnauthenticated
+	}
+	if principal.IsAPIKey() {
+		return auth.ErrForbidden
+	}
+
+	return useCase.UserService.UpdateLocale(ctx, principal.UserID, input.Locale)
+}
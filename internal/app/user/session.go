@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/services"
+)
+
+type RefreshTokenUseCase struct {
+	SessionService *services.SessionService
+}
+
+func NewRefreshTokenUseCase(sessionService *services.SessionService) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{SessionService: sessionService}
+}
+
+func (useCase RefreshTokenUseCase) Execute(ctx context.Context, input RefreshTokenInput) (*UserAuthOutput, error) {
+	ctx, span := tracer.Start(ctx, "RefreshTokenUseCase.Execute")
+	defer span.End()
+
+	session, err := useCase.SessionService.Refresh(ctx, input.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserAuthOutput(session), nil
+}
+
+type LogoutUseCase struct {
+	SessionService *services.SessionService
+}
+
+func NewLogoutUseCase(sessionService *services.SessionService) *LogoutUseCase {
+	return &LogoutUseCase{SessionService: sessionService}
+}
+
+func (useCase LogoutUseCase) Execute(ctx context.Context, input RefreshTokenInput) error {
+	ctx, span := tracer.Start(ctx, "LogoutUseCase.Execute")
+	defer span.End()
+
+	return useCase.SessionService.Revoke(ctx, input.RefreshToken)
+}
+
+func toUserAuthOutput(session *services.Session) *UserAuthOutput {
+	return &UserAuthOutput{
+		AccessToken:  session.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(time.Until(session.AccessExpiresAt).Round(time.Second).Seconds()),
+		RefreshToken: session.RefreshToken,
+	}
+}
@@ -0,0 +1,176 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/services"
+)
+
+// VerifyMFAUseCase responde o desafio devolvido pelo login e abre a sessão.
+// Códigos errados contam como falhas de login da conta, e as falhas só são
+// zeradas aqui, depois do segundo fator.
+type VerifyMFAUseCase struct {
+	TwoFactorService     *services.TwoFactorService
+	SessionService       *services.SessionService
+	LoginThrottleService *services.LoginThrottleService
+}
+
+func NewVerifyMFAUseCase(
+	twoFactorService *services.TwoFactorService,
+	sessionService *services.SessionService,
+	loginThrottleService *services.LoginThrottleService,
+) *VerifyMFAUseCase {
+	return &VerifyMFAUseCase{
+		TwoFactorService:     twoFactorService,
+		SessionService:       sessionService,
+		LoginThrottleService: loginThrottleService,
+	}
+}
+
+func (useCase VerifyMFAUseCase) Execute(ctx context.Context, input MFAVerifyInput) (*UserAuthOutput, error) {
+	ctx, span := tracer.Start(ctx, "VerifyMFAUseCase.Execute")
+	defer span.End()
+
+	user, err := useCase.TwoFactorService.OpenChallenge(ctx, input.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// a conta bloqueada pela senha também não passa pelo segundo fator
+	if err := useCase.LoginThrottleService.Check(ctx, user.Email(), input.IP); err != nil {
+		return nil, err
+	}
+
+	err = useCase.TwoFactorService.VerifyCode(ctx, user.ID(), input.Code, input.RecoveryCode)
+	if errors.Is(err, services.ErrInvalidMFACode) {
+		if recordErr := useCase.LoginThrottleService.RecordFailure(ctx, user.Email(), input.IP); recordErr != nil {
+			return nil, recordErr
+		}
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := useCase.LoginThrottleService.RecordSuccess(ctx, user.Email()); err != nil {
+		return nil, err
+	}
+
+	session, err := useCase.SessionService.Start(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return toUserAuthOutput(session), nil
+}
+
+type EnrollTOTPUseCase struct {
+	TwoFactorService *services.TwoFactorService
+}
+
+func NewEnrollTOTPUseCase(twoFactorService *services.TwoFactorService) *EnrollTOTPUseCase {
+	return &EnrollTOTPUseCase{TwoFactorService: twoFactorService}
+}
+
+func (useCase EnrollTOTPUseCase) Execute(ctx context.Context) (*TOTPEnrollmentOutput, error) {
+	ctx, span := tracer.Start(ctx, "EnrollTOTPUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	enrollment, err := useCase.TwoFactorService.BeginEnrollment(ctx, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOTPEnrollmentOutput{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	}, nil
+}
+
+// ConfirmTOTPUseCase ativa o autenticador e devolve uma nova sessão, já com a
+// verificação em duas etapas, no lugar das que foram encerradas.
+type ConfirmTOTPUseCase struct {
+	TwoFactorService *services.TwoFactorService
+	SessionService   *services.SessionService
+}
+
+func NewConfirmTOTPUseCase(twoFactorService *services.TwoFactorService, sessionService *services.SessionService) *ConfirmTOTPUseCase {
+	return &ConfirmTOTPUseCase{TwoFactorService: twoFactorService, SessionService: sessionService}
+}
+
+func (useCase ConfirmTOTPUseCase) Execute(ctx context.Context, input TOTPCodeInput) (*TOTPActivationOutput, error) {
+	ctx, span := tracer.Start(ctx, "ConfirmTOTPUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	activation, err := useCase.TwoFactorService.ConfirmEnrollment(ctx, principal.UserID, input.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := useCase.SessionService.Start(ctx, activation.User)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOTPActivationOutput{
+		RecoveryCodes: activation.RecoveryCodes,
+		Session:       toUserAuthOutput(session),
+	}, nil
+}
+
+type DisableTOTPUseCase struct {
+	TwoFactorService *services.TwoFactorService
+}
+
+func NewDisableTOTPUseCase(twoFactorService *services.TwoFactorService) *DisableTOTPUseCase {
+	return &DisableTOTPUseCase{TwoFactorService: twoFactorService}
+}
+
+func (useCase DisableTOTPUseCase) Execute(ctx context.Context, input TOTPCodeInput) error {
+	ctx, span := tracer.Start(ctx, "DisableTOTPUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return auth.ErrUnauthenticated
+	}
+
+	return useCase.TwoFactorService.Disable(ctx, principal, input.Code)
+}
+
+type RegenerateRecoveryCodesUseCase struct {
+	TwoFactorService *services.TwoFactorService
+}
+
+func NewRegenerateRecoveryCodesUseCase(twoFactorService *services.TwoFactorService) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{TwoFactorService: twoFactorService}
+}
+
+func (useCase RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, input TOTPCodeInput) (*RecoveryCodesOutput, error) {
+	ctx, span := tracer.Start(ctx, "RegenerateRecoveryCodesUseCase.Execute")
+	defer span.End()
+
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, auth.ErrUnauthenticated
+	}
+
+	codes, err := useCase.TwoFactorService.RegenerateRecoveryCodes(ctx, principal.UserID, input.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryCodesOutput{RecoveryCodes: codes}, nil
+}
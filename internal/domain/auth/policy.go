@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"strings"
+
+	"scheduling/internal/domain/errs"
+)
+
+var ErrEmailNotVerified = errs.Forbidden("auth.email_not_verified")
+
+// UnverifiedPolicy define o que uma conta com email ainda não verificado pode
+// fazer:
+//   - allow: tudo, a verificação é opcional;
+//   - restrict: entra normalmente, mas não agenda até verificar o email;
+//   - block: não consegue entrar até verificar o email.
+type UnverifiedPolicy string
+
+const (
+	UnverifiedAllow    UnverifiedPolicy = "allow"
+	UnverifiedRestrict UnverifiedPolicy = "restrict"
+	UnverifiedBlock    UnverifiedPolicy = "block"
+)
+
+// ParseUnverifiedPolicy aceita os valores de UnverifiedPolicy; vazio equivale
+// a allow, o comportamento anterior à verificação de email.
+func ParseUnverifiedPolicy(value string) (UnverifiedPolicy, error) {
+	switch policy := UnverifiedPolicy(value); policy {
+	case "":
+		return UnverifiedAllow, nil
+	case UnverifiedAllow, UnverifiedRestrict, UnverifiedBlock:
+		return policy, nil
+	default:
+		return "", errs.Validation("auth.invalid_unverified_policy").With("value", value)
+	}
+}
+
+// AllowsLogin indica se a conta pode iniciar uma sessão.
+func (p UnverifiedPolicy) AllowsLogin(emailVerified bool) bool {
+	return emailVerified || p != UnverifiedBlock
+}
+
+// AllowsRestricted indica se a conta pode usar as rotas restritas a emails
+// verificados, como o agendamento.
+func (p UnverifiedPolicy) AllowsRestricted(emailVerified bool) bool {
+	return emailVerified || p == UnverifiedAllow
+}
+
+var ErrMFARequired = errs.Forbidden("auth.mfa_required")
+
+// MFAPolicy lista os papéis que precisam ter concluído a verificação em duas
+// etapas para acessar as rotas protegidas. Sem papéis, a verificação é
+// opcional para todos.
+type MFAPolicy struct {
+	requiredRoles []string
+}
+
+func NewMFAPolicy(roles ...string) MFAPolicy {
+	return MFAPolicy{requiredRoles: roles}
+}
+
+// ParseMFAPolicy lê a lista de papéis separados por vírgula, ex.:
+// "admin,staff".
+func ParseMFAPolicy(value string) (MFAPolicy, error) {
+	var roles []string
+	for _, role := range strings.Split(value, ",") {
+		role = strings.TrimSpace(role)
+		if role == "" {
+			continue
+		}
+		if role != "client" && role != "staff" && role != "admin" {
+			return MFAPolicy{}, errs.Validation("auth.invalid_mfa_role").With("role", role)
+		}
+		roles = append(roles, role)
+	}
+	return NewMFAPolicy(roles...), nil
+}
+
+// Requires indica se o papel precisa da verificação em duas etapas.
+func (p MFAPolicy) Requires(role string) bool {
+	for _, required := range p.requiredRoles {
+		if required == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows indica se o principal atende à política.
+func (p MFAPolicy) Allows(principal Principal) bool {
+	return principal.MFA || !p.Requires(principal.Role)
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+
+	"scheduling/internal/domain/errs"
+)
+
+var (
+	ErrUnauthenticated = errs.Unauthorized("auth.unauthenticated")
+	ErrForbidden       = errs.Forbidden("auth.forbidden")
+)
+
+// Principal é quem fez a requisição: um usuário, montado a partir dos claims
+// do token de acesso, ou uma integração autenticada por chave de API. MFA
+// indica que a sessão passou pela verificação em duas etapas e Locale traz o
+// idioma preferido do usuário. Principais de chave de API não têm usuário nem
+// papel, só os escopos da chave.
+type Principal struct {
+	UserID        int
+	Role          string
+	TenantID      int
+	EmailVerified bool
+	MFA           bool
+	Locale        string
+	APIKeyID      int
+	Scopes        []string
+}
+
+func (p Principal) IsAPIKey() bool {
+	return p.APIKeyID != 0
+}
+
+// HasScope indica se a chave de API recebeu o escopo. É sempre false para
+// usuários, cujo acesso é definido pelo papel.
+func (p Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Principal) HasRole(roles ...string) bool {
+	for _, role := range roles {
+		if p.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// CanActFor indica se o principal pode acessar dados do usuário userID: o
+// próprio usuário ou alguém com um dos papéis informados.
+func (p Principal) CanActFor(userID int, roles ...string) bool {
+	return p.UserID == userID || p.HasRole(roles...)
+}
+
+type contextKey struct{}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(contextKey{}).(Principal)
+	return p, ok
+}
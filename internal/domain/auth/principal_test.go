@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestFromContext(t *testing.T) {
+	if _, ok := FromContext(context.Background()); ok {
+		t.Error("contexto sem principal não deveria retornar ok")
+	}
+
+	want := Principal{UserID: 7, Role: "client", TenantID: 3}
+	got, ok := FromContext(WithPrincipal(context.Background(), want))
+	if !ok || !reflect.DeepEqual(got, want) {
+		t.Errorf("principal esperado %+v, obtido %+v", want, got)
+	}
+}
+
+func TestPrincipal_CanActFor(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal Principal
+		userID    int
+		want      bool
+	}{
+		{"o próprio usuário", Principal{UserID: 7, Role: "client"}, 7, true},
+		{"cliente acessando outro usuário", Principal{UserID: 7, Role: "client"}, 8, false},
+		{"profissional acessando outro usuário", Principal{UserID: 2, Role: "staff"}, 8, true},
+		{"administrador acessando outro usuário", Principal{UserID: 1, Role: "admin"}, 8, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.principal.CanActFor(tt.userID, "staff", "admin"); got != tt.want {
+				t.Errorf("CanActFor() = %v, esperado %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrincipal_HasScope(t *testing.T) {
+	key := Principal{APIKeyID: 4, Scopes: []string{"availability:read", "appointments:write"}}
+	if !key.IsAPIKey() || !key.HasScope("appointments:write") {
+		t.Error("chave deveria ter o escopo appointments:write")
+	}
+	if key.HasScope("services:manage") {
+		t.Error("chave não deveria ter o escopo services:manage")
+	}
+
+	admin := Principal{UserID: 1, Role: "admin"}
+	if admin.IsAPIKey() || admin.HasScope("services:manage") {
+		t.Error("usuários não têm escopos")
+	}
+}
@@ -0,0 +1,98 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+// Escopos que uma chave de API pode receber. Cada rota aceita por chaves
+// exige um deles.
+const (
+	ScopeAvailabilityRead  = "availability:read"
+	ScopeAppointmentsWrite = "appointments:write"
+	ScopeServicesManage    = "services:manage"
+)
+
+var ErrInvalidScope = errs.Validation("api_key.invalid_scope")
+
+// APIKey é uma credencial de longa duração usada por integrações (PDV, site)
+// em nome do negócio. Como nos tokens de sessão, só o hash é guardado; prefix
+// é o início da chave, exibido para que o administrador a reconheça.
+type APIKey struct {
+	id         int
+	name       string
+	prefix     string
+	keyHash    string
+	scopes     []string
+	createdBy  int
+	expiresAt  *time.Time
+	lastUsedAt *time.Time
+	revokedAt  *time.Time
+	createdAt  time.Time
+}
+
+func NewAPIKey(name, prefix, keyHash string, scopes []string, createdBy int) (*APIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errs.Validation("api_key.name_required")
+	}
+	if prefix == "" || keyHash == "" {
+		return nil, errs.Validation("api_key.hash_required")
+	}
+	if len(scopes) == 0 {
+		return nil, errs.Validation("api_key.scopes_required")
+	}
+	for _, scope := range scopes {
+		if !IsValidScope(scope) {
+			return nil, errs.Wrap(errs.KindValidation, "api_key.unknown_scope", ErrInvalidScope).With("scope", scope)
+		}
+	}
+
+	return &APIKey{
+		name:      name,
+		prefix:    prefix,
+		keyHash:   keyHash,
+		scopes:    scopes,
+		createdBy: createdBy,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RebuildAPIKey(id int, name, prefix, keyHash string, scopes []string, createdBy int, expiresAt, lastUsedAt, revokedAt *time.Time, createdAt time.Time) *APIKey {
+	return &APIKey{
+		id:         id,
+		name:       name,
+		prefix:     prefix,
+		keyHash:    keyHash,
+		scopes:     scopes,
+		createdBy:  createdBy,
+		expiresAt:  expiresAt,
+		lastUsedAt: lastUsedAt,
+		revokedAt:  revokedAt,
+		createdAt:  createdAt,
+	}
+}
+
+func IsValidScope(scope string) bool {
+	return scope == ScopeAvailabilityRead || scope == ScopeAppointmentsWrite || scope == ScopeServicesManage
+}
+
+// IsActive indica se a chave ainda pode ser usada: não foi revogada e, se foi
+// substituída numa rotação, o prazo de transição não acabou.
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.revokedAt == nil && (k.expiresAt == nil || now.Before(*k.expiresAt))
+}
+
+func (k *APIKey) SetID(id int)           { k.id = id }
+func (k *APIKey) ID() int                { return k.id }
+func (k *APIKey) Name() string           { return k.name }
+func (k *APIKey) Prefix() string         { return k.prefix }
+func (k *APIKey) KeyHash() string        { return k.keyHash }
+func (k *APIKey) Scopes() []string       { return k.scopes }
+func (k *APIKey) CreatedBy() int         { return k.createdBy }
+func (k *APIKey) ExpiresAt() *time.Time  { return k.expiresAt }
+func (k *APIKey) LastUsedAt() *time.Time { return k.lastUsedAt }
+func (k *APIKey) RevokedAt() *time.Time  { return k.revokedAt }
+func (k *APIKey) CreatedAt() time.Time   { return k.createdAt }
@@ -1,8 +1,9 @@
 package entities
 
 import (
-	"errors"
 	"time"
+
+	"scheduling/internal/domain/errs"
 )
 
 type AppointmentStatus string
@@ -21,14 +22,15 @@ type Appointment struct {
 	scheduledAt time.Time
 	status      AppointmentStatus
 	createdAt   time.Time
+	locationID  int
 }
 
 func NewAppointment(clientID, staffID, serviceID int, scheduledAt time.Time) (*Appointment, error) {
 	if clientID == 0 || staffID == 0 || serviceID == 0 {
-		return nil, errors.New("cliente, profissional e serviço são obrigatórios")
+		return nil, errs.Validation("appointment.parties_required")
 	}
 	if scheduledAt.Before(time.Now()) {
-		return nil, errors.New("não é possível agendar para o passado")
+		return nil, errs.Validation("appointment.in_past")
 	}
 
 	return &Appointment{
@@ -41,6 +43,24 @@ func NewAppointment(clientID, staffID, serviceID int, scheduledAt time.Time) (*A
 	}, nil
 }
 
+func RebuildAppointment(id, clientID, staffID, serviceID int, scheduledAt time.Time, status string, createdAt time.Time) (*Appointment, error) {
+	if clientID == 0 || staffID == 0 || serviceID == 0 {
+		return nil, errs.Validation("appointment.parties_required")
+	}
+
+	appointment := &Appointment{
+		id:          id,
+		clientID:    clientID,
+		staffID:     staffID,
+		serviceID:   serviceID,
+		scheduledAt: scheduledAt,
+		createdAt:   createdAt,
+	}
+	appointment.SetStatus(status)
+
+	return appointment, nil
+}
+
 func (a *Appointment) Cancel() {
 	a.status = StatusCancelled
 }
@@ -74,6 +94,10 @@ func (a *Appointment) SetCreatedAt(t time.Time) {
 	a.createdAt = t
 }
 
+func (a *Appointment) SetLocationID(id int) {
+	a.locationID = id
+}
+
 func (a *Appointment) ID() int                   { return a.id }
 func (a *Appointment) ClientID() int             { return a.clientID }
 func (a *Appointment) StaffID() int              { return a.staffID }
@@ -81,3 +105,4 @@ func (a *Appointment) ServiceID() int            { return a.serviceID }
 func (a *Appointment) ScheduledAt() time.Time    { return a.scheduledAt }
 func (a *Appointment) Status() AppointmentStatus { return a.status }
 func (a *Appointment) CreatedAt() time.Time      { return a.createdAt }
+func (a *Appointment) LocationID() int           { return a.locationID }
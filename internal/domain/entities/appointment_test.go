@@ -103,6 +103,40 @@ func TestNewAppointment(t *testing.T) {
 	}
 }
 
+func TestRebuildAppointment(t *testing.T) {
+	pastTime := time.Date(2020, 5, 10, 14, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2020, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	t.Run("reconstruir agendamento no passado", func(t *testing.T) {
+		appointment, err := RebuildAppointment(7, 1, 2, 3, pastTime, "completed", createdAt)
+		if err != nil {
+			t.Fatalf("não esperava erro, mas obteve: %v", err)
+		}
+		if appointment.ID() != 7 {
+			t.Errorf("ID() = %d, esperado 7", appointment.ID())
+		}
+		if !appointment.ScheduledAt().Equal(pastTime) {
+			t.Errorf("ScheduledAt() = %v, esperado %v", appointment.ScheduledAt(), pastTime)
+		}
+		if appointment.Status() != StatusCompleted {
+			t.Errorf("Status() = %s, esperado %s", appointment.Status(), StatusCompleted)
+		}
+		if !appointment.CreatedAt().Equal(createdAt) {
+			t.Errorf("CreatedAt() = %v, esperado %v", appointment.CreatedAt(), createdAt)
+		}
+	})
+
+	t.Run("ids obrigatórios continuam validados", func(t *testing.T) {
+		_, err := RebuildAppointment(7, 0, 2, 3, pastTime, "scheduled", createdAt)
+		if err == nil {
+			t.Fatal("esperado erro, mas nenhum foi retornado")
+		}
+		if err.Error() != "cliente, profissional e serviço são obrigatórios" {
+			t.Errorf("mensagem de erro incorreta, obtido: '%s'", err.Error())
+		}
+	})
+}
+
 func TestAppointmentGetters(t *testing.T) {
 	now := time.Now()
 	scheduledAt := now.Add(1 * time.Hour)
@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+const (
+	AuditAccountLocked   = "account_locked"
+	AuditAccountUnlocked = "account_unlocked"
+	AuditIPLocked        = "ip_locked"
+	AuditIPUnlocked      = "ip_unlocked"
+	AuditAPIKeyCreated   = "api_key_created"
+	AuditAPIKeyRotated   = "api_key_rotated"
+	AuditAPIKeyRevoked   = "api_key_revoked"
+)
+
+// AuditEntry registra uma ação de segurança. actorID é nil quando a ação foi
+// tomada pelo próprio sistema, como um bloqueio automático; subject é o alvo
+// da ação (email, IP ou chave de API) e ip é o endereço de quem a causou.
+type AuditEntry struct {
+	id        int
+	actorID   *int
+	action    string
+	subject   string
+	ip        string
+	createdAt time.Time
+}
+
+func NewAuditEntry(actorID *int, action, subject, ip string) (*AuditEntry, error) {
+	if action == "" {
+		return nil, errs.Validation("audit.action_required")
+	}
+
+	return &AuditEntry{
+		actorID:   actorID,
+		action:    action,
+		subject:   subject,
+		ip:        ip,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RebuildAuditEntry(id int, actorID *int, action, subject, ip string, createdAt time.Time) *AuditEntry {
+	return &AuditEntry{
+		id:        id,
+		actorID:   actorID,
+		action:    action,
+		subject:   subject,
+		ip:        ip,
+		createdAt: createdAt,
+	}
+}
+
+func (e *AuditEntry) SetID(id int)         { e.id = id }
+func (e *AuditEntry) ID() int              { return e.id }
+func (e *AuditEntry) ActorID() *int        { return e.actorID }
+func (e *AuditEntry) Action() string       { return e.action }
+func (e *AuditEntry) Subject() string      { return e.subject }
+func (e *AuditEntry) IP() string           { return e.ip }
+func (e *AuditEntry) CreatedAt() time.Time { return e.createdAt }
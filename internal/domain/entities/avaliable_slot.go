@@ -1,9 +1,9 @@
 package entities
 
 import (
-	"errors"
-	"fmt"
 	"time"
+
+	"scheduling/internal/domain/errs"
 )
 
 type Weekday string
@@ -19,22 +19,23 @@ const (
 )
 
 type AvailableSlot struct {
-	id        int
-	staffID   int
-	weekday   Weekday
-	startTime time.Time
-	endTime   time.Time
+	id         int
+	staffID    int
+	weekday    Weekday
+	startTime  time.Time
+	endTime    time.Time
+	locationID int
 }
 
 func NewAvailableSlot(staffID int, weekday Weekday, start, end time.Time) (*AvailableSlot, error) {
 	if staffID == 0 {
-		return nil, errors.New("staffID é obrigatório")
+		return nil, errs.Validation("staff.id_required")
 	}
 	if !isValidWeekday(weekday) {
-		return nil, fmt.Errorf("dia da semana inválido: %s", weekday)
+		return nil, errs.Validation("weekday.invalid").With("weekday", weekday)
 	}
 	if !start.Before(end) {
-		return nil, errors.New("o horário inicial deve ser antes do final")
+		return nil, errs.Validation("time_range.invalid")
 	}
 
 	return &AvailableSlot{
@@ -75,9 +76,17 @@ func FromTimeWeekday(w time.Weekday) Weekday {
 	}
 }
 
+// AtLocation indica se o expediente vale para a unidade. Expedientes sem
+// unidade valem para qualquer uma, assim como consultas sem unidade.
+func (s *AvailableSlot) AtLocation(locationID int) bool {
+	return s.locationID == 0 || locationID == 0 || s.locationID == locationID
+}
+
+func (s *AvailableSlot) SetLocationID(id int) { s.locationID = id }
 func (s *AvailableSlot) SetID(id int)         { s.id = id }
 func (s *AvailableSlot) ID() int              { return s.id }
 func (s *AvailableSlot) StaffID() int         { return s.staffID }
 func (s *AvailableSlot) Weekday() Weekday     { return s.weekday }
 func (s *AvailableSlot) StartTime() time.Time { return s.startTime }
 func (s *AvailableSlot) EndTime() time.Time   { return s.endTime }
+func (s *AvailableSlot) LocationID() int      { return s.locationID }
@@ -206,3 +206,34 @@ func TestFromTimeWeekday(t *testing.T) {
 		})
 	}
 }
+
+func TestAvailableSlotAtLocation(t *testing.T) {
+	start := time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)
+	end := time.Date(0, 1, 1, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		slotLocation int
+		location     int
+		want         bool
+	}{
+		{"expediente sem unidade vale para todas", 0, 3, true},
+		{"consulta sem unidade aceita qualquer expediente", 2, 0, true},
+		{"mesma unidade", 2, 2, true},
+		{"unidade diferente", 2, 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot, err := NewAvailableSlot(1, Monday, start, end)
+			if err != nil {
+				t.Fatalf("não esperava erro, mas obteve: %v", err)
+			}
+			slot.SetLocationID(tt.slotLocation)
+
+			if got := slot.AtLocation(tt.location); got != tt.want {
+				t.Errorf("AtLocation(%d) = %v, esperado %v", tt.location, got, tt.want)
+			}
+		})
+	}
+}
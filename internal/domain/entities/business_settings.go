@@ -0,0 +1,103 @@
+package entities
+
+import (
+	"regexp"
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+const (
+	DefaultSlotStepMinutes           = 15
+	DefaultTimezone                  = "UTC"
+	DefaultCurrency                  = "BRL"
+	DefaultCancellationNoticeMinutes = 24 * 60
+	DefaultBookingHorizonDays        = 90
+
+	maxSlotStepMinutes    = 240
+	maxBookingHorizonDays = 730
+	maxBrandingNameLength = 100
+)
+
+var currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// BusinessSettings reúne as configurações de um negócio (tenant) usadas pela
+// disponibilidade e pelos agendamentos. Negócios sem configuração gravada usam
+// DefaultBusinessSettings.
+type BusinessSettings struct {
+	slotStep           time.Duration
+	timezone           *time.Location
+	currency           string
+	cancellationNotice time.Duration
+	bookingHorizonDays int
+	brandingName       string
+	updatedAt          time.Time
+}
+
+func NewBusinessSettings(
+	slotStepMinutes int,
+	timezone string,
+	currency string,
+	cancellationNoticeMinutes int,
+	bookingHorizonDays int,
+	brandingName string,
+) (*BusinessSettings, error) {
+	if slotStepMinutes <= 0 || slotStepMinutes > maxSlotStepMinutes {
+		return nil, errs.Validation("settings.invalid_slot_interval")
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" {
+		return nil, errs.Validation("settings.invalid_timezone")
+	}
+
+	if !currencyPattern.MatchString(currency) {
+		return nil, errs.Validation("settings.invalid_currency")
+	}
+
+	if cancellationNoticeMinutes < 0 {
+		return nil, errs.Validation("settings.negative_cancellation_notice")
+	}
+
+	if bookingHorizonDays <= 0 || bookingHorizonDays > maxBookingHorizonDays {
+		return nil, errs.Validation("settings.invalid_booking_horizon")
+	}
+
+	if len(brandingName) > maxBrandingNameLength {
+		return nil, errs.Validation("settings.display_name_too_long")
+	}
+
+	return &BusinessSettings{
+		slotStep:           time.Duration(slotStepMinutes) * time.Minute,
+		timezone:           location,
+		currency:           currency,
+		cancellationNotice: time.Duration(cancellationNoticeMinutes) * time.Minute,
+		bookingHorizonDays: bookingHorizonDays,
+		brandingName:       brandingName,
+	}, nil
+}
+
+func DefaultBusinessSettings() *BusinessSettings {
+	return &BusinessSettings{
+		slotStep:           DefaultSlotStepMinutes * time.Minute,
+		timezone:           time.UTC,
+		currency:           DefaultCurrency,
+		cancellationNotice: DefaultCancellationNoticeMinutes * time.Minute,
+		bookingHorizonDays: DefaultBookingHorizonDays,
+	}
+}
+
+// BookingLimit é o último instante em que um agendamento pode começar quando
+// marcado em now.
+func (s *BusinessSettings) BookingLimit(now time.Time) time.Time {
+	return now.AddDate(0, 0, s.bookingHorizonDays)
+}
+
+func (s *BusinessSettings) SetUpdatedAt(t time.Time)          { s.updatedAt = t }
+func (s *BusinessSettings) SlotStep() time.Duration           { return s.slotStep }
+func (s *BusinessSettings) Timezone() *time.Location          { return s.timezone }
+func (s *BusinessSettings) Currency() string                  { return s.currency }
+func (s *BusinessSettings) CancellationNotice() time.Duration { return s.cancellationNotice }
+func (s *BusinessSettings) BookingHorizonDays() int           { return s.bookingHorizonDays }
+func (s *BusinessSettings) BrandingName() string              { return s.brandingName }
+func (s *BusinessSettings) UpdatedAt() time.Time              { return s.updatedAt }
@@ -0,0 +1,126 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewBusinessSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		slotStep    int
+		timezone    string
+		currency    string
+		notice      int
+		horizonDays int
+		branding    string
+		wantErr     bool
+		errMsg      string
+	}{
+		{
+			name:        "configuração válida",
+			slotStep:    30,
+			timezone:    "America/Sao_Paulo",
+			currency:    "BRL",
+			notice:      120,
+			horizonDays: 60,
+			branding:    "Studio Centro",
+		},
+		{
+			name:        "intervalo entre horários zerado",
+			slotStep:    0,
+			timezone:    "UTC",
+			currency:    "BRL",
+			horizonDays: 60,
+			wantErr:     true,
+			errMsg:      "o intervalo entre horários deve estar entre 1 e 240 minutos",
+		},
+		{
+			name:        "fuso horário desconhecido",
+			slotStep:    15,
+			timezone:    "America/Atlantida",
+			currency:    "BRL",
+			horizonDays: 60,
+			wantErr:     true,
+			errMsg:      "fuso horário inválido",
+		},
+		{
+			name:        "fuso horário vazio",
+			slotStep:    15,
+			timezone:    "",
+			currency:    "BRL",
+			horizonDays: 60,
+			wantErr:     true,
+			errMsg:      "fuso horário inválido",
+		},
+		{
+			name:        "moeda fora do padrão ISO",
+			slotStep:    15,
+			timezone:    "UTC",
+			currency:    "real",
+			horizonDays: 60,
+			wantErr:     true,
+			errMsg:      "moeda inválida: use o código ISO 4217 (ex.: BRL)",
+		},
+		{
+			name:        "antecedência negativa",
+			slotStep:    15,
+			timezone:    "UTC",
+			currency:    "BRL",
+			notice:      -1,
+			horizonDays: 60,
+			wantErr:     true,
+			errMsg:      "a antecedência para cancelamento não pode ser negativa",
+		},
+		{
+			name:        "horizonte acima do limite",
+			slotStep:    15,
+			timezone:    "UTC",
+			currency:    "BRL",
+			horizonDays: 731,
+			wantErr:     true,
+			errMsg:      "o horizonte de agendamento deve estar entre 1 e 730 dias",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := NewBusinessSettings(tt.slotStep, tt.timezone, tt.currency, tt.notice, tt.horizonDays, tt.branding)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("esperado erro, mas nenhum foi retornado")
+				} else if err.Error() != tt.errMsg {
+					t.Errorf("mensagem de erro incorreta, esperado: '%s', obtido: '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("não esperava erro, mas obteve: %v", err)
+			}
+			if settings.SlotStep() != time.Duration(tt.slotStep)*time.Minute {
+				t.Errorf("SlotStep esperado %d minutos, obtido %v", tt.slotStep, settings.SlotStep())
+			}
+			if settings.Timezone().String() != tt.timezone {
+				t.Errorf("Timezone esperado '%s', obtido '%s'", tt.timezone, settings.Timezone())
+			}
+			if settings.CancellationNotice() != time.Duration(tt.notice)*time.Minute {
+				t.Errorf("CancellationNotice esperado %d minutos, obtido %v", tt.notice, settings.CancellationNotice())
+			}
+			if settings.BrandingName() != tt.branding {
+				t.Errorf("BrandingName esperado '%s', obtido '%s'", tt.branding, settings.BrandingName())
+			}
+		})
+	}
+}
+
+func TestBusinessSettings_BookingLimit(t *testing.T) {
+	settings := DefaultBusinessSettings()
+	now := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+
+	want := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	if got := settings.BookingLimit(now); !got.Equal(want) {
+		t.Errorf("BookingLimit esperado %v, obtido %v", want, got)
+	}
+}
@@ -0,0 +1,55 @@
+package entities
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+// ExternalIdentity vincula um usuário a uma conta em um provedor OIDC. O
+// subject (claim "sub") é o identificador estável do usuário no provedor;
+// o email pode mudar e não é usado para reencontrar o vínculo.
+type ExternalIdentity struct {
+	id        int
+	userID    int
+	provider  string
+	subject   string
+	email     string
+	createdAt time.Time
+}
+
+func NewExternalIdentity(userID int, provider, subject, email string) (*ExternalIdentity, error) {
+	if userID <= 0 {
+		return nil, errs.Validation("external_identity.user_required")
+	}
+	if provider == "" || subject == "" {
+		return nil, errs.Validation("external_identity.subject_required")
+	}
+
+	return &ExternalIdentity{
+		userID:    userID,
+		provider:  provider,
+		subject:   subject,
+		email:     email,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RebuildExternalIdentity(id, userID int, provider, subject, email string, createdAt time.Time) *ExternalIdentity {
+	return &ExternalIdentity{
+		id:        id,
+		userID:    userID,
+		provider:  provider,
+		subject:   subject,
+		email:     email,
+		createdAt: createdAt,
+	}
+}
+
+func (i *ExternalIdentity) SetID(id int)         { i.id = id }
+func (i *ExternalIdentity) ID() int              { return i.id }
+func (i *ExternalIdentity) UserID() int          { return i.userID }
+func (i *ExternalIdentity) Provider() string     { return i.provider }
+func (i *ExternalIdentity) Subject() string      { return i.subject }
+func (i *ExternalIdentity) Email() string        { return i.email }
+func (i *ExternalIdentity) CreatedAt() time.Time { return i.createdAt }
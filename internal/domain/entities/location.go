@@ -0,0 +1,35 @@
+package entities
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+// Location é uma unidade (filial) do negócio onde os serviços são prestados.
+type Location struct {
+	id        int
+	name      string
+	address   string
+	createdAt time.Time
+}
+
+func NewLocation(id int, name, address string) (*Location, error) {
+	if name == "" {
+		return nil, errs.Validation("location.name_required")
+	}
+
+	return &Location{
+		id:        id,
+		name:      name,
+		address:   address,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (l *Location) SetID(id int)             { l.id = id }
+func (l *Location) SetCreatedAt(t time.Time) { l.createdAt = t }
+func (l *Location) ID() int                  { return l.id }
+func (l *Location) Name() string             { return l.name }
+func (l *Location) Address() string          { return l.address }
+func (l *Location) CreatedAt() time.Time     { return l.createdAt }
@@ -0,0 +1,58 @@
+package entities
+
+import "testing"
+
+func TestNewLocation(t *testing.T) {
+	tests := []struct {
+		name         string
+		locationName string
+		address      string
+		wantErr      bool
+		errMsg       string
+	}{
+		{
+			name:         "criar unidade válida",
+			locationName: "Centro",
+			address:      "Rua A, 100",
+		},
+		{
+			name:         "unidade sem endereço é aceita",
+			locationName: "Shopping",
+		},
+		{
+			name:         "nome vazio deve retornar erro",
+			locationName: "",
+			address:      "Rua B, 200",
+			wantErr:      true,
+			errMsg:       "nome da unidade é obrigatório",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			location, err := NewLocation(1, tt.locationName, tt.address)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("esperado erro, mas nenhum foi retornado")
+				} else if err.Error() != tt.errMsg {
+					t.Errorf("mensagem de erro incorreta, esperado: '%s', obtido: '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("não esperava erro, mas obteve: %v", err)
+			}
+			if location.Name() != tt.locationName {
+				t.Errorf("Name esperado '%s', obtido '%s'", tt.locationName, location.Name())
+			}
+			if location.Address() != tt.address {
+				t.Errorf("Address esperado '%s', obtido '%s'", tt.address, location.Address())
+			}
+			if location.CreatedAt().IsZero() {
+				t.Error("CreatedAt não deve ser zero")
+			}
+		})
+	}
+}
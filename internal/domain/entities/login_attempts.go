@@ -0,0 +1,40 @@
+package entities
+
+import "time"
+
+const (
+	LoginScopeAccount = "account"
+	LoginScopeIP      = "ip"
+)
+
+// LoginAttempts conta as falhas de login recentes de uma conta (pelo email
+// informado, exista ele ou não) ou de um IP. Os atrasos progressivos e os
+// bloqueios temporários são registrados em lockedUntil.
+type LoginAttempts struct {
+	scope         string
+	key           string
+	failures      int
+	lastFailureAt time.Time
+	lockedUntil   *time.Time
+}
+
+func RebuildLoginAttempts(scope, key string, failures int, lastFailureAt time.Time, lockedUntil *time.Time) *LoginAttempts {
+	return &LoginAttempts{
+		scope:         scope,
+		key:           key,
+		failures:      failures,
+		lastFailureAt: lastFailureAt,
+		lockedUntil:   lockedUntil,
+	}
+}
+
+// IsLocked indica se novas tentativas ainda devem ser recusadas.
+func (a *LoginAttempts) IsLocked(now time.Time) bool {
+	return a.lockedUntil != nil && now.Before(*a.lockedUntil)
+}
+
+func (a *LoginAttempts) Scope() string            { return a.scope }
+func (a *LoginAttempts) Key() string              { return a.key }
+func (a *LoginAttempts) Failures() int            { return a.failures }
+func (a *LoginAttempts) LastFailureAt() time.Time { return a.lastFailureAt }
+func (a *LoginAttempts) LockedUntil() *time.Time  { return a.lockedUntil }
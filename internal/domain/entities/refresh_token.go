@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+// RefreshToken guarda apenas o hash do token entregue ao cliente. Tokens
+// renovados a partir do mesmo login compartilham o familyID, o que permite
+// revogar a sessão inteira quando um token já usado é reapresentado.
+type RefreshToken struct {
+	id        int
+	userID    int
+	tokenHash string
+	familyID  string
+	expiresAt time.Time
+	revokedAt *time.Time
+	createdAt time.Time
+}
+
+func NewRefreshToken(userID int, tokenHash, familyID string, expiresAt time.Time) (*RefreshToken, error) {
+	if userID <= 0 {
+		return nil, errs.Validation("refresh_token.user_required")
+	}
+	if tokenHash == "" || familyID == "" {
+		return nil, errs.Validation("refresh_token.hash_required")
+	}
+
+	return &RefreshToken{
+		userID:    userID,
+		tokenHash: tokenHash,
+		familyID:  familyID,
+		expiresAt: expiresAt,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RebuildRefreshToken(id, userID int, tokenHash, familyID string, expiresAt time.Time, revokedAt *time.Time, createdAt time.Time) *RefreshToken {
+	return &RefreshToken{
+		id:        id,
+		userID:    userID,
+		tokenHash: tokenHash,
+		familyID:  familyID,
+		expiresAt: expiresAt,
+		revokedAt: revokedAt,
+		createdAt: createdAt,
+	}
+}
+
+func (t *RefreshToken) IsExpired(now time.Time) bool { return !now.Before(t.expiresAt) }
+func (t *RefreshToken) IsRevoked() bool              { return t.revokedAt != nil }
+
+func (t *RefreshToken) SetID(id int)          { t.id = id }
+func (t *RefreshToken) ID() int               { return t.id }
+func (t *RefreshToken) UserID() int           { return t.userID }
+func (t *RefreshToken) TokenHash() string     { return t.tokenHash }
+func (t *RefreshToken) FamilyID() string      { return t.familyID }
+func (t *RefreshToken) ExpiresAt() time.Time  { return t.expiresAt }
+func (t *RefreshToken) RevokedAt() *time.Time { return t.revokedAt }
+func (t *RefreshToken) CreatedAt() time.Time  { return t.createdAt }
@@ -0,0 +1,129 @@
+package entities
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+// Resource é uma sala ou equipamento compartilhado entre profissionais.
+// A capacidade indica quantos agendamentos podem usá-lo ao mesmo tempo.
+type Resource struct {
+	id        int
+	name      string
+	capacity  int
+	createdAt time.Time
+}
+
+func NewResource(id int, name string, capacity int) (*Resource, error) {
+	if name == "" {
+		return nil, errs.Validation("resource.name_required")
+	}
+	if capacity <= 0 {
+		return nil, errs.Validation("resource.invalid_capacity")
+	}
+
+	return &Resource{
+		id:        id,
+		name:      name,
+		capacity:  capacity,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (r *Resource) ChangeCapacity(capacity int) error {
+	if capacity <= 0 {
+		return errs.Validation("resource.invalid_capacity")
+	}
+	r.capacity = capacity
+	return nil
+}
+
+func (r *Resource) SetID(id int)             { r.id = id }
+func (r *Resource) SetCreatedAt(t time.Time) { r.createdAt = t }
+func (r *Resource) ID() int                  { return r.id }
+func (r *Resource) Name() string             { return r.name }
+func (r *Resource) Capacity() int            { return r.capacity }
+func (r *Resource) CreatedAt() time.Time     { return r.createdAt }
+
+// ResourceRequirement indica quantas unidades de um recurso um serviço ocupa.
+type ResourceRequirement struct {
+	serviceID  int
+	resourceID int
+	quantity   int
+}
+
+func NewResourceRequirement(serviceID, resourceID, quantity int) (*ResourceRequirement, error) {
+	if serviceID == 0 || resourceID == 0 {
+		return nil, errs.Validation("resource.requirement_parties_required")
+	}
+	if quantity <= 0 {
+		return nil, errs.Validation("resource.invalid_quantity")
+	}
+
+	return &ResourceRequirement{
+		serviceID:  serviceID,
+		resourceID: resourceID,
+		quantity:   quantity,
+	}, nil
+}
+
+func (r *ResourceRequirement) ServiceID() int  { return r.serviceID }
+func (r *ResourceRequirement) ResourceID() int { return r.resourceID }
+func (r *ResourceRequirement) Quantity() int   { return r.quantity }
+
+// ResourceAllocation é o uso de um recurso por um agendamento já marcado.
+type ResourceAllocation struct {
+	resourceID int
+	quantity   int
+	startsAt   time.Time
+	endsAt     time.Time
+}
+
+func NewResourceAllocation(resourceID, quantity int, startsAt, endsAt time.Time) (*ResourceAllocation, error) {
+	if resourceID == 0 {
+		return nil, errs.Validation("resource.allocation_resource_required")
+	}
+	if quantity <= 0 {
+		return nil, errs.Validation("resource.invalid_quantity")
+	}
+	if !startsAt.Before(endsAt) {
+		return nil, errs.Validation("time_range.invalid")
+	}
+
+	return &ResourceAllocation{
+		resourceID: resourceID,
+		quantity:   quantity,
+		startsAt:   startsAt,
+		endsAt:     endsAt,
+	}, nil
+}
+
+func (a *ResourceAllocation) ResourceID() int     { return a.resourceID }
+func (a *ResourceAllocation) Quantity() int       { return a.quantity }
+func (a *ResourceAllocation) StartsAt() time.Time { return a.startsAt }
+func (a *ResourceAllocation) EndsAt() time.Time   { return a.endsAt }
+
+// PeakUsage devolve o maior número de unidades em uso simultâneo no intervalo.
+func PeakUsage(allocations []*ResourceAllocation, start, end time.Time) int {
+	points := []time.Time{start}
+	for _, a := range allocations {
+		if a.startsAt.After(start) && a.startsAt.Before(end) {
+			points = append(points, a.startsAt)
+		}
+	}
+
+	peak := 0
+	for _, p := range points {
+		usage := 0
+		for _, a := range allocations {
+			if !a.startsAt.After(p) && a.endsAt.After(p) {
+				usage += a.quantity
+			}
+		}
+		if usage > peak {
+			peak = usage
+		}
+	}
+	return peak
+}
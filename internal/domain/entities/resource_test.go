@@ -0,0 +1,172 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewResource(t *testing.T) {
+	tests := []struct {
+		name         string
+		resourceName string
+		capacity     int
+		wantErr      bool
+		errMsg       string
+	}{
+		{
+			name:         "criar recurso válido",
+			resourceName: "Sala de Massagem",
+			capacity:     2,
+			wantErr:      false,
+		},
+		{
+			name:         "nome vazio deve retornar erro",
+			resourceName: "",
+			capacity:     1,
+			wantErr:      true,
+			errMsg:       "nome do recurso é obrigatório",
+		},
+		{
+			name:         "capacidade zero deve retornar erro",
+			resourceName: "Laser",
+			capacity:     0,
+			wantErr:      true,
+			errMsg:       "a capacidade deve ser maior que zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, err := NewResource(1, tt.resourceName, tt.capacity)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("esperado erro, mas nenhum foi retornado")
+				} else if err.Error() != tt.errMsg {
+					t.Errorf("mensagem de erro incorreta, esperado: '%s', obtido: '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("não esperava erro, mas obteve: %v", err)
+			}
+
+			if resource.Name() != tt.resourceName {
+				t.Errorf("Name esperado '%s', obtido '%s'", tt.resourceName, resource.Name())
+			}
+			if resource.Capacity() != tt.capacity {
+				t.Errorf("Capacity esperado %d, obtido %d", tt.capacity, resource.Capacity())
+			}
+			if resource.CreatedAt().IsZero() {
+				t.Error("CreatedAt não deve ser zero")
+			}
+		})
+	}
+}
+
+func TestResourceChangeCapacity(t *testing.T) {
+	resource, _ := NewResource(1, "Maca", 1)
+
+	if err := resource.ChangeCapacity(3); err != nil {
+		t.Errorf("não esperava erro, mas obteve: %v", err)
+	}
+	if resource.Capacity() != 3 {
+		t.Errorf("Capacity esperado 3, obtido %d", resource.Capacity())
+	}
+	if err := resource.ChangeCapacity(0); err == nil {
+		t.Error("esperado erro para capacidade zero")
+	}
+}
+
+func TestNewResourceRequirement(t *testing.T) {
+	tests := []struct {
+		name       string
+		serviceID  int
+		resourceID int
+		quantity   int
+		wantErr    bool
+		errMsg     string
+	}{
+		{"requisito válido", 1, 2, 1, false, ""},
+		{"serviço ausente", 0, 2, 1, true, "serviço e recurso são obrigatórios"},
+		{"recurso ausente", 1, 0, 1, true, "serviço e recurso são obrigatórios"},
+		{"quantidade zero", 1, 2, 0, true, "a quantidade deve ser maior que zero"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := NewResourceRequirement(tt.serviceID, tt.resourceID, tt.quantity)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("esperado erro, mas nenhum foi retornado")
+				} else if err.Error() != tt.errMsg {
+					t.Errorf("mensagem de erro incorreta, esperado: '%s', obtido: '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("não esperava erro, mas obteve: %v", err)
+			}
+			if req.ServiceID() != tt.serviceID || req.ResourceID() != tt.resourceID || req.Quantity() != tt.quantity {
+				t.Errorf("requisito com valores incorretos: %+v", req)
+			}
+		})
+	}
+}
+
+func TestNewResourceAllocation(t *testing.T) {
+	start := time.Date(2030, 1, 7, 10, 0, 0, 0, time.UTC)
+
+	if _, err := NewResourceAllocation(1, 1, start, start.Add(time.Hour)); err != nil {
+		t.Errorf("não esperava erro, mas obteve: %v", err)
+	}
+	if _, err := NewResourceAllocation(1, 1, start, start); err == nil {
+		t.Error("esperado erro para intervalo vazio")
+	}
+	if _, err := NewResourceAllocation(0, 1, start, start.Add(time.Hour)); err == nil {
+		t.Error("esperado erro para recurso ausente")
+	}
+}
+
+func TestPeakUsage(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2030, 1, 7, hour, minute, 0, 0, time.UTC)
+	}
+	alloc := func(start, end time.Time, quantity int) *ResourceAllocation {
+		a, err := NewResourceAllocation(1, quantity, start, end)
+		if err != nil {
+			t.Fatalf("falha ao criar alocação: %v", err)
+		}
+		return a
+	}
+
+	allocations := []*ResourceAllocation{
+		alloc(at(9, 0), at(10, 0), 1),
+		alloc(at(9, 30), at(11, 0), 1),
+		alloc(at(10, 30), at(12, 0), 2),
+	}
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		want  int
+	}{
+		{"antes de qualquer uso", at(8, 0), at(9, 0), 0},
+		{"uso simples", at(9, 0), at(9, 30), 1},
+		{"duas alocações simultâneas", at(9, 0), at(10, 0), 2},
+		{"pico dentro do intervalo", at(10, 0), at(11, 30), 3},
+		{"depois do término", at(12, 0), at(13, 0), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PeakUsage(allocations, tt.start, tt.end); got != tt.want {
+				t.Errorf("PeakUsage() = %d, esperado %d", got, tt.want)
+			}
+		})
+	}
+}
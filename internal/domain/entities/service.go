@@ -1,8 +1,9 @@
 package entities
 
 import (
-	"errors"
 	"time"
+
+	"scheduling/internal/domain/errs"
 )
 
 type Service struct {
@@ -16,13 +17,13 @@ type Service struct {
 
 func NewService(id, staffID int, name string, durationMinutes int, price float64) (*Service, error) {
 	if name == "" {
-		return nil, errors.New("nome do serviço é obrigatório")
+		return nil, errs.Validation("service.name_required")
 	}
 	if durationMinutes <= 0 {
-		return nil, errors.New("a duração deve ser maior que zero")
+		return nil, errs.Validation("service.non_positive_duration")
 	}
 	if price < 0 {
-		return nil, errors.New("preço não pode ser negativo")
+		return nil, errs.Validation("service.negative_price")
 	}
 
 	return &Service{
@@ -44,7 +45,7 @@ func (s *Service) CreatedAt() time.Time { return s.createdAt }
 
 func (s *Service) ChangePrice(newPrice float64) error {
 	if newPrice < 0 {
-		return errors.New("preço não pode ser negativo")
+		return errs.Validation("service.negative_price")
 	}
 	s.price = newPrice
 	return nil
@@ -52,7 +53,7 @@ func (s *Service) ChangePrice(newPrice float64) error {
 
 func (s *Service) ChangeDuration(newDuration int) error {
 	if newDuration <= 0 {
-		return errors.New("duração inválida")
+		return errs.Validation("service.invalid_duration")
 	}
 	s.durationMinutes = newDuration
 	return nil
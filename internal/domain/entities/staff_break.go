@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+// StaffBreak representa uma pausa recorrente dentro do expediente.
+// Quando durationMinutes é menor que a janela, a pausa é flutuante e
+// o motor de disponibilidade escolhe onde encaixá-la.
+type StaffBreak struct {
+	id              int
+	staffID         int
+	weekday         Weekday
+	startTime       time.Time
+	endTime         time.Time
+	durationMinutes int
+}
+
+func NewStaffBreak(staffID int, weekday Weekday, start, end time.Time, durationMinutes int) (*StaffBreak, error) {
+	if staffID == 0 {
+		return nil, errs.Validation("staff.id_required")
+	}
+	if !isValidWeekday(weekday) {
+		return nil, errs.Validation("weekday.invalid").With("weekday", weekday)
+	}
+	if !start.Before(end) {
+		return nil, errs.Validation("time_range.invalid")
+	}
+	if durationMinutes < 0 {
+		return nil, errs.Validation("staff_break.negative_duration")
+	}
+
+	window := int(end.Sub(start).Minutes())
+	if durationMinutes == 0 {
+		durationMinutes = window
+	}
+	if durationMinutes > window {
+		return nil, errs.Validation("staff_break.duration_exceeds_window")
+	}
+
+	return &StaffBreak{
+		staffID:         staffID,
+		weekday:         weekday,
+		startTime:       start,
+		endTime:         end,
+		durationMinutes: durationMinutes,
+	}, nil
+}
+
+func (b *StaffBreak) IsFloating() bool {
+	return b.durationMinutes < int(b.endTime.Sub(b.startTime).Minutes())
+}
+
+func (b *StaffBreak) Duration() time.Duration {
+	return time.Duration(b.durationMinutes) * time.Minute
+}
+
+func (b *StaffBreak) SetID(id int)         { b.id = id }
+func (b *StaffBreak) ID() int              { return b.id }
+func (b *StaffBreak) StaffID() int         { return b.staffID }
+func (b *StaffBreak) Weekday() Weekday     { return b.weekday }
+func (b *StaffBreak) StartTime() time.Time { return b.startTime }
+func (b *StaffBreak) EndTime() time.Time   { return b.endTime }
+func (b *StaffBreak) DurationMinutes() int { return b.durationMinutes }
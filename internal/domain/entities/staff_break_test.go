@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewStaffBreak(t *testing.T) {
+	start := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+
+	tests := []struct {
+		name         string
+		staffID      int
+		weekday      Weekday
+		startTime    time.Time
+		endTime      time.Time
+		duration     int
+		wantErr      bool
+		errMsg       string
+		wantDuration int
+		wantFloating bool
+	}{
+		{
+			name:         "pausa fixa sem duração informada",
+			staffID:      1,
+			weekday:      Monday,
+			startTime:    start,
+			endTime:      start.Add(time.Hour),
+			duration:     0,
+			wantDuration: 60,
+			wantFloating: false,
+		},
+		{
+			name:         "pausa flutuante de 30 minutos entre 12h e 14h",
+			staffID:      1,
+			weekday:      Tuesday,
+			startTime:    start,
+			endTime:      end,
+			duration:     30,
+			wantDuration: 30,
+			wantFloating: true,
+		},
+		{
+			name:         "duração igual à janela é pausa fixa",
+			staffID:      1,
+			weekday:      Tuesday,
+			startTime:    start,
+			endTime:      end,
+			duration:     120,
+			wantDuration: 120,
+			wantFloating: false,
+		},
+		{
+			name:      "staffID zero deve retornar erro",
+			staffID:   0,
+			weekday:   Monday,
+			startTime: start,
+			endTime:   end,
+			wantErr:   true,
+			errMsg:    "staffID é obrigatório",
+		},
+		{
+			name:      "dia da semana inválido deve retornar erro",
+			staffID:   1,
+			weekday:   Weekday("invalid"),
+			startTime: start,
+			endTime:   end,
+			wantErr:   true,
+			errMsg:    "dia da semana inválido: invalid",
+		},
+		{
+			name:      "horário final antes do inicial deve retornar erro",
+			staffID:   1,
+			weekday:   Monday,
+			startTime: end,
+			endTime:   start,
+			wantErr:   true,
+			errMsg:    "o horário inicial deve ser antes do final",
+		},
+		{
+			name:      "duração negativa deve retornar erro",
+			staffID:   1,
+			weekday:   Monday,
+			startTime: start,
+			endTime:   end,
+			duration:  -10,
+			wantErr:   true,
+			errMsg:    "a duração da pausa não pode ser negativa",
+		},
+		{
+			name:      "duração maior que a janela deve retornar erro",
+			staffID:   1,
+			weekday:   Monday,
+			startTime: start,
+			endTime:   end,
+			duration:  150,
+			wantErr:   true,
+			errMsg:    "a duração da pausa não cabe na janela informada",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := NewStaffBreak(tt.staffID, tt.weekday, tt.startTime, tt.endTime, tt.duration)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("esperado erro, mas nenhum foi retornado")
+				} else if err.Error() != tt.errMsg {
+					t.Errorf("mensagem de erro incorreta, esperado: '%s', obtido: '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("não esperava erro, mas obteve: %v", err)
+			}
+
+			if b.StaffID() != tt.staffID {
+				t.Errorf("StaffID esperado %d, obtido %d", tt.staffID, b.StaffID())
+			}
+			if b.Weekday() != tt.weekday {
+				t.Errorf("Weekday esperado %s, obtido %s", tt.weekday, b.Weekday())
+			}
+			if b.DurationMinutes() != tt.wantDuration {
+				t.Errorf("DurationMinutes esperado %d, obtido %d", tt.wantDuration, b.DurationMinutes())
+			}
+			if b.Duration() != time.Duration(tt.wantDuration)*time.Minute {
+				t.Errorf("Duration esperado %d minutos, obtido %v", tt.wantDuration, b.Duration())
+			}
+			if b.IsFloating() != tt.wantFloating {
+				t.Errorf("IsFloating esperado %v, obtido %v", tt.wantFloating, b.IsFloating())
+			}
+		})
+	}
+}
+
+func TestStaffBreakSetID(t *testing.T) {
+	start := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	b, err := NewStaffBreak(1, Friday, start, start.Add(time.Hour), 0)
+	if err != nil {
+		t.Fatalf("não esperava erro, mas obteve: %v", err)
+	}
+
+	b.SetID(42)
+
+	if got := b.ID(); got != 42 {
+		t.Errorf("ID() = %d, esperado 42 após SetID", got)
+	}
+}
@@ -0,0 +1,42 @@
+package entities
+
+import (
+	"regexp"
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+var tenantSlugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// Tenant é um negócio independente hospedado na mesma instalação. O slug é
+// usado como subdomínio.
+type Tenant struct {
+	id        int
+	name      string
+	slug      string
+	createdAt time.Time
+}
+
+func NewTenant(id int, name, slug string) (*Tenant, error) {
+	if name == "" {
+		return nil, errs.Validation("tenant.name_required")
+	}
+	if !tenantSlugPattern.MatchString(slug) {
+		return nil, errs.Validation("tenant.invalid_slug")
+	}
+
+	return &Tenant{
+		id:        id,
+		name:      name,
+		slug:      slug,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func (t *Tenant) SetID(id int)             { t.id = id }
+func (t *Tenant) SetCreatedAt(c time.Time) { t.createdAt = c }
+func (t *Tenant) ID() int                  { return t.id }
+func (t *Tenant) Name() string             { return t.name }
+func (t *Tenant) Slug() string             { return t.slug }
+func (t *Tenant) CreatedAt() time.Time     { return t.createdAt }
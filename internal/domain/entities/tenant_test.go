@@ -0,0 +1,41 @@
+package entities
+
+import "testing"
+
+func TestNewTenant(t *testing.T) {
+	tests := []struct {
+		name       string
+		tenantName string
+		slug       string
+		wantErr    bool
+		errMsg     string
+	}{
+		{"criar negócio válido", "Barbearia Centro", "barbearia-centro", false, ""},
+		{"nome vazio", "", "barbearia", true, "nome do negócio é obrigatório"},
+		{"slug com maiúsculas", "Barbearia", "Barbearia", true, "slug inválido: use letras minúsculas, números e hífen"},
+		{"slug terminando em hífen", "Barbearia", "barbearia-", true, "slug inválido: use letras minúsculas, números e hífen"},
+		{"slug vazio", "Barbearia", "", true, "slug inválido: use letras minúsculas, números e hífen"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenant, err := NewTenant(1, tt.tenantName, tt.slug)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Error("esperado erro, mas nenhum foi retornado")
+				} else if err.Error() != tt.errMsg {
+					t.Errorf("mensagem de erro incorreta, esperado: '%s', obtido: '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("não esperava erro, mas obteve: %v", err)
+			}
+			if tenant.Slug() != tt.slug {
+				t.Errorf("Slug esperado '%s', obtido '%s'", tt.slug, tenant.Slug())
+			}
+		})
+	}
+}
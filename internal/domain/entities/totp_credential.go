@@ -0,0 +1,58 @@
+package entities
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+// TOTPCredential é o segredo do aplicativo autenticador de um usuário. Só
+// passa a ser exigido no login depois que o cadastro é confirmado com um
+// código válido. lastUsedStep guarda a última janela aceita para que o mesmo
+// código não seja usado duas vezes.
+type TOTPCredential struct {
+	userID       int
+	secret       string
+	enabledAt    *time.Time
+	lastUsedStep int64
+	createdAt    time.Time
+}
+
+func NewTOTPCredential(userID int, secret string) (*TOTPCredential, error) {
+	if userID <= 0 {
+		return nil, errs.Validation("mfa.user_required")
+	}
+	if secret == "" {
+		return nil, errs.Validation("mfa.secret_required")
+	}
+
+	return &TOTPCredential{
+		userID:    userID,
+		secret:    secret,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RebuildTOTPCredential(userID int, secret string, enabledAt *time.Time, lastUsedStep int64, createdAt time.Time) *TOTPCredential {
+	return &TOTPCredential{
+		userID:       userID,
+		secret:       secret,
+		enabledAt:    enabledAt,
+		lastUsedStep: lastUsedStep,
+		createdAt:    createdAt,
+	}
+}
+
+func (c *TOTPCredential) Enable(at time.Time) {
+	if c.enabledAt == nil {
+		c.enabledAt = &at
+	}
+}
+
+func (c *TOTPCredential) IsEnabled() bool { return c.enabledAt != nil }
+
+func (c *TOTPCredential) UserID() int           { return c.userID }
+func (c *TOTPCredential) Secret() string        { return c.secret }
+func (c *TOTPCredential) EnabledAt() *time.Time { return c.enabledAt }
+func (c *TOTPCredential) LastUsedStep() int64   { return c.lastUsedStep }
+func (c *TOTPCredential) CreatedAt() time.Time  { return c.createdAt }
@@ -1,35 +1,43 @@
 package entities
 
 import (
-	"errors"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/i18n"
+	"scheduling/internal/domain/password"
 	"scheduling/internal/domain/valueobject"
 	"time"
 )
 
 const (
 	RoleClient = "client"
+	RoleStaff  = "staff"
 	RoleAdmin  = "admin"
 )
 
+var ErrPasswordTooShort = errs.Validation("user.password_too_short")
+
 type User struct {
-	id        int
-	name      string
-	email     valueobject.Email
-	password  string
-	role      string
-	createdAt time.Time
+	id              int
+	name            string
+	email           valueobject.Email
+	password        string
+	hash            string
+	role            string
+	emailVerifiedAt *time.Time
+	locale          i18n.Locale
+	createdAt       time.Time
 }
 
 func NewUser(id int, name string, emailStr, password, role string) (*User, error) {
 	if name == "" {
-		return nil, errors.New("nome é obrigatório")
+		return nil, errs.Validation("user.name_required")
 	}
 
-	if len(password) < 6 {
-		return nil, errors.New("a senha deve ter ao menos 6 caracteres")
+	if err := ValidatePassword(password); err != nil {
+		return nil, err
 	}
-	if role != RoleClient && role != RoleAdmin {
-		return nil, errors.New("papel inválido")
+	if role != RoleClient && role != RoleStaff && role != RoleAdmin {
+		return nil, errs.Validation("user.invalid_role")
 	}
 
 	email, err := valueobject.NewEmail(emailStr)
@@ -47,6 +55,36 @@ func NewUser(id int, name string, emailStr, password, role string) (*User, error
 	}, nil
 }
 
+// NewExternalUser cria a conta de cliente de quem entrou por um provedor
+// externo (OIDC). A conta nasce sem senha, então só entra pelo provedor até
+// que uma senha seja definida.
+func NewExternalUser(name string, emailStr string) (*User, error) {
+	if name == "" {
+		name = emailStr
+	}
+
+	email, err := valueobject.NewEmail(emailStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &User{
+		name:      name,
+		email:     email,
+		role:      RoleClient,
+		createdAt: time.Now(),
+	}, nil
+}
+
+// ValidatePassword aplica as regras de senha do cadastro, também usadas na
+// redefinição de senha.
+func ValidatePassword(plain string) error {
+	if len(plain) < 6 {
+		return ErrPasswordTooShort
+	}
+	return nil
+}
+
 func RebuildUser(id int, name string, email valueobject.Email, role string) *User {
 	return &User{
 		id:    id,
@@ -56,10 +94,51 @@ func RebuildUser(id int, name string, email valueobject.Email, role string) *Use
 	}
 }
 
+func (u *User) SetID(id int) {
+	u.id = id
+}
+
 func (u *User) SetCreatedAt(t time.Time) {
 	u.createdAt = t
 }
 
+// MarkEmailVerified registra que o usuário comprovou ser dono do email.
+// Verificações posteriores mantêm a data da primeira.
+func (u *User) MarkEmailVerified(at time.Time) {
+	if u.emailVerifiedAt == nil {
+		u.emailVerifiedAt = &at
+	}
+}
+
+// SetLocale define o idioma preferido do usuário, usado nas mensagens e
+// notificações. Vazio remove a preferência.
+func (u *User) SetLocale(tag string) error {
+	locale, err := ParseLocale(tag)
+	if err != nil {
+		return err
+	}
+	u.locale = locale
+	return nil
+}
+
+// ParseLocale valida a preferência de idioma de um usuário. Vazio é aceito e
+// significa sem preferência.
+func ParseLocale(tag string) (i18n.Locale, error) {
+	if tag == "" {
+		return "", nil
+	}
+
+	locale, ok := i18n.Parse(tag)
+	if !ok {
+		return "", errs.Validation("user.invalid_locale").With("locale", tag)
+	}
+	return locale, nil
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.emailVerifiedAt != nil
+}
+
 func (u *User) CanAccessAdminPanel() bool {
 	return u.role == RoleAdmin
 }
@@ -68,13 +147,37 @@ func (u *User) IsClient() bool {
 	return u.role == RoleClient
 }
 
-func (u *User) CheckPassword(password string) bool {
-	return u.password == password
+// HashPassword substitui a senha informada em NewUser pelo seu hash. A senha
+// em texto puro não fica guardada na entidade depois disso.
+func (u *User) HashPassword(hasher password.Hasher) error {
+	hash, err := hasher.Hash(u.password)
+	if err != nil {
+		return err
+	}
+	u.hash = hash
+	u.password = ""
+	return nil
+}
+
+func (u *User) SetPasswordHash(hash string) {
+	u.hash = hash
+}
+
+func (u *User) CheckPassword(hasher password.Hasher, plain string) (bool, error) {
+	if u.hash == "" {
+		return false, nil
+	}
+	return hasher.Verify(u.hash, plain)
 }
 
 func (u *User) ID() int              { return u.id }
 func (u *User) Name() string         { return u.name }
 func (u *User) Email() string        { return u.email.String() }
-func (u *User) Password() string     { return u.password }
+func (u *User) PasswordHash() string { return u.hash }
 func (u *User) Role() string         { return u.role }
 func (u *User) CreatedAt() time.Time { return u.createdAt }
+
+func (u *User) EmailVerifiedAt() *time.Time { return u.emailVerifiedAt }
+
+// Locale é o idioma preferido, vazio quando o usuário não escolheu nenhum.
+func (u *User) Locale() i18n.Locale { return u.locale }
@@ -1,11 +1,19 @@
 package entities
 
 import (
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/i18n"
 	"scheduling/internal/domain/valueobject"
 	"testing"
 	"time"
 )
 
+type fakeHasher struct{}
+
+func (fakeHasher) Hash(plain string) (string, error)       { return "hash:" + plain, nil }
+func (fakeHasher) Verify(hash, plain string) (bool, error) { return hash == "hash:"+plain, nil }
+func (fakeHasher) NeedsRehash(hash string) bool            { return false }
+
 func TestNewUser(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -39,10 +47,13 @@ func TestNewUser(t *testing.T) {
 				if u.Role() != RoleClient {
 					t.Errorf("função esperada 'cliente', obtida'%s'", u.Role())
 				}
-				if !u.CheckPassword("senha123") {
+				if err := u.HashPassword(fakeHasher{}); err != nil {
+					t.Fatalf("falha ao gerar hash da senha: %v", err)
+				}
+				if ok, _ := u.CheckPassword(fakeHasher{}, "senha123"); !ok {
 					t.Error("falha na verificação da senha para senha correta")
 				}
-				if u.CheckPassword("wrong") {
+				if ok, _ := u.CheckPassword(fakeHasher{}, "wrong"); ok {
 					t.Error("verificação de senha aprovada para senha errada")
 				}
 				if u.CreatedAt().IsZero() {
@@ -188,6 +199,12 @@ func TestUserRoles(t *testing.T) {
 			wantAdmin:  false,
 			wantClient: true,
 		},
+		{
+			name:       "perfil de profissional",
+			role:       RoleStaff,
+			wantAdmin:  false,
+			wantClient: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -226,10 +243,21 @@ func TestUserMethods(t *testing.T) {
 			t.Fatalf("falha ao criar usuário: %v", err)
 		}
 
-		if !user.CheckPassword("senha_correta") {
+		if ok, _ := user.CheckPassword(fakeHasher{}, "senha_correta"); ok {
+			t.Error("CheckPassword() = true antes do hash, esperado false")
+		}
+
+		if err := user.HashPassword(fakeHasher{}); err != nil {
+			t.Fatalf("falha ao gerar hash da senha: %v", err)
+		}
+		if user.PasswordHash() != "hash:senha_correta" {
+			t.Errorf("PasswordHash() = %s, esperado hash:senha_correta", user.PasswordHash())
+		}
+
+		if ok, _ := user.CheckPassword(fakeHasher{}, "senha_correta"); !ok {
 			t.Error("CheckPassword() = false para senha correta, esperado true")
 		}
-		if user.CheckPassword("senha_errada") {
+		if ok, _ := user.CheckPassword(fakeHasher{}, "senha_errada"); ok {
 			t.Error("CheckPassword() = true para senha errada, esperado false")
 		}
 	})
@@ -282,3 +310,53 @@ func TestUserGetters(t *testing.T) {
 		}
 	})
 }
+
+func TestNewExternalUser(t *testing.T) {
+	user, err := NewExternalUser("Maria", "maria@gmail.com")
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if user.Role() != RoleClient {
+		t.Errorf("papel esperado '%s', obtido '%s'", RoleClient, user.Role())
+	}
+
+	// conta externa não tem senha e não entra por email e senha
+	if ok, _ := user.CheckPassword(fakeHasher{}, ""); ok {
+		t.Error("conta externa não deveria aceitar senha")
+	}
+
+	unnamed, _ := NewExternalUser("", "joao@gmail.com")
+	if unnamed.Name() != "joao@gmail.com" {
+		t.Errorf("sem nome, o email deveria ser usado como nome; obtido '%s'", unnamed.Name())
+	}
+
+	if _, err := NewExternalUser("Maria", "invalido"); err == nil {
+		t.Error("email inválido deveria retornar erro")
+	}
+}
+
+func TestUserSetLocale(t *testing.T) {
+	user := RebuildUser(1, "Maria", valueobject.Email{}, RoleClient)
+	if user.Locale() != "" {
+		t.Errorf("usuário novo não deveria ter idioma, obtido '%s'", user.Locale())
+	}
+
+	if err := user.SetLocale("en-US"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if user.Locale() != i18n.En {
+		t.Errorf("idioma esperado '%s', obtido '%s'", i18n.En, user.Locale())
+	}
+
+	if err := user.SetLocale("fr"); errs.KindOf(err) != errs.KindValidation {
+		t.Errorf("idioma não suportado deveria ser erro de validação, obtido %v", err)
+	}
+	if user.Locale() != i18n.En {
+		t.Errorf("idioma inválido não deveria alterar a preferência, obtido '%s'", user.Locale())
+	}
+
+	_ = user.SetLocale("")
+	if user.Locale() != "" {
+		t.Errorf("vazio deveria remover a preferência, obtido '%s'", user.Locale())
+	}
+}
@@ -0,0 +1,70 @@
+package entities
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+const (
+	TokenPurposePasswordReset     = "password_reset"
+	TokenPurposeEmailVerification = "email_verification"
+	TokenPurposeMFAChallenge      = "mfa_challenge"
+)
+
+// UserToken é um token de uso único enviado por email para redefinir a senha
+// ou confirmar o endereço, ou entregue no login para a segunda etapa da
+// autenticação. Como no refresh token, só o hash é guardado.
+type UserToken struct {
+	id        int
+	userID    int
+	purpose   string
+	tokenHash string
+	expiresAt time.Time
+	usedAt    *time.Time
+	createdAt time.Time
+}
+
+func NewUserToken(userID int, purpose, tokenHash string, expiresAt time.Time) (*UserToken, error) {
+	if userID <= 0 {
+		return nil, errs.Validation("user_token.user_required")
+	}
+	if purpose != TokenPurposePasswordReset && purpose != TokenPurposeEmailVerification && purpose != TokenPurposeMFAChallenge {
+		return nil, errs.Validation("user_token.invalid_purpose")
+	}
+	if tokenHash == "" {
+		return nil, errs.Validation("user_token.hash_required")
+	}
+
+	return &UserToken{
+		userID:    userID,
+		purpose:   purpose,
+		tokenHash: tokenHash,
+		expiresAt: expiresAt,
+		createdAt: time.Now(),
+	}, nil
+}
+
+func RebuildUserToken(id, userID int, purpose, tokenHash string, expiresAt time.Time, usedAt *time.Time, createdAt time.Time) *UserToken {
+	return &UserToken{
+		id:        id,
+		userID:    userID,
+		purpose:   purpose,
+		tokenHash: tokenHash,
+		expiresAt: expiresAt,
+		usedAt:    usedAt,
+		createdAt: createdAt,
+	}
+}
+
+func (t *UserToken) IsExpired(now time.Time) bool { return !now.Before(t.expiresAt) }
+func (t *UserToken) IsUsed() bool                 { return t.usedAt != nil }
+
+func (t *UserToken) SetID(id int)         { t.id = id }
+func (t *UserToken) ID() int              { return t.id }
+func (t *UserToken) UserID() int          { return t.userID }
+func (t *UserToken) Purpose() string      { return t.purpose }
+func (t *UserToken) TokenHash() string    { return t.tokenHash }
+func (t *UserToken) ExpiresAt() time.Time { return t.expiresAt }
+func (t *UserToken) UsedAt() *time.Time   { return t.usedAt }
+func (t *UserToken) CreatedAt() time.Time { return t.createdAt }
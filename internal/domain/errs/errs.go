@@ -0,0 +1,161 @@
+// Package errs classifica os erros do domínio para que as camadas externas
+// saibam como tratá-los sem conhecer cada erro. A camada HTTP, por exemplo,
+// converte o tipo em status da resposta.
+//
+// Cada erro tem um código estável (ex.: "user.name_required") e parâmetros;
+// o texto vem do catálogo de i18n, no idioma de quem recebe a resposta.
+package errs
+
+import (
+	"errors"
+
+	"scheduling/internal/domain/i18n"
+)
+
+type Kind int
+
+const (
+	// KindInternal é o tipo dos erros não classificados, como falhas de banco.
+	// A mensagem deles não deve chegar ao usuário.
+	KindInternal Kind = iota
+	KindValidation
+	KindNotFound
+	KindConflict
+	KindForbidden
+	KindUnauthorized
+	KindRateLimited
+	// KindUnprocessable é o tipo das entradas válidas que o domínio recusa
+	// por uma regra de negócio, como uma data além do prazo de agendamento.
+	KindUnprocessable
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindValidation:
+		return "validation"
+	case KindNotFound:
+		return "not_found"
+	case KindConflict:
+		return "conflict"
+	case KindForbidden:
+		return "forbidden"
+	case KindUnauthorized:
+		return "unauthorized"
+	case KindRateLimited:
+		return "rate_limited"
+	case KindUnprocessable:
+		return "unprocessable"
+	default:
+		return "internal"
+	}
+}
+
+// Error é um erro do domínio com o seu tipo e código. Erros sentinela criados
+// com os construtores abaixo continuam comparáveis com errors.Is, inclusive
+// depois de receber parâmetros com With.
+type Error struct {
+	kind   Kind
+	code   string
+	params i18n.Params
+	err    error
+	fields []FieldError
+}
+
+// FieldError aponta o problema de um campo da entrada.
+type FieldError struct {
+	Field  string
+	Code   string
+	Params i18n.Params
+}
+
+func New(kind Kind, code string) *Error {
+	return &Error{kind: kind, code: code}
+}
+
+// Wrap classifica um erro já existente com um código, mantendo-o acessível
+// por errors.Is e errors.As.
+func Wrap(kind Kind, code string, err error) *Error {
+	return &Error{kind: kind, code: code, err: err}
+}
+
+// Invalid cria um erro de validação com os problemas de cada campo.
+func Invalid(code string, fields ...FieldError) *Error {
+	return &Error{kind: KindValidation, code: code, fields: fields}
+}
+
+func Validation(code string) *Error    { return New(KindValidation, code) }
+func NotFound(code string) *Error      { return New(KindNotFound, code) }
+func Conflict(code string) *Error      { return New(KindConflict, code) }
+func Forbidden(code string) *Error     { return New(KindForbidden, code) }
+func Unauthorized(code string) *Error  { return New(KindUnauthorized, code) }
+func RateLimited(code string) *Error   { return New(KindRateLimited, code) }
+func Unprocessable(code string) *Error { return New(KindUnprocessable, code) }
+
+// With devolve uma cópia do erro com o parâmetro da mensagem. A cópia envolve
+// o original, então errors.Is(err.With(...), err) continua verdadeiro.
+func (e *Error) With(key string, value any) *Error {
+	params := i18n.Params{key: value}
+	for k, v := range e.params {
+		if _, ok := params[k]; !ok {
+			params[k] = v
+		}
+	}
+
+	copied := *e
+	copied.params = params
+	copied.err = e
+	return &copied
+}
+
+// Error devolve a mensagem no idioma padrão, usada em logs e testes.
+func (e *Error) Error() string { return e.Message(i18n.Default) }
+func (e *Error) Unwrap() error { return e.err }
+func (e *Error) Kind() Kind    { return e.kind }
+func (e *Error) Code() string  { return e.code }
+
+func (e *Error) Params() i18n.Params  { return e.params }
+func (e *Error) Fields() []FieldError { return e.fields }
+
+func (e *Error) Message(locale i18n.Locale) string {
+	return i18n.Translate(locale, e.code, e.params)
+}
+
+// Message traduz err para o idioma. Erros não classificados não têm código e
+// voltam com a mensagem original.
+func Message(err error, locale i18n.Locale) string {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Message(locale)
+	}
+	return err.Error()
+}
+
+// KindOf retorna o tipo do primeiro erro classificado na cadeia de err, ou
+// KindInternal se nenhum for.
+func KindOf(err error) Kind {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.kind
+	}
+	return KindInternal
+}
+
+// CodeOf retorna o código do primeiro erro classificado na cadeia de err, ou
+// vazio se nenhum for.
+func CodeOf(err error) string {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.code
+	}
+	return ""
+}
+
+// FieldsOf retorna os problemas por campo do primeiro erro classificado na
+// cadeia de err.
+func FieldsOf(err error) []FieldError {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.fields
+	}
+	return nil
+}
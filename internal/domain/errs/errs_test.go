@@ -0,0 +1,93 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"scheduling/internal/domain/i18n"
+)
+
+func TestKindOf(t *testing.T) {
+	sentinel := NotFound("user.not_found")
+	cause := errors.New("json inválido")
+
+	tests := []struct {
+		name string
+		err  error
+		want Kind
+	}{
+		{"erro sem classificação", errors.New("connection refused"), KindInternal},
+		{"nil", nil, KindInternal},
+		{"sentinela", sentinel, KindNotFound},
+		{"sentinela envolvido com %w", fmt.Errorf("%w: 7", sentinel), KindNotFound},
+		{"sentinela com parâmetros", sentinel.With("id", 7), KindNotFound},
+		{"erro classificado com Wrap", Wrap(KindValidation, "request.invalid_body", cause), KindValidation},
+		{"conflito", Conflict("booking.slot_unavailable"), KindConflict},
+		{"acesso negado", Forbidden("auth.forbidden"), KindForbidden},
+		{"não autenticado", Unauthorized("auth.invalid_token"), KindUnauthorized},
+		{"excesso de tentativas", RateLimited("auth.too_many_login_attempts"), KindRateLimited},
+		{"regra de negócio", Unprocessable("booking.beyond_horizon"), KindUnprocessable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KindOf(tt.err); got != tt.want {
+				t.Errorf("tipo esperado %s, obtido %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	cause := errors.New("unexpected EOF")
+	err := Wrap(KindValidation, "request.invalid_body", cause)
+
+	if !errors.Is(err, cause) {
+		t.Error("Wrap deveria manter o erro original na cadeia")
+	}
+	if err.Error() != "corpo da requisição inválido" {
+		t.Errorf("mensagem esperada do catálogo, obtida %q", err.Error())
+	}
+}
+
+func TestWith(t *testing.T) {
+	sentinel := Conflict("user.email_taken")
+	err := sentinel.With("email", "ana@gmail.com")
+
+	if !errors.Is(err, sentinel) {
+		t.Error("With deveria manter o sentinela na cadeia")
+	}
+	if sentinel.Params() != nil {
+		t.Errorf("With não deveria alterar o sentinela, obtido %v", sentinel.Params())
+	}
+	if err.Error() != "email já existe: ana@gmail.com" {
+		t.Errorf("mensagem incorreta: %q", err.Error())
+	}
+	if CodeOf(fmt.Errorf("ao criar: %w", err)) != "user.email_taken" {
+		t.Errorf("código incorreto: %q", CodeOf(err))
+	}
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		locale i18n.Locale
+		want   string
+	}{
+		{"português", Validation("user.name_required"), i18n.PtBR, "nome é obrigatório"},
+		{"inglês", Validation("user.name_required"), i18n.En, "name is required"},
+		{"com parâmetros", Validation("weekday.invalid").With("weekday", "funday"), i18n.En, "invalid weekday: funday"},
+		{"envolvido com %w", fmt.Errorf("%w: 10h", Conflict("booking.slot_unavailable")), i18n.En, "time slot unavailable for booking"},
+		{"sem classificação", errors.New("connection refused"), i18n.En, "connection refused"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Message(tt.err, tt.locale); got != tt.want {
+				t.Errorf("mensagem esperada %q, obtida %q", tt.want, got)
+			}
+		})
+	}
+}
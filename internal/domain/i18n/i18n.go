@@ -0,0 +1,156 @@
+// Package i18n traduz os códigos de mensagem do domínio (erros, notificações)
+// para os idiomas atendidos. Os textos ficam nos catálogos em locales/, um
+// arquivo JSON por idioma, com parâmetros no formato {nome}.
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Locale string
+
+const (
+	PtBR Locale = "pt-BR"
+	En   Locale = "en"
+
+	// Default é o idioma usado quando nem o usuário nem a requisição indicam
+	// um idioma atendido, e o idioma de err.Error() nos erros do domínio.
+	Default = PtBR
+)
+
+// Params são os valores que substituem os {nome} da mensagem.
+type Params map[string]any
+
+//go:embed locales/*.json
+var catalogFiles embed.FS
+
+var catalogs = mustLoadCatalogs()
+
+func mustLoadCatalogs() map[Locale]map[string]string {
+	loaded := map[Locale]map[string]string{}
+	for _, locale := range Supported() {
+		data, err := catalogFiles.ReadFile("locales/" + string(locale) + ".json")
+		if err != nil {
+			panic(fmt.Sprintf("catálogo %s não encontrado: %v", locale, err))
+		}
+
+		var messages map[string]string
+		if err := json.Unmarshal(data, &messages); err != nil {
+			panic(fmt.Sprintf("catálogo %s inválido: %v", locale, err))
+		}
+		loaded[locale] = messages
+	}
+	return loaded
+}
+
+func Supported() []Locale {
+	return []Locale{PtBR, En}
+}
+
+// Translate devolve a mensagem de code no idioma, caindo para o idioma padrão
+// e, por fim, para o próprio código quando não há tradução.
+func Translate(locale Locale, code string, params Params) string {
+	message, ok := catalogs[locale][code]
+	if !ok {
+		message, ok = catalogs[Default][code]
+	}
+	if !ok {
+		return code
+	}
+	return interpolate(message, params)
+}
+
+// Has indica se o código tem mensagem no catálogo do idioma padrão.
+func Has(code string) bool {
+	_, ok := catalogs[Default][code]
+	return ok
+}
+
+func interpolate(message string, params Params) string {
+	if len(params) == 0 {
+		return message
+	}
+
+	// ordem fixa para que a saída não dependa da ordem do mapa
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(params)*2)
+	for _, key := range keys {
+		pairs = append(pairs, "{"+key+"}", fmt.Sprint(params[key]))
+	}
+	return strings.NewReplacer(pairs...).Replace(message)
+}
+
+// Parse reconhece um idioma atendido a partir de uma tag como "en-US",
+// "pt_br" ou "PT". Só o idioma principal é considerado.
+func Parse(tag string) (Locale, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	primary, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+
+	for _, locale := range Supported() {
+		supported, _, _ := strings.Cut(strings.ToLower(string(locale)), "-")
+		if primary == supported {
+			return locale, true
+		}
+	}
+	return "", false
+}
+
+// Negotiate escolhe o idioma atendido de maior preferência no header
+// Accept-Language, ou Default se nenhum for atendido.
+func Negotiate(acceptLanguage string) Locale {
+	best, bestQuality := Default, 0.0
+
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, quality := parseLanguageRange(part)
+		locale, ok := Parse(tag)
+		if ok && quality > bestQuality {
+			best, bestQuality = locale, quality
+		}
+	}
+	return best
+}
+
+func parseLanguageRange(part string) (string, float64) {
+	tag, params, _ := strings.Cut(part, ";")
+
+	quality := 1.0
+	if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return tag, 0
+		}
+		quality = parsed
+	}
+	return tag, quality
+}
+
+type contextKey struct{}
+
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, contextKey{}, locale)
+}
+
+// FromContext devolve o idioma escolhido para a requisição, ou Default se
+// nenhum foi definido.
+func FromContext(ctx context.Context) Locale {
+	if locale, ok := LocaleFrom(ctx); ok {
+		return locale
+	}
+	return Default
+}
+
+func LocaleFrom(ctx context.Context) (Locale, bool) {
+	locale, ok := ctx.Value(contextKey{}).(Locale)
+	return locale, ok
+}
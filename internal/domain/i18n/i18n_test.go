@@ -0,0 +1,87 @@
+package i18n
+
+import (
+	"context"
+	"regexp"
+	"testing"
+)
+
+func TestCatalogsHaveSameMessages(t *testing.T) {
+	placeholder := regexp.MustCompile(`\{\w+\}`)
+
+	for code, message := range catalogs[Default] {
+		for _, locale := range Supported() {
+			translated, ok := catalogs[locale][code]
+			if !ok {
+				t.Errorf("%s: código %q sem tradução", locale, code)
+				continue
+			}
+			want := placeholder.FindAllString(message, -1)
+			got := placeholder.FindAllString(translated, -1)
+			if len(want) != len(got) {
+				t.Errorf("%s: código %q deveria ter os parâmetros %v, tem %v", locale, code, want, got)
+			}
+		}
+	}
+	for _, locale := range Supported() {
+		if len(catalogs[locale]) != len(catalogs[Default]) {
+			t.Errorf("%s: %d mensagens, esperado %d", locale, len(catalogs[locale]), len(catalogs[Default]))
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale Locale
+		code   string
+		params Params
+		want   string
+	}{
+		{"português", PtBR, "user.name_required", nil, "nome é obrigatório"},
+		{"inglês", En, "user.name_required", nil, "name is required"},
+		{"parâmetros", En, "request.invalid_param", Params{"param": "staff_id"}, "invalid staff_id"},
+		{"idioma desconhecido usa o padrão", Locale("fr"), "user.name_required", nil, "nome é obrigatório"},
+		{"código desconhecido", En, "nao.existe", nil, "nao.existe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Translate(tt.locale, tt.code, tt.params); got != tt.want {
+				t.Errorf("esperado %q, obtido %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		header string
+		want   Locale
+	}{
+		{"", Default},
+		{"en-US,en;q=0.9", En},
+		{"pt-BR,pt;q=0.9,en;q=0.8", PtBR},
+		{"fr-FR, en;q=0.5", En},
+		{"en;q=0.3, pt;q=0.7", PtBR},
+		{"de, fr", Default},
+		{"en;q=abc, pt-PT", PtBR},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if got := Negotiate(tt.header); got != tt.want {
+				t.Errorf("esperado %s, obtido %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if got := FromContext(context.Background()); got != Default {
+		t.Errorf("sem idioma no contexto: esperado %s, obtido %s", Default, got)
+	}
+	if got := FromContext(WithLocale(context.Background(), En)); got != En {
+		t.Errorf("esperado %s, obtido %s", En, got)
+	}
+}
@@ -0,0 +1,53 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/i18n"
+)
+
+const (
+	KindPasswordReset     = "password_reset"
+	KindEmailVerification = "email_verification"
+)
+
+// Message é um aviso a ser entregue ao usuário com o link que ele precisa
+// abrir. Assunto e texto vêm do catálogo de i18n, no idioma de Locale; o
+// canal (email, SMS, ...) fica a cargo do Notifier.
+type Message struct {
+	Kind      string
+	TenantID  int
+	To        string
+	Name      string
+	Link      string
+	ExpiresAt time.Time
+	Locale    i18n.Locale
+}
+
+// expiresAtLayouts formata a validade do link como cada idioma costuma ler.
+var expiresAtLayouts = map[i18n.Locale]string{
+	i18n.PtBR: "02/01/2006 15:04 MST",
+	i18n.En:   "Jan 2, 2006 3:04 PM MST",
+}
+
+func (m Message) Subject() string {
+	return i18n.Translate(m.Locale, "notification."+m.Kind+".subject", nil)
+}
+
+func (m Message) Text() string {
+	layout, ok := expiresAtLayouts[m.Locale]
+	if !ok {
+		layout = expiresAtLayouts[i18n.Default]
+	}
+
+	return i18n.Translate(m.Locale, "notification."+m.Kind+".body", i18n.Params{
+		"name":       m.Name,
+		"link":       m.Link,
+		"expires_at": m.ExpiresAt.Format(layout),
+	})
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, message Message) error
+}
@@ -0,0 +1,10 @@
+package password
+
+// Hasher abstrai o algoritmo usado para guardar senhas. NeedsRehash indica
+// que o hash armazenado foi gerado com outro algoritmo ou com custo menor que
+// o atual e deve ser regravado no próximo login bem-sucedido.
+type Hasher interface {
+	Hash(plain string) (string, error)
+	Verify(hash, plain string) (bool, error)
+	NeedsRehash(hash string) bool
+}
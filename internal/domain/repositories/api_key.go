@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+// APIKeyRepository guarda as chaves de API do tenant do contexto. FindByHash
+// e FindByID retornam nil, nil quando a chave não existe. Revoke retorna false
+// quando a chave não existe ou já estava revogada. Expire encurta a validade
+// da chave substituída numa rotação.
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *entities.APIKey) error
+	FindByHash(ctx context.Context, keyHash string) (*entities.APIKey, error)
+	FindByID(ctx context.Context, id int) (*entities.APIKey, error)
+	List(ctx context.Context) ([]*entities.APIKey, error)
+	Revoke(ctx context.Context, id int) (bool, error)
+	Expire(ctx context.Context, id int, at time.Time) error
+	TouchLastUsed(ctx context.Context, id int, at time.Time) error
+}
@@ -1,16 +1,19 @@
 package repositories
 
 import (
+	"context"
 	"time"
 
 	"scheduling/internal/domain/entities"
 )
 
 type AppointmentRepository interface {
-	FindByID(id int) (*entities.Appointment, error)
-	FindAllByStaffID(staffID int) ([]*entities.Appointment, error)
-	HasConflict(staffID int, start, end time.Time) (bool, error)
-	Save(appointment *entities.Appointment) error
-	Update(appointment *entities.Appointment) error
-	Delete(id int) error
+	FindByID(ctx context.Context, id int) (*entities.Appointment, error)
+	FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.Appointment, error)
+	FindAllByClientID(ctx context.Context, clientID int) ([]*entities.Appointment, error)
+	FindScheduledByStaffAndDate(ctx context.Context, staffID int, date time.Time) ([]*entities.Appointment, error)
+	HasConflict(ctx context.Context, staffID int, start, end time.Time) (bool, error)
+	Save(ctx context.Context, appointment *entities.Appointment) error
+	Update(ctx context.Context, appointment *entities.Appointment) error
+	Delete(ctx context.Context, id int) error
 }
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+// AuditRepository grava o registro de auditoria do tenant do contexto. Os
+// registros nunca são alterados.
+type AuditRepository interface {
+	Record(ctx context.Context, entry *entities.AuditEntry) error
+}
@@ -1,18 +1,19 @@
 package repositories
 
 import (
+	"context"
 	"time"
 
 	"scheduling/internal/domain/entities"
 )
 
 type AvailableSlotRepository interface {
-	FindByID(id int) (*entities.AvailableSlot, error)
-	FindAllByStaffID(staffID int) ([]*entities.AvailableSlot, error)
-	FindSlotsByStaffAndDate(staffID int, date time.Time) ([]*entities.AvailableSlot, error)
-	HasConflict(staffID int, weekday entities.Weekday, start, end time.Time) (bool, error)
-	IsWithinAvailableSlot(staffID int, start, end time.Time) (bool, error)
-	Save(slot *entities.AvailableSlot) error
-	Update(slot *entities.AvailableSlot) error
-	Delete(id int) error
+	FindByID(ctx context.Context, id int) (*entities.AvailableSlot, error)
+	FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.AvailableSlot, error)
+	FindSlotsByStaffAndDate(ctx context.Context, staffID int, date time.Time) ([]*entities.AvailableSlot, error)
+	HasConflict(ctx context.Context, staffID int, weekday entities.Weekday, start, end time.Time) (bool, error)
+	IsWithinAvailableSlot(ctx context.Context, staffID int, start, end time.Time) (bool, error)
+	Save(ctx context.Context, slot *entities.AvailableSlot) error
+	Update(ctx context.Context, slot *entities.AvailableSlot) error
+	Delete(ctx context.Context, id int) error
 }
@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+)
+
+var (
+	ErrStaffUnavailable    = errs.Conflict("booking.staff_unavailable")
+	ErrResourceUnavailable = errs.Conflict("booking.resource_unavailable")
+	ErrStaffNotFound       = errs.NotFound("booking.staff_not_found")
+	ErrResourceNotFound    = errs.NotFound("booking.resource_not_found")
+)
+
+// BookingRepository grava um agendamento verificando, na mesma transação,
+// conflitos do profissional e a capacidade dos recursos exigidos.
+type BookingRepository interface {
+	Book(ctx context.Context, appointment *entities.Appointment, duration time.Duration, requirements []*entities.ResourceRequirement) error
+}
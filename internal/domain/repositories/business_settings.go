@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+// BusinessSettingsRepository guarda uma configuração por tenant. Find devolve
+// nil sem erro quando o tenant ainda não configurou nada.
+type BusinessSettingsRepository interface {
+	Find(ctx context.Context) (*entities.BusinessSettings, error)
+	Save(ctx context.Context, settings *entities.BusinessSettings) error
+}
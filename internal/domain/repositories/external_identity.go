@@ -0,0 +1,14 @@
+package repositories
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+// ExternalIdentityRepository guarda os vínculos com provedores OIDC do tenant
+// do contexto. FindBySubject retorna nil, nil quando não há vínculo.
+type ExternalIdentityRepository interface {
+	FindBySubject(ctx context.Context, provider, subject string) (*entities.ExternalIdentity, error)
+	Create(ctx context.Context, identity *entities.ExternalIdentity) error
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type LocationRepository interface {
+	FindByID(ctx context.Context, id int) (*entities.Location, error)
+	FindAll(ctx context.Context) ([]*entities.Location, error)
+	Save(ctx context.Context, location *entities.Location) error
+	AddService(ctx context.Context, locationID, serviceID int) error
+	OffersService(ctx context.Context, locationID, serviceID int) (bool, error)
+	TravelTime(ctx context.Context, fromLocationID, toLocationID int) (time.Duration, error)
+	SetTravelTime(ctx context.Context, fromLocationID, toLocationID int, travel time.Duration) error
+}
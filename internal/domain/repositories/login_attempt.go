@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+// LoginAttemptRepository guarda os contadores de falhas de login do tenant do
+// contexto. Há uma implementação em memória, suficiente para uma única
+// instância, e uma em MySQL, compartilhada entre as instâncias.
+//
+// Find retorna nil, nil quando não há falhas registradas. RecordFailure soma
+// uma falha de forma atômica e retorna o total; falhas anteriores a since são
+// descartadas e a contagem recomeça em 1.
+type LoginAttemptRepository interface {
+	Find(ctx context.Context, scope, key string) (*entities.LoginAttempts, error)
+	RecordFailure(ctx context.Context, scope, key string, at, since time.Time) (int, error)
+	Lock(ctx context.Context, scope, key string, until time.Time) error
+	Reset(ctx context.Context, scope, key string) error
+}
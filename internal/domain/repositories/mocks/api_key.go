@@ -0,0 +1,71 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockAPIKeyRepository struct {
+	CreateFunc        func(ctx context.Context, key *entities.APIKey) error
+	FindByHashFunc    func(ctx context.Context, keyHash string) (*entities.APIKey, error)
+	FindByIDFunc      func(ctx context.Context, id int) (*entities.APIKey, error)
+	ListFunc          func(ctx context.Context) ([]*entities.APIKey, error)
+	RevokeFunc        func(ctx context.Context, id int) (bool, error)
+	ExpireFunc        func(ctx context.Context, id int, at time.Time) error
+	TouchLastUsedFunc func(ctx context.Context, id int, at time.Time) error
+}
+
+func (m *MockAPIKeyRepository) Create(ctx context.Context, key *entities.APIKey) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, key)
+	}
+	return nil
+}
+
+func (m *MockAPIKeyRepository) FindByHash(ctx context.Context, keyHash string) (*entities.APIKey, error) {
+	if m.FindByHashFunc != nil {
+		return m.FindByHashFunc(ctx, keyHash)
+	}
+	return nil, nil
+}
+
+func (m *MockAPIKeyRepository) FindByID(ctx context.Context, id int) (*entities.APIKey, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockAPIKeyRepository) List(ctx context.Context) ([]*entities.APIKey, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockAPIKeyRepository) Revoke(ctx context.Context, id int) (bool, error) {
+	if m.RevokeFunc != nil {
+		return m.RevokeFunc(ctx, id)
+	}
+	return true, nil
+}
+
+func (m *MockAPIKeyRepository) Expire(ctx context.Context, id int, at time.Time) error {
+	if m.ExpireFunc != nil {
+		return m.ExpireFunc(ctx, id, at)
+	}
+	return nil
+}
+
+func (m *MockAPIKeyRepository) TouchLastUsed(ctx context.Context, id int, at time.Time) error {
+	if m.TouchLastUsedFunc != nil {
+		return m.TouchLastUsedFunc(ctx, id, at)
+	}
+	return nil
+}
+
+func NewMockAPIKeyRepository() *MockAPIKeyRepository {
+	return &MockAPIKeyRepository{}
+}
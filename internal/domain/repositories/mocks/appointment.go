@@ -1,62 +1,79 @@
 package mocks
 
 import (
+	"context"
 	"time"
 
 	"scheduling/internal/domain/entities"
 )
 
 type MockAppointmentRepository struct {
-	FindByIDFunc           func(id int) (*entities.Appointment, error)
-	FindAllByStaffIDFunc   func(staffID int) ([]*entities.Appointment, error)
-	HasConflictFunc        func(staffID int, start, end time.Time) (bool, error)
-	SaveFunc               func(appointment *entities.Appointment) error
-	UpdateFunc             func(appointment *entities.Appointment) error
-	DeleteFunc             func(id int) error
+	FindByIDFunc                    func(ctx context.Context, id int) (*entities.Appointment, error)
+	FindAllByStaffIDFunc            func(ctx context.Context, staffID int) ([]*entities.Appointment, error)
+	FindAllByClientIDFunc           func(ctx context.Context, clientID int) ([]*entities.Appointment, error)
+	FindScheduledByStaffAndDateFunc func(ctx context.Context, staffID int, date time.Time) ([]*entities.Appointment, error)
+	HasConflictFunc                 func(ctx context.Context, staffID int, start, end time.Time) (bool, error)
+	SaveFunc                        func(ctx context.Context, appointment *entities.Appointment) error
+	UpdateFunc                      func(ctx context.Context, appointment *entities.Appointment) error
+	DeleteFunc                      func(ctx context.Context, id int) error
 }
 
-func (m *MockAppointmentRepository) FindByID(id int) (*entities.Appointment, error) {
+func (m *MockAppointmentRepository) FindByID(ctx context.Context, id int) (*entities.Appointment, error) {
 	if m.FindByIDFunc != nil {
-		return m.FindByIDFunc(id)
+		return m.FindByIDFunc(ctx, id)
 	}
 	return nil, nil
 }
 
-func (m *MockAppointmentRepository) FindAllByStaffID(staffID int) ([]*entities.Appointment, error) {
+func (m *MockAppointmentRepository) FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.Appointment, error) {
 	if m.FindAllByStaffIDFunc != nil {
-		return m.FindAllByStaffIDFunc(staffID)
+		return m.FindAllByStaffIDFunc(ctx, staffID)
 	}
 	return nil, nil
 }
 
-func (m *MockAppointmentRepository) HasConflict(staffID int, start, end time.Time) (bool, error) {
+func (m *MockAppointmentRepository) FindAllByClientID(ctx context.Context, clientID int) ([]*entities.Appointment, error) {
+	if m.FindAllByClientIDFunc != nil {
+		return m.FindAllByClientIDFunc(ctx, clientID)
+	}
+	return nil, nil
+}
+
+func (m *MockAppointmentRepository) FindScheduledByStaffAndDate(ctx context.Context, staffID int, date time.Time) ([]*entities.Appointment, error) {
+	if m.FindScheduledByStaffAndDateFunc != nil {
+		return m.FindScheduledByStaffAndDateFunc(ctx, staffID, date)
+	}
+	return nil, nil
+}
+
+func (m *MockAppointmentRepository) HasConflict(ctx context.Context, staffID int, start, end time.Time) (bool, error) {
 	if m.HasConflictFunc != nil {
-		return m.HasConflictFunc(staffID, start, end)
+		return m.HasConflictFunc(ctx, staffID, start, end)
 	}
 	return false, nil
 }
 
-func (m *MockAppointmentRepository) Save(appointment *entities.Appointment) error {
+func (m *MockAppointmentRepository) Save(ctx context.Context, appointment *entities.Appointment) error {
 	if m.SaveFunc != nil {
-		return m.SaveFunc(appointment)
+		return m.SaveFunc(ctx, appointment)
 	}
 	return nil
 }
 
-func (m *MockAppointmentRepository) Update(appointment *entities.Appointment) error {
+func (m *MockAppointmentRepository) Update(ctx context.Context, appointment *entities.Appointment) error {
 	if m.UpdateFunc != nil {
-		return m.UpdateFunc(appointment)
+		return m.UpdateFunc(ctx, appointment)
 	}
 	return nil
 }
 
-func (m *MockAppointmentRepository) Delete(id int) error {
+func (m *MockAppointmentRepository) Delete(ctx context.Context, id int) error {
 	if m.DeleteFunc != nil {
-		return m.DeleteFunc(id)
+		return m.DeleteFunc(ctx, id)
 	}
 	return nil
 }
 
 func NewMockAppointmentRepository() *MockAppointmentRepository {
 	return &MockAppointmentRepository{}
-}
\ No newline at end of file
+}
@@ -0,0 +1,22 @@
+package mocks
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockAuditRepository struct {
+	RecordFunc func(ctx context.Context, entry *entities.AuditEntry) error
+}
+
+func (m *MockAuditRepository) Record(ctx context.Context, entry *entities.AuditEntry) error {
+	if m.RecordFunc != nil {
+		return m.RecordFunc(ctx, entry)
+	}
+	return nil
+}
+
+func NewMockAuditRepository() *MockAuditRepository {
+	return &MockAuditRepository{}
+}
@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockBookingRepository struct {
+	BookFunc func(ctx context.Context, appointment *entities.Appointment, duration time.Duration, requirements []*entities.ResourceRequirement) error
+}
+
+func (m *MockBookingRepository) Book(ctx context.Context, appointment *entities.Appointment, duration time.Duration, requirements []*entities.ResourceRequirement) error {
+	if m.BookFunc != nil {
+		return m.BookFunc(ctx, appointment, duration, requirements)
+	}
+	return nil
+}
+
+func NewMockBookingRepository() *MockBookingRepository {
+	return &MockBookingRepository{}
+}
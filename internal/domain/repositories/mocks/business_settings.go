@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockBusinessSettingsRepository struct {
+	FindFunc func(ctx context.Context) (*entities.BusinessSettings, error)
+	SaveFunc func(ctx context.Context, settings *entities.BusinessSettings) error
+}
+
+func (m *MockBusinessSettingsRepository) Find(ctx context.Context) (*entities.BusinessSettings, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockBusinessSettingsRepository) Save(ctx context.Context, settings *entities.BusinessSettings) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, settings)
+	}
+	return nil
+}
+
+func NewMockBusinessSettingsRepository() *MockBusinessSettingsRepository {
+	return &MockBusinessSettingsRepository{}
+}
@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockExternalIdentityRepository struct {
+	FindBySubjectFunc func(ctx context.Context, provider, subject string) (*entities.ExternalIdentity, error)
+	CreateFunc        func(ctx context.Context, identity *entities.ExternalIdentity) error
+}
+
+func (m *MockExternalIdentityRepository) FindBySubject(ctx context.Context, provider, subject string) (*entities.ExternalIdentity, error) {
+	if m.FindBySubjectFunc != nil {
+		return m.FindBySubjectFunc(ctx, provider, subject)
+	}
+	return nil, nil
+}
+
+func (m *MockExternalIdentityRepository) Create(ctx context.Context, identity *entities.ExternalIdentity) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, identity)
+	}
+	return nil
+}
+
+func NewMockExternalIdentityRepository() *MockExternalIdentityRepository {
+	return &MockExternalIdentityRepository{}
+}
@@ -0,0 +1,71 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockLocationRepository struct {
+	FindByIDFunc      func(ctx context.Context, id int) (*entities.Location, error)
+	FindAllFunc       func(ctx context.Context) ([]*entities.Location, error)
+	SaveFunc          func(ctx context.Context, location *entities.Location) error
+	AddServiceFunc    func(ctx context.Context, locationID, serviceID int) error
+	OffersServiceFunc func(ctx context.Context, locationID, serviceID int) (bool, error)
+	TravelTimeFunc    func(ctx context.Context, fromLocationID, toLocationID int) (time.Duration, error)
+	SetTravelTimeFunc func(ctx context.Context, fromLocationID, toLocationID int, travel time.Duration) error
+}
+
+func (m *MockLocationRepository) FindByID(ctx context.Context, id int) (*entities.Location, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockLocationRepository) FindAll(ctx context.Context) ([]*entities.Location, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockLocationRepository) Save(ctx context.Context, location *entities.Location) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, location)
+	}
+	return nil
+}
+
+func (m *MockLocationRepository) AddService(ctx context.Context, locationID, serviceID int) error {
+	if m.AddServiceFunc != nil {
+		return m.AddServiceFunc(ctx, locationID, serviceID)
+	}
+	return nil
+}
+
+func (m *MockLocationRepository) OffersService(ctx context.Context, locationID, serviceID int) (bool, error) {
+	if m.OffersServiceFunc != nil {
+		return m.OffersServiceFunc(ctx, locationID, serviceID)
+	}
+	return true, nil
+}
+
+func (m *MockLocationRepository) TravelTime(ctx context.Context, fromLocationID, toLocationID int) (time.Duration, error) {
+	if m.TravelTimeFunc != nil {
+		return m.TravelTimeFunc(ctx, fromLocationID, toLocationID)
+	}
+	return 0, nil
+}
+
+func (m *MockLocationRepository) SetTravelTime(ctx context.Context, fromLocationID, toLocationID int, travel time.Duration) error {
+	if m.SetTravelTimeFunc != nil {
+		return m.SetTravelTimeFunc(ctx, fromLocationID, toLocationID, travel)
+	}
+	return nil
+}
+
+func NewMockLocationRepository() *MockLocationRepository {
+	return &MockLocationRepository{}
+}
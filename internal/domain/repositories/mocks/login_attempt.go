@@ -0,0 +1,47 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockLoginAttemptRepository struct {
+	FindFunc          func(ctx context.Context, scope, key string) (*entities.LoginAttempts, error)
+	RecordFailureFunc func(ctx context.Context, scope, key string, at, since time.Time) (int, error)
+	LockFunc          func(ctx context.Context, scope, key string, until time.Time) error
+	ResetFunc         func(ctx context.Context, scope, key string) error
+}
+
+func (m *MockLoginAttemptRepository) Find(ctx context.Context, scope, key string) (*entities.LoginAttempts, error) {
+	if m.FindFunc != nil {
+		return m.FindFunc(ctx, scope, key)
+	}
+	return nil, nil
+}
+
+func (m *MockLoginAttemptRepository) RecordFailure(ctx context.Context, scope, key string, at, since time.Time) (int, error) {
+	if m.RecordFailureFunc != nil {
+		return m.RecordFailureFunc(ctx, scope, key, at, since)
+	}
+	return 1, nil
+}
+
+func (m *MockLoginAttemptRepository) Lock(ctx context.Context, scope, key string, until time.Time) error {
+	if m.LockFunc != nil {
+		return m.LockFunc(ctx, scope, key, until)
+	}
+	return nil
+}
+
+func (m *MockLoginAttemptRepository) Reset(ctx context.Context, scope, key string) error {
+	if m.ResetFunc != nil {
+		return m.ResetFunc(ctx, scope, key)
+	}
+	return nil
+}
+
+func NewMockLoginAttemptRepository() *MockLoginAttemptRepository {
+	return &MockLoginAttemptRepository{}
+}
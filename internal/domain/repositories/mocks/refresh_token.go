@@ -0,0 +1,54 @@
+package mocks
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockRefreshTokenRepository struct {
+	CreateFunc           func(ctx context.Context, token *entities.RefreshToken) error
+	FindByHashFunc       func(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+	RevokeFunc           func(ctx context.Context, id int) (bool, error)
+	RevokeFamilyFunc     func(ctx context.Context, familyID string) error
+	RevokeAllForUserFunc func(ctx context.Context, userID int) error
+}
+
+func (m *MockRefreshTokenRepository) Create(ctx context.Context, token *entities.RefreshToken) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, token)
+	}
+	return nil
+}
+
+func (m *MockRefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	if m.FindByHashFunc != nil {
+		return m.FindByHashFunc(ctx, tokenHash)
+	}
+	return nil, nil
+}
+
+func (m *MockRefreshTokenRepository) Revoke(ctx context.Context, id int) (bool, error) {
+	if m.RevokeFunc != nil {
+		return m.RevokeFunc(ctx, id)
+	}
+	return true, nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	if m.RevokeFamilyFunc != nil {
+		return m.RevokeFamilyFunc(ctx, familyID)
+	}
+	return nil
+}
+
+func (m *MockRefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID int) error {
+	if m.RevokeAllForUserFunc != nil {
+		return m.RevokeAllForUserFunc(ctx, userID)
+	}
+	return nil
+}
+
+func NewMockRefreshTokenRepository() *MockRefreshTokenRepository {
+	return &MockRefreshTokenRepository{}
+}
@@ -0,0 +1,79 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockResourceRepository struct {
+	FindByIDFunc                    func(ctx context.Context, id int) (*entities.Resource, error)
+	FindAllFunc                     func(ctx context.Context) ([]*entities.Resource, error)
+	SaveFunc                        func(ctx context.Context, resource *entities.Resource) error
+	UpdateFunc                      func(ctx context.Context, resource *entities.Resource) error
+	DeleteFunc                      func(ctx context.Context, id int) error
+	FindRequirementsByServiceIDFunc func(ctx context.Context, serviceID int) ([]*entities.ResourceRequirement, error)
+	SaveRequirementFunc             func(ctx context.Context, requirement *entities.ResourceRequirement) error
+	FindAllocationsByDateFunc       func(ctx context.Context, resourceID int, date time.Time) ([]*entities.ResourceAllocation, error)
+}
+
+func (m *MockResourceRepository) FindByID(ctx context.Context, id int) (*entities.Resource, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockResourceRepository) FindAll(ctx context.Context) ([]*entities.Resource, error) {
+	if m.FindAllFunc != nil {
+		return m.FindAllFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockResourceRepository) Save(ctx context.Context, resource *entities.Resource) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, resource)
+	}
+	return nil
+}
+
+func (m *MockResourceRepository) Update(ctx context.Context, resource *entities.Resource) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, resource)
+	}
+	return nil
+}
+
+func (m *MockResourceRepository) Delete(ctx context.Context, id int) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockResourceRepository) FindRequirementsByServiceID(ctx context.Context, serviceID int) ([]*entities.ResourceRequirement, error) {
+	if m.FindRequirementsByServiceIDFunc != nil {
+		return m.FindRequirementsByServiceIDFunc(ctx, serviceID)
+	}
+	return nil, nil
+}
+
+func (m *MockResourceRepository) SaveRequirement(ctx context.Context, requirement *entities.ResourceRequirement) error {
+	if m.SaveRequirementFunc != nil {
+		return m.SaveRequirementFunc(ctx, requirement)
+	}
+	return nil
+}
+
+func (m *MockResourceRepository) FindAllocationsByDate(ctx context.Context, resourceID int, date time.Time) ([]*entities.ResourceAllocation, error) {
+	if m.FindAllocationsByDateFunc != nil {
+		return m.FindAllocationsByDateFunc(ctx, resourceID, date)
+	}
+	return nil, nil
+}
+
+func NewMockResourceRepository() *MockResourceRepository {
+	return &MockResourceRepository{}
+}
@@ -1,30 +1,34 @@
 package mocks
 
-import "scheduling/internal/domain/entities"
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
 
 type MockServiceRepository struct {
-	FindByIDFunc         func(id int) (*entities.Service, error)
-	FindAllByStaffIDFunc func(staffID int) ([]*entities.Service, error)
-	ExistsFunc           func(id int) (bool, error)
+	FindByIDFunc         func(ctx context.Context, id int) (*entities.Service, error)
+	FindAllByStaffIDFunc func(ctx context.Context, staffID int) ([]*entities.Service, error)
+	ExistsFunc           func(ctx context.Context, id int) (bool, error)
 }
 
-func (m *MockServiceRepository) FindByID(id int) (*entities.Service, error) {
+func (m *MockServiceRepository) FindByID(ctx context.Context, id int) (*entities.Service, error) {
 	if m.FindByIDFunc != nil {
-		return m.FindByIDFunc(id)
+		return m.FindByIDFunc(ctx, id)
 	}
 	return nil, nil
 }
 
-func (m *MockServiceRepository) FindAllByStaffID(staffID int) ([]*entities.Service, error) {
+func (m *MockServiceRepository) FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.Service, error) {
 	if m.FindAllByStaffIDFunc != nil {
-		return m.FindAllByStaffIDFunc(staffID)
+		return m.FindAllByStaffIDFunc(ctx, staffID)
 	}
 	return nil, nil
 }
 
-func (m *MockServiceRepository) Exists(id int) (bool, error) {
+func (m *MockServiceRepository) Exists(ctx context.Context, id int) (bool, error) {
 	if m.ExistsFunc != nil {
-		return m.ExistsFunc(id)
+		return m.ExistsFunc(ctx, id)
 	}
 	return false, nil
 }
@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockStaffBreakRepository struct {
+	FindByIDFunc         func(ctx context.Context, id int) (*entities.StaffBreak, error)
+	FindAllByStaffIDFunc func(ctx context.Context, staffID int) ([]*entities.StaffBreak, error)
+	FindByWeekdayFunc    func(ctx context.Context, staffID int, weekday entities.Weekday) ([]*entities.StaffBreak, error)
+	SaveFunc             func(ctx context.Context, staffBreak *entities.StaffBreak) error
+	UpdateFunc           func(ctx context.Context, staffBreak *entities.StaffBreak) error
+	DeleteFunc           func(ctx context.Context, id int) error
+}
+
+func (m *MockStaffBreakRepository) FindByID(ctx context.Context, id int) (*entities.StaffBreak, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockStaffBreakRepository) FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.StaffBreak, error) {
+	if m.FindAllByStaffIDFunc != nil {
+		return m.FindAllByStaffIDFunc(ctx, staffID)
+	}
+	return nil, nil
+}
+
+func (m *MockStaffBreakRepository) FindByWeekday(ctx context.Context, staffID int, weekday entities.Weekday) ([]*entities.StaffBreak, error) {
+	if m.FindByWeekdayFunc != nil {
+		return m.FindByWeekdayFunc(ctx, staffID, weekday)
+	}
+	return nil, nil
+}
+
+func (m *MockStaffBreakRepository) Save(ctx context.Context, staffBreak *entities.StaffBreak) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, staffBreak)
+	}
+	return nil
+}
+
+func (m *MockStaffBreakRepository) Update(ctx context.Context, staffBreak *entities.StaffBreak) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, staffBreak)
+	}
+	return nil
+}
+
+func (m *MockStaffBreakRepository) Delete(ctx context.Context, id int) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func NewMockStaffBreakRepository() *MockStaffBreakRepository {
+	return &MockStaffBreakRepository{}
+}
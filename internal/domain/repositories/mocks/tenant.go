@@ -0,0 +1,38 @@
+package mocks
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockTenantRepository struct {
+	FindByIDFunc   func(ctx context.Context, id int) (*entities.Tenant, error)
+	FindBySlugFunc func(ctx context.Context, slug string) (*entities.Tenant, error)
+	SaveFunc       func(ctx context.Context, tenant *entities.Tenant) error
+}
+
+func (m *MockTenantRepository) FindByID(ctx context.Context, id int) (*entities.Tenant, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockTenantRepository) FindBySlug(ctx context.Context, slug string) (*entities.Tenant, error) {
+	if m.FindBySlugFunc != nil {
+		return m.FindBySlugFunc(ctx, slug)
+	}
+	return nil, nil
+}
+
+func (m *MockTenantRepository) Save(ctx context.Context, tenant *entities.Tenant) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, tenant)
+	}
+	return nil
+}
+
+func NewMockTenantRepository() *MockTenantRepository {
+	return &MockTenantRepository{}
+}
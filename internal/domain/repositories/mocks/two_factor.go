@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockTwoFactorRepository struct {
+	FindByUserIDFunc         func(ctx context.Context, userID int) (*entities.TOTPCredential, error)
+	SaveFunc                 func(ctx context.Context, credential *entities.TOTPCredential) error
+	DeleteFunc               func(ctx context.Context, userID int) error
+	MarkStepUsedFunc         func(ctx context.Context, userID int, step int64) (bool, error)
+	ReplaceRecoveryCodesFunc func(ctx context.Context, userID int, codeHashes []string) error
+	UseRecoveryCodeFunc      func(ctx context.Context, userID int, codeHash string) (bool, error)
+}
+
+func (m *MockTwoFactorRepository) FindByUserID(ctx context.Context, userID int) (*entities.TOTPCredential, error) {
+	if m.FindByUserIDFunc != nil {
+		return m.FindByUserIDFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *MockTwoFactorRepository) Save(ctx context.Context, credential *entities.TOTPCredential) error {
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, credential)
+	}
+	return nil
+}
+
+func (m *MockTwoFactorRepository) Delete(ctx context.Context, userID int) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, userID)
+	}
+	return nil
+}
+
+func (m *MockTwoFactorRepository) MarkStepUsed(ctx context.Context, userID int, step int64) (bool, error) {
+	if m.MarkStepUsedFunc != nil {
+		return m.MarkStepUsedFunc(ctx, userID, step)
+	}
+	return true, nil
+}
+
+func (m *MockTwoFactorRepository) ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error {
+	if m.ReplaceRecoveryCodesFunc != nil {
+		return m.ReplaceRecoveryCodesFunc(ctx, userID, codeHashes)
+	}
+	return nil
+}
+
+func (m *MockTwoFactorRepository) UseRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error) {
+	if m.UseRecoveryCodeFunc != nil {
+		return m.UseRecoveryCodeFunc(ctx, userID, codeHash)
+	}
+	return false, nil
+}
+
+func NewMockTwoFactorRepository() *MockTwoFactorRepository {
+	return &MockTwoFactorRepository{}
+}
@@ -1,26 +1,110 @@
 package mocks
 
-import "scheduling/internal/domain/entities"
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
 
 type MockUserRepository struct {
-	FindByIDFunc func(id int) (*entities.User, error)
-	ExistsFunc   func(id int) (bool, error)
+	CreateFunc             func(ctx context.Context, user *entities.User) error
+	FindByIDFunc           func(ctx context.Context, id int) (*entities.User, error)
+	UpdateFunc             func(ctx context.Context, user *entities.User) error
+	DeleteFunc             func(ctx context.Context, id int) error
+	ListFunc               func(ctx context.Context, limit, offset int) ([]*entities.User, error)
+	CountFunc              func(ctx context.Context) (int64, error)
+	ExistsFunc             func(ctx context.Context, id int) (bool, error)
+	EmailExistFunc         func(ctx context.Context, email string) (bool, error)
+	FindByEmailFunc        func(ctx context.Context, email string) (entities.User, error)
+	UpdatePasswordHashFunc func(ctx context.Context, id int, hash string) error
+	MarkEmailVerifiedFunc  func(ctx context.Context, id int) error
+	UpdateLocaleFunc       func(ctx context.Context, id int, locale string) error
 }
 
-func (m *MockUserRepository) FindByID(id int) (*entities.User, error) {
+func (m *MockUserRepository) Create(ctx context.Context, user *entities.User) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, user)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) FindByID(ctx context.Context, id int) (*entities.User, error) {
 	if m.FindByIDFunc != nil {
-		return m.FindByIDFunc(id)
+		return m.FindByIDFunc(ctx, id)
 	}
 	return nil, nil
 }
 
-func (m *MockUserRepository) Exists(id int) (bool, error) {
+func (m *MockUserRepository) Update(ctx context.Context, user *entities.User) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, user)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, id int) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) List(ctx context.Context, limit, offset int) ([]*entities.User, error) {
+	if m.ListFunc != nil {
+		return m.ListFunc(ctx, limit, offset)
+	}
+	return nil, nil
+}
+
+func (m *MockUserRepository) Count(ctx context.Context) (int64, error) {
+	if m.CountFunc != nil {
+		return m.CountFunc(ctx)
+	}
+	return 0, nil
+}
+
+func (m *MockUserRepository) Exists(ctx context.Context, id int) (bool, error) {
 	if m.ExistsFunc != nil {
-		return m.ExistsFunc(id)
+		return m.ExistsFunc(ctx, id)
 	}
 	return false, nil
 }
 
+func (m *MockUserRepository) EmailExist(ctx context.Context, email string) (bool, error) {
+	if m.EmailExistFunc != nil {
+		return m.EmailExistFunc(ctx, email)
+	}
+	return false, nil
+}
+
+func (m *MockUserRepository) FindByEmail(ctx context.Context, email string) (entities.User, error) {
+	if m.FindByEmailFunc != nil {
+		return m.FindByEmailFunc(ctx, email)
+	}
+	return entities.User{}, nil
+}
+
+func (m *MockUserRepository) UpdatePasswordHash(ctx context.Context, id int, hash string) error {
+	if m.UpdatePasswordHashFunc != nil {
+		return m.UpdatePasswordHashFunc(ctx, id, hash)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) MarkEmailVerified(ctx context.Context, id int) error {
+	if m.MarkEmailVerifiedFunc != nil {
+		return m.MarkEmailVerifiedFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockUserRepository) UpdateLocale(ctx context.Context, id int, locale string) error {
+	if m.UpdateLocaleFunc != nil {
+		return m.UpdateLocaleFunc(ctx, id, locale)
+	}
+	return nil
+}
+
 func NewMockUserRepository() *MockUserRepository {
 	return &MockUserRepository{}
-}
\ No newline at end of file
+}
@@ -0,0 +1,46 @@
+package mocks
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+type MockUserTokenRepository struct {
+	CreateFunc        func(ctx context.Context, token *entities.UserToken) error
+	FindByHashFunc    func(ctx context.Context, purpose, tokenHash string) (*entities.UserToken, error)
+	ConsumeFunc       func(ctx context.Context, id int) (bool, error)
+	InvalidateAllFunc func(ctx context.Context, userID int, purpose string) error
+}
+
+func (m *MockUserTokenRepository) Create(ctx context.Context, token *entities.UserToken) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, token)
+	}
+	return nil
+}
+
+func (m *MockUserTokenRepository) FindByHash(ctx context.Context, purpose, tokenHash string) (*entities.UserToken, error) {
+	if m.FindByHashFunc != nil {
+		return m.FindByHashFunc(ctx, purpose, tokenHash)
+	}
+	return nil, nil
+}
+
+func (m *MockUserTokenRepository) Consume(ctx context.Context, id int) (bool, error) {
+	if m.ConsumeFunc != nil {
+		return m.ConsumeFunc(ctx, id)
+	}
+	return true, nil
+}
+
+func (m *MockUserTokenRepository) InvalidateAll(ctx context.Context, userID int, purpose string) error {
+	if m.InvalidateAllFunc != nil {
+		return m.InvalidateAllFunc(ctx, userID, purpose)
+	}
+	return nil
+}
+
+func NewMockUserTokenRepository() *MockUserTokenRepository {
+	return &MockUserTokenRepository{}
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+// RefreshTokenRepository guarda os refresh tokens do tenant do contexto.
+// FindByHash retorna nil, nil quando o token não existe. Revoke retorna false
+// quando o token já estava revogado, o que indica uso concorrente ou reuso.
+// RevokeAllForUser encerra todas as sessões do usuário, como após a troca de
+// senha.
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *entities.RefreshToken) error
+	FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error)
+	Revoke(ctx context.Context, id int) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeAllForUser(ctx context.Context, userID int) error
+}
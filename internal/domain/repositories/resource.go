@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type ResourceRepository interface {
+	FindByID(ctx context.Context, id int) (*entities.Resource, error)
+	FindAll(ctx context.Context) ([]*entities.Resource, error)
+	Save(ctx context.Context, resource *entities.Resource) error
+	Update(ctx context.Context, resource *entities.Resource) error
+	Delete(ctx context.Context, id int) error
+	FindRequirementsByServiceID(ctx context.Context, serviceID int) ([]*entities.ResourceRequirement, error)
+	SaveRequirement(ctx context.Context, requirement *entities.ResourceRequirement) error
+	FindAllocationsByDate(ctx context.Context, resourceID int, date time.Time) ([]*entities.ResourceAllocation, error)
+}
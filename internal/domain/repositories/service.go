@@ -1,9 +1,16 @@
 package repositories
 
-import "scheduling/internal/domain/entities"
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+)
+
+var ErrServiceNotFound = errs.NotFound("service.not_found")
 
 type ServiceRepository interface {
-	FindByID(id int) (*entities.Service, error)
-	FindAllByStaffID(staffID int) ([]*entities.Service, error)
-	Exists(id int) (bool, error)
+	FindByID(ctx context.Context, id int) (*entities.Service, error)
+	FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.Service, error)
+	Exists(ctx context.Context, id int) (bool, error)
 }
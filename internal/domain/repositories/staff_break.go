@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+type StaffBreakRepository interface {
+	FindByID(ctx context.Context, id int) (*entities.StaffBreak, error)
+	FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.StaffBreak, error)
+	FindByWeekday(ctx context.Context, staffID int, weekday entities.Weekday) ([]*entities.StaffBreak, error)
+	Save(ctx context.Context, staffBreak *entities.StaffBreak) error
+	Update(ctx context.Context, staffBreak *entities.StaffBreak) error
+	Delete(ctx context.Context, id int) error
+}
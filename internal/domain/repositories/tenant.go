@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+// TenantRepository não é escopado por tenant: é ele que resolve o tenant da
+// requisição antes de qualquer outro repositório ser usado.
+type TenantRepository interface {
+	FindByID(ctx context.Context, id int) (*entities.Tenant, error)
+	FindBySlug(ctx context.Context, slug string) (*entities.Tenant, error)
+	Save(ctx context.Context, tenant *entities.Tenant) error
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+// TwoFactorRepository guarda as credenciais TOTP e os códigos de recuperação
+// do tenant do contexto. FindByUserID retorna nil, nil quando o usuário não
+// cadastrou um autenticador. MarkStepUsed e UseRecoveryCode retornam false
+// quando o código já foi usado, garantindo o uso único mesmo com requisições
+// simultâneas.
+type TwoFactorRepository interface {
+	FindByUserID(ctx context.Context, userID int) (*entities.TOTPCredential, error)
+	Save(ctx context.Context, credential *entities.TOTPCredential) error
+	Delete(ctx context.Context, userID int) error
+	MarkStepUsed(ctx context.Context, userID int, step int64) (bool, error)
+	ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error)
+}
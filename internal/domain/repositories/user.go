@@ -3,11 +3,17 @@ package repositories
 import (
 	"context"
 	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
 )
 
+var ErrUserNotFound = errs.NotFound("user.not_found")
+
 type UserRepository interface {
 	Repository[entities.User]
 	Exists(ctx context.Context, id int) (bool, error)
 	EmailExist(ctx context.Context, email string) (bool, error)
 	FindByEmail(ctx context.Context, email string) (entities.User, error)
+	UpdatePasswordHash(ctx context.Context, id int, hash string) error
+	MarkEmailVerified(ctx context.Context, id int) error
+	UpdateLocale(ctx context.Context, id int, locale string) error
 }
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"scheduling/internal/domain/entities"
+)
+
+// UserTokenRepository guarda os tokens de redefinição de senha e de
+// verificação de email do tenant do contexto. FindByHash retorna nil, nil
+// quando o token não existe. Consume marca o token como usado e retorna false
+// quando ele já tinha sido usado ou expirou, garantindo o uso único mesmo com
+// requisições simultâneas. InvalidateAll descarta os tokens pendentes do
+// usuário para a finalidade informada.
+type UserTokenRepository interface {
+	Create(ctx context.Context, token *entities.UserToken) error
+	FindByHash(ctx context.Context, purpose, tokenHash string) (*entities.UserToken, error)
+	Consume(ctx context.Context, id int) (bool, error)
+	InvalidateAll(ctx context.Context, userID int, purpose string) error
+}
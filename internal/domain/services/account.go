@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/i18n"
+	"scheduling/internal/domain/notification"
+	"scheduling/internal/domain/password"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/tenant"
+)
+
+const (
+	PasswordResetTTL     = time.Hour
+	EmailVerificationTTL = 48 * time.Hour
+)
+
+var ErrInvalidUserToken = errs.Validation("account.invalid_token")
+
+// AccountLinks são as páginas do frontend que recebem os links enviados por
+// email. O token e o tenant são acrescentados como parâmetros da query.
+type AccountLinks struct {
+	PasswordResetURL     string
+	EmailVerificationURL string
+}
+
+// AccountService cuida da redefinição de senha e da verificação de email.
+// Os dois fluxos usam tokens de uso único enviados por link: só o hash fica
+// no banco e um novo pedido invalida os links anteriores.
+type AccountService struct {
+	logger      *slog.Logger
+	userRepo    repositories.UserRepository
+	tokenRepo   repositories.UserTokenRepository
+	refreshRepo repositories.RefreshTokenRepository
+	hasher      password.Hasher
+	notifier    notification.Notifier
+	links       AccountLinks
+	now         func() time.Time
+}
+
+func NewAccountService(
+	logger *slog.Logger,
+	userRepo repositories.UserRepository,
+	tokenRepo repositories.UserTokenRepository,
+	refreshRepo repositories.RefreshTokenRepository,
+	hasher password.Hasher,
+	notifier notification.Notifier,
+	links AccountLinks,
+) *AccountService {
+	return &AccountService{
+		logger:      logger,
+		userRepo:    userRepo,
+		tokenRepo:   tokenRepo,
+		refreshRepo: refreshRepo,
+		hasher:      hasher,
+		notifier:    notifier,
+		links:       links,
+		now:         time.Now,
+	}
+}
+
+// RequestPasswordReset envia o link de redefinição para o email informado.
+// Emails desconhecidos e falhas no envio não são informados a quem pediu,
+// para que a resposta não revele quais emails estão cadastrados.
+func (s *AccountService) RequestPasswordReset(ctx context.Context, email string) error {
+	user, err := s.userRepo.FindByEmail(ctx, email)
+	if errors.Is(err, repositories.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar usuário por email",
+			"error", err.Error(),
+			"operation", "account_service.find_by_email",
+		)
+		return err
+	}
+
+	if err := s.send(ctx, &user, entities.TokenPurposePasswordReset); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao enviar link de redefinição de senha",
+			"error", err.Error(),
+			"user_id", user.ID(),
+			"operation", "account_service.request_password_reset",
+		)
+	}
+
+	return nil
+}
+
+// ResetPassword troca a senha do dono do token e encerra todas as sessões
+// abertas. Como o link chegou por email, a troca também confirma o email.
+func (s *AccountService) ResetPassword(ctx context.Context, plainToken, newPassword string) error {
+	if err := entities.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	userToken, err := s.consume(ctx, entities.TokenPurposePasswordReset, plainToken)
+	if err != nil {
+		return err
+	}
+
+	hash, err := s.hasher.Hash(newPassword)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.UpdatePasswordHash(ctx, userToken.UserID(), hash); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao redefinir a senha",
+			"error", err.Error(),
+			"user_id", userToken.UserID(),
+			"operation", "account_service.update_password",
+		)
+		return err
+	}
+
+	if err := s.tokenRepo.InvalidateAll(ctx, userToken.UserID(), entities.TokenPurposePasswordReset); err != nil {
+		return err
+	}
+	if err := s.refreshRepo.RevokeAllForUser(ctx, userToken.UserID()); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao encerrar as sessões após a troca de senha",
+			"error", err.Error(),
+			"user_id", userToken.UserID(),
+			"operation", "account_service.revoke_sessions",
+		)
+		return err
+	}
+
+	return s.userRepo.MarkEmailVerified(ctx, userToken.UserID())
+}
+
+// RequestEmailVerification envia o link de verificação para o email do
+// usuário. Não faz nada quando o email já foi verificado.
+func (s *AccountService) RequestEmailVerification(ctx context.Context, userID int) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return repositories.ErrUserNotFound
+	}
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	if err := s.send(ctx, user, entities.TokenPurposeEmailVerification); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao enviar link de verificação de email",
+			"error", err.Error(),
+			"user_id", user.ID(),
+			"operation", "account_service.request_email_verification",
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (s *AccountService) VerifyEmail(ctx context.Context, plainToken string) error {
+	userToken, err := s.consume(ctx, entities.TokenPurposeEmailVerification, plainToken)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.MarkEmailVerified(ctx, userToken.UserID()); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao marcar o email como verificado",
+			"error", err.Error(),
+			"user_id", userToken.UserID(),
+			"operation", "account_service.verify_email",
+		)
+		return err
+	}
+
+	return nil
+}
+
+// send gera um novo token para a finalidade, invalida os anteriores e entrega
+// o link pelo notifier.
+func (s *AccountService) send(ctx context.Context, user *entities.User, purpose string) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	plainToken, err := newOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	ttl, kind, base := PasswordResetTTL, notification.KindPasswordReset, s.links.PasswordResetURL
+	if purpose == entities.TokenPurposeEmailVerification {
+		ttl, kind, base = EmailVerificationTTL, notification.KindEmailVerification, s.links.EmailVerificationURL
+	}
+
+	expiresAt := s.now().Add(ttl)
+	userToken, err := entities.NewUserToken(user.ID(), purpose, hashToken(plainToken), expiresAt)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tokenRepo.InvalidateAll(ctx, user.ID(), purpose); err != nil {
+		return err
+	}
+	if err := s.tokenRepo.Create(ctx, userToken); err != nil {
+		return err
+	}
+
+	link, err := accountLink(base, plainToken, tenantID)
+	if err != nil {
+		return err
+	}
+
+	return s.notifier.Notify(ctx, notification.Message{
+		Kind:      kind,
+		TenantID:  tenantID,
+		To:        user.Email(),
+		Name:      user.Name(),
+		Link:      link,
+		ExpiresAt: expiresAt,
+		Locale:    notificationLocale(ctx, user),
+	})
+}
+
+func (s *AccountService) consume(ctx context.Context, purpose, plainToken string) (*entities.UserToken, error) {
+	return consumeUserToken(ctx, s.tokenRepo, purpose, plainToken, s.now())
+}
+
+// consumeUserToken valida o token e o marca como usado. Tokens desconhecidos,
+// de outra finalidade, expirados ou já usados retornam ErrInvalidUserToken.
+func consumeUserToken(
+	ctx context.Context,
+	tokenRepo repositories.UserTokenRepository,
+	purpose, plainToken string,
+	now time.Time,
+) (*entities.UserToken, error) {
+	userToken, err := tokenRepo.FindByHash(ctx, purpose, hashToken(plainToken))
+	if err != nil {
+		return nil, err
+	}
+	if userToken == nil || userToken.IsUsed() || userToken.IsExpired(now) {
+		return nil, ErrInvalidUserToken
+	}
+
+	consumed, err := tokenRepo.Consume(ctx, userToken.ID())
+	if err != nil {
+		return nil, err
+	}
+	if !consumed {
+		// outra requisição usou o mesmo token entre a busca e a marcação
+		return nil, ErrInvalidUserToken
+	}
+
+	return userToken, nil
+}
+
+func accountLink(base, plainToken string, tenantID int) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set("token", plainToken)
+	query.Set("tenant", strconv.Itoa(tenantID))
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// notificationLocale usa o idioma preferido do usuário ou, sem preferência, o
+// da requisição que pediu o envio.
+func notificationLocale(ctx context.Context, user *entities.User) i18n.Locale {
+	if locale := user.Locale(); locale != "" {
+		return locale
+	}
+	return i18n.FromContext(ctx)
+}
@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/i18n"
+	"scheduling/internal/domain/notification"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/valueobject"
+)
+
+type fakeNotifier struct {
+	sent []notification.Message
+}
+
+func (f *fakeNotifier) Notify(ctx context.Context, message notification.Message) error {
+	f.sent = append(f.sent, message)
+	return nil
+}
+
+// memoryUserTokens guarda os tokens em memória para exercitar o uso único.
+func memoryUserTokens() *mocks.MockUserTokenRepository {
+	stored := map[int]*entities.UserToken{}
+	repo := mocks.NewMockUserTokenRepository()
+
+	use := func(t *entities.UserToken) {
+		now := time.Now()
+		stored[t.ID()] = entities.RebuildUserToken(t.ID(), t.UserID(), t.Purpose(), t.TokenHash(), t.ExpiresAt(), &now, t.CreatedAt())
+	}
+
+	repo.CreateFunc = func(ctx context.Context, t *entities.UserToken) error {
+		t.SetID(len(stored) + 1)
+		stored[t.ID()] = t
+		return nil
+	}
+	repo.FindByHashFunc = func(ctx context.Context, purpose, hash string) (*entities.UserToken, error) {
+		for _, t := range stored {
+			if t.Purpose() == purpose && t.TokenHash() == hash {
+				return t, nil
+			}
+		}
+		return nil, nil
+	}
+	repo.ConsumeFunc = func(ctx context.Context, id int) (bool, error) {
+		t, ok := stored[id]
+		if !ok || t.IsUsed() {
+			return false, nil
+		}
+		use(t)
+		return true, nil
+	}
+	repo.InvalidateAllFunc = func(ctx context.Context, userID int, purpose string) error {
+		for _, t := range stored {
+			if t.UserID() == userID && t.Purpose() == purpose && !t.IsUsed() {
+				use(t)
+			}
+		}
+		return nil
+	}
+
+	return repo
+}
+
+func tokenFromLink(t *testing.T, link string) string {
+	t.Helper()
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("link inválido %q: %v", link, err)
+	}
+	if u.Query().Get("tenant") != "4" {
+		t.Errorf("link deveria levar o tenant 4: %s", link)
+	}
+	return u.Query().Get("token")
+}
+
+func TestAccountService_PasswordReset(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+
+	users := mocks.NewMockUserRepository()
+	users.FindByEmailFunc = func(ctx context.Context, email string) (entities.User, error) {
+		if email != "maria@gmail.com" {
+			return entities.User{}, repositories.ErrUserNotFound
+		}
+		emailVO, _ := valueobject.NewEmail(email)
+		return *entities.RebuildUser(7, "Maria", emailVO, entities.RoleClient), nil
+	}
+	var newHash string
+	users.UpdatePasswordHashFunc = func(ctx context.Context, id int, hash string) error {
+		newHash = hash
+		return nil
+	}
+	verified := false
+	users.MarkEmailVerifiedFunc = func(ctx context.Context, id int) error {
+		verified = true
+		return nil
+	}
+
+	refreshRepo := mocks.NewMockRefreshTokenRepository()
+	revokedFor := 0
+	refreshRepo.RevokeAllForUserFunc = func(ctx context.Context, userID int) error {
+		revokedFor = userID
+		return nil
+	}
+
+	notifier := &fakeNotifier{}
+	service := NewAccountService(discardLogger(), users, memoryUserTokens(), refreshRepo, versionedHasher{}, notifier,
+		AccountLinks{PasswordResetURL: "https://app.exemplo.com/redefinir-senha"})
+
+	if err := service.RequestPasswordReset(ctx, "ninguem@gmail.com"); err != nil {
+		t.Fatalf("email desconhecido não deveria retornar erro: %v", err)
+	}
+	if len(notifier.sent) != 0 {
+		t.Fatal("email desconhecido não deveria receber notificação")
+	}
+
+	// só o link mais recente continua válido
+	if err := service.RequestPasswordReset(ctx, "maria@gmail.com"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if err := service.RequestPasswordReset(ctx, "maria@gmail.com"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(notifier.sent) != 2 || notifier.sent[1].Kind != notification.KindPasswordReset || notifier.sent[1].To != "maria@gmail.com" {
+		t.Fatalf("notificações incorretas: %+v", notifier.sent)
+	}
+	oldToken := tokenFromLink(t, notifier.sent[0].Link)
+	token := tokenFromLink(t, notifier.sent[1].Link)
+
+	if err := service.ResetPassword(ctx, oldToken, "novasenha"); !errors.Is(err, ErrInvalidUserToken) {
+		t.Errorf("link substituído deveria ser recusado, obtido %v", err)
+	}
+	if err := service.ResetPassword(ctx, token, "123"); !errors.Is(err, entities.ErrPasswordTooShort) {
+		t.Errorf("senha curta deveria ser recusada, obtido %v", err)
+	}
+
+	if err := service.ResetPassword(ctx, token, "novasenha"); err != nil {
+		t.Fatalf("erro inesperado ao redefinir: %v", err)
+	}
+	if newHash != "v2:novasenha" {
+		t.Errorf("hash gravado esperado 'v2:novasenha', obtido '%s'", newHash)
+	}
+	if revokedFor != 7 {
+		t.Errorf("sessões do usuário 7 deveriam ser encerradas, obtido %d", revokedFor)
+	}
+	if !verified {
+		t.Error("a redefinição deveria confirmar o email")
+	}
+
+	if err := service.ResetPassword(ctx, token, "outrasenha"); !errors.Is(err, ErrInvalidUserToken) {
+		t.Errorf("link já usado deveria ser recusado, obtido %v", err)
+	}
+}
+
+func TestAccountService_EmailVerification(t *testing.T) {
+	ctx := i18n.WithLocale(tenant.WithID(context.Background(), 4), i18n.PtBR)
+	emailVO, _ := valueobject.NewEmail("maria@gmail.com")
+	user := entities.RebuildUser(7, "Maria", emailVO, entities.RoleClient)
+	_ = user.SetLocale("en")
+
+	users := mocks.NewMockUserRepository()
+	users.FindByIDFunc = func(ctx context.Context, id int) (*entities.User, error) {
+		return user, nil
+	}
+	users.MarkEmailVerifiedFunc = func(ctx context.Context, id int) error {
+		user.MarkEmailVerified(time.Now())
+		return nil
+	}
+
+	notifier := &fakeNotifier{}
+	service := NewAccountService(discardLogger(), users, memoryUserTokens(), mocks.NewMockRefreshTokenRepository(), versionedHasher{}, notifier,
+		AccountLinks{EmailVerificationURL: "https://app.exemplo.com/verificar-email"})
+
+	if err := service.RequestEmailVerification(ctx, 7); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(notifier.sent) != 1 || notifier.sent[0].Kind != notification.KindEmailVerification {
+		t.Fatalf("notificações incorretas: %+v", notifier.sent)
+	}
+	// a preferência do usuário vale mais que o idioma da requisição
+	if sent := notifier.sent[0]; sent.Locale != i18n.En || sent.Subject() != "Confirm your email" || !strings.Contains(sent.Text(), sent.Link) {
+		t.Errorf("notificação deveria estar em inglês e trazer o link: %q %q", sent.Subject(), sent.Text())
+	}
+	token := tokenFromLink(t, notifier.sent[0].Link)
+
+	t.Run("token de outra finalidade", func(t *testing.T) {
+		if err := service.ResetPassword(ctx, token, "novasenha"); !errors.Is(err, ErrInvalidUserToken) {
+			t.Errorf("token de verificação não deveria redefinir a senha, obtido %v", err)
+		}
+	})
+
+	t.Run("token expirado", func(t *testing.T) {
+		service.now = func() time.Time { return time.Now().Add(EmailVerificationTTL + time.Minute) }
+		defer func() { service.now = time.Now }()
+
+		if err := service.VerifyEmail(ctx, token); !errors.Is(err, ErrInvalidUserToken) {
+			t.Errorf("esperado ErrInvalidUserToken, obtido %v", err)
+		}
+	})
+
+	if err := service.VerifyEmail(ctx, token); err != nil {
+		t.Fatalf("erro inesperado ao verificar: %v", err)
+	}
+	if !user.IsEmailVerified() {
+		t.Error("email deveria estar verificado")
+	}
+
+	if err := service.RequestEmailVerification(ctx, 7); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(notifier.sent) != 1 {
+		t.Error("email já verificado não deveria receber novo link")
+	}
+}
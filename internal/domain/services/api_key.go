@@ -0,0 +1,219 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/tenant"
+)
+
+const (
+	// APIKeyPrefix identifica as chaves em logs e em varreduras de segredos
+	// vazados.
+	APIKeyPrefix = "sk_"
+	// APIKeyRotationGrace é quanto a chave antiga continua valendo depois de
+	// uma rotação, para que a integração troque a chave sem ficar fora do ar.
+	APIKeyRotationGrace = 24 * time.Hour
+	// apiKeyTouchInterval evita uma escrita no banco a cada requisição só
+	// para atualizar o último uso.
+	apiKeyTouchInterval = time.Minute
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+)
+
+var (
+	ErrInvalidAPIKey  = errs.Unauthorized("api_key.invalid")
+	ErrAPIKeyNotFound = errs.NotFound("api_key.not_found")
+)
+
+// IssuedAPIKey é uma chave recém-criada. Secret é a chave completa, exibida
+// uma única vez.
+type IssuedAPIKey struct {
+	Key    *entities.APIKey
+	Secret string
+}
+
+// APIKeyService gerencia as chaves de API de cada negócio e autentica as
+// requisições feitas com elas. Criação, rotação e revogação vão para o
+// registro de auditoria.
+type APIKeyService struct {
+	logger    *slog.Logger
+	keyRepo   repositories.APIKeyRepository
+	auditRepo repositories.AuditRepository
+	now       func() time.Time
+}
+
+func NewAPIKeyService(
+	logger *slog.Logger,
+	keyRepo repositories.APIKeyRepository,
+	auditRepo repositories.AuditRepository,
+) *APIKeyService {
+	return &APIKeyService{
+		logger:    logger,
+		keyRepo:   keyRepo,
+		auditRepo: auditRepo,
+		now:       time.Now,
+	}
+}
+
+func (s *APIKeyService) Create(ctx context.Context, actorID int, name string, scopes []string, ip string) (*IssuedAPIKey, error) {
+	issued, err := s.issue(ctx, actorID, name, scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordAudit(ctx, s.logger, s.auditRepo, &actorID, entities.AuditAPIKeyCreated, issued.Key.Prefix(), ip); err != nil {
+		return nil, err
+	}
+
+	return issued, nil
+}
+
+func (s *APIKeyService) List(ctx context.Context) ([]*entities.APIKey, error) {
+	keys, err := s.keyRepo.List(ctx)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao listar chaves de API",
+			"error", err.Error(),
+			"operation", "api_key_service.list",
+		)
+		return nil, err
+	}
+	return keys, nil
+}
+
+// Rotate emite uma nova chave com o mesmo nome e escopos. A antiga continua
+// valendo por APIKeyRotationGrace, ou menos se já estava para expirar.
+func (s *APIKeyService) Rotate(ctx context.Context, actorID, id int, ip string) (*IssuedAPIKey, error) {
+	current, err := s.keyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil || !current.IsActive(s.now()) {
+		return nil, ErrAPIKeyNotFound
+	}
+
+	issued, err := s.issue(ctx, actorID, current.Name(), current.Scopes())
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := s.now().Add(APIKeyRotationGrace)
+	if current.ExpiresAt() == nil || expiresAt.Before(*current.ExpiresAt()) {
+		if err := s.keyRepo.Expire(ctx, id, expiresAt); err != nil {
+			s.logger.ErrorContext(
+				ctx,
+				"Erro ao encerrar a chave de API substituída",
+				"error", err.Error(),
+				"api_key_id", id,
+				"operation", "api_key_service.rotate",
+			)
+			return nil, err
+		}
+	}
+
+	if err := recordAudit(ctx, s.logger, s.auditRepo, &actorID, entities.AuditAPIKeyRotated, current.Prefix(), ip); err != nil {
+		return nil, err
+	}
+
+	return issued, nil
+}
+
+func (s *APIKeyService) Revoke(ctx context.Context, actorID, id int, ip string) error {
+	key, err := s.keyRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return ErrAPIKeyNotFound
+	}
+
+	revoked, err := s.keyRepo.Revoke(ctx, id)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao revogar chave de API",
+			"error", err.Error(),
+			"api_key_id", id,
+			"operation", "api_key_service.revoke",
+		)
+		return err
+	}
+	if !revoked {
+		return ErrAPIKeyNotFound
+	}
+
+	return recordAudit(ctx, s.logger, s.auditRepo, &actorID, entities.AuditAPIKeyRevoked, key.Prefix(), ip)
+}
+
+// Verify autentica a chave apresentada na requisição e monta o principal da
+// integração. A busca é feita no tenant da requisição, então uma chave só vale
+// para o negócio que a criou.
+func (s *APIKeyService) Verify(ctx context.Context, plainKey string) (auth.Principal, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return auth.Principal{}, err
+	}
+	if !strings.HasPrefix(plainKey, APIKeyPrefix) {
+		return auth.Principal{}, ErrInvalidAPIKey
+	}
+
+	now := s.now()
+	key, err := s.keyRepo.FindByHash(ctx, hashToken(plainKey))
+	if err != nil {
+		return auth.Principal{}, err
+	}
+	if key == nil || !key.IsActive(now) {
+		return auth.Principal{}, ErrInvalidAPIKey
+	}
+
+	if key.LastUsedAt() == nil || now.Sub(*key.LastUsedAt()) >= apiKeyTouchInterval {
+		// falhar ao registrar o uso não deve derrubar a integração
+		if err := s.keyRepo.TouchLastUsed(ctx, key.ID(), now); err != nil {
+			s.logger.ErrorContext(
+				ctx,
+				"Erro ao registrar o uso da chave de API",
+				"error", err.Error(),
+				"api_key_id", key.ID(),
+				"operation", "api_key_service.touch_last_used",
+			)
+		}
+	}
+
+	return auth.Principal{
+		TenantID: tenantID,
+		APIKeyID: key.ID(),
+		Scopes:   key.Scopes(),
+	}, nil
+}
+
+func (s *APIKeyService) issue(ctx context.Context, actorID int, name string, scopes []string) (*IssuedAPIKey, error) {
+	token, err := newOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	secret := APIKeyPrefix + token
+
+	key, err := entities.NewAPIKey(name, secret[:apiKeyDisplayLength], hashToken(secret), scopes, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.keyRepo.Create(ctx, key); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao criar chave de API",
+			"error", err.Error(),
+			"operation", "api_key_service.create",
+		)
+		return nil, err
+	}
+
+	return &IssuedAPIKey{Key: key, Secret: secret}, nil
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/tenant"
+)
+
+// memoryAPIKeys reproduz em memória o contrato do repositório, sem separar
+// tenants. touches conta as atualizações do último uso.
+func memoryAPIKeys(touches *int) *mocks.MockAPIKeyRepository {
+	var keys []*entities.APIKey
+	repo := mocks.NewMockAPIKeyRepository()
+
+	find := func(match func(*entities.APIKey) bool) *entities.APIKey {
+		for _, key := range keys {
+			if match(key) {
+				return key
+			}
+		}
+		return nil
+	}
+	replace := func(id int, update func(k *entities.APIKey) *entities.APIKey) {
+		for i, key := range keys {
+			if key.ID() == id {
+				keys[i] = update(key)
+			}
+		}
+	}
+
+	repo.CreateFunc = func(ctx context.Context, key *entities.APIKey) error {
+		key.SetID(len(keys) + 1)
+		keys = append(keys, key)
+		return nil
+	}
+	repo.FindByHashFunc = func(ctx context.Context, keyHash string) (*entities.APIKey, error) {
+		return find(func(k *entities.APIKey) bool { return k.KeyHash() == keyHash }), nil
+	}
+	repo.FindByIDFunc = func(ctx context.Context, id int) (*entities.APIKey, error) {
+		return find(func(k *entities.APIKey) bool { return k.ID() == id }), nil
+	}
+	repo.RevokeFunc = func(ctx context.Context, id int) (bool, error) {
+		key := find(func(k *entities.APIKey) bool { return k.ID() == id })
+		if key == nil || key.RevokedAt() != nil {
+			return false, nil
+		}
+		now := time.Now()
+		replace(id, func(k *entities.APIKey) *entities.APIKey {
+			return entities.RebuildAPIKey(k.ID(), k.Name(), k.Prefix(), k.KeyHash(), k.Scopes(), k.CreatedBy(), k.ExpiresAt(), k.LastUsedAt(), &now, k.CreatedAt())
+		})
+		return true, nil
+	}
+	repo.ExpireFunc = func(ctx context.Context, id int, at time.Time) error {
+		replace(id, func(k *entities.APIKey) *entities.APIKey {
+			return entities.RebuildAPIKey(k.ID(), k.Name(), k.Prefix(), k.KeyHash(), k.Scopes(), k.CreatedBy(), &at, k.LastUsedAt(), k.RevokedAt(), k.CreatedAt())
+		})
+		return nil
+	}
+	repo.TouchLastUsedFunc = func(ctx context.Context, id int, at time.Time) error {
+		*touches++
+		replace(id, func(k *entities.APIKey) *entities.APIKey {
+			return entities.RebuildAPIKey(k.ID(), k.Name(), k.Prefix(), k.KeyHash(), k.Scopes(), k.CreatedBy(), k.ExpiresAt(), &at, k.RevokedAt(), k.CreatedAt())
+		})
+		return nil
+	}
+
+	return repo
+}
+
+func TestAPIKeyService(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	newService := func() (*APIKeyService, *[]*entities.AuditEntry, *int) {
+		var entries []*entities.AuditEntry
+		audit := mocks.NewMockAuditRepository()
+		audit.RecordFunc = func(ctx context.Context, entry *entities.AuditEntry) error {
+			entries = append(entries, entry)
+			return nil
+		}
+		touches := 0
+
+		service := NewAPIKeyService(discardLogger(), memoryAPIKeys(&touches), audit)
+		service.now = func() time.Time { return now }
+		return service, &entries, &touches
+	}
+
+	t.Run("criação e autenticação", func(t *testing.T) {
+		service, entries, touches := newService()
+
+		issued, err := service.Create(ctx, 1, "PDV loja 1", []string{entities.ScopeAvailabilityRead}, "10.0.0.9")
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if !strings.HasPrefix(issued.Secret, APIKeyPrefix) || !strings.HasPrefix(issued.Secret, issued.Key.Prefix()) {
+			t.Errorf("chave %q não começa com o prefixo %q", issued.Secret, issued.Key.Prefix())
+		}
+		if issued.Key.KeyHash() == issued.Secret {
+			t.Error("a chave não deveria ser guardada em texto puro")
+		}
+		if len(*entries) != 1 || (*entries)[0].Action() != entities.AuditAPIKeyCreated || (*entries)[0].Subject() != issued.Key.Prefix() {
+			t.Errorf("criação deveria ser auditada com o prefixo da chave, obtido %+v", *entries)
+		}
+
+		principal, err := service.Verify(ctx, issued.Secret)
+		if err != nil {
+			t.Fatalf("erro inesperado ao verificar: %v", err)
+		}
+		if !principal.IsAPIKey() || principal.TenantID != 4 || !principal.HasScope(entities.ScopeAvailabilityRead) || principal.HasScope(entities.ScopeServicesManage) {
+			t.Errorf("principal incorreto: %+v", principal)
+		}
+
+		now = now.Add(30 * time.Second)
+		_, _ = service.Verify(ctx, issued.Secret)
+		if *touches != 1 {
+			t.Errorf("último uso deveria ser atualizado no máximo uma vez por minuto, obtido %d escritas", *touches)
+		}
+		now = now.Add(time.Minute)
+		_, _ = service.Verify(ctx, issued.Secret)
+		if *touches != 2 {
+			t.Errorf("último uso deveria ter sido atualizado, obtido %d escritas", *touches)
+		}
+	})
+
+	t.Run("chaves inválidas", func(t *testing.T) {
+		service, _, _ := newService()
+
+		if _, err := service.Create(ctx, 1, "PDV", []string{"users:delete"}, ""); !errors.Is(err, entities.ErrInvalidScope) {
+			t.Errorf("esperado ErrInvalidScope, obtido %v", err)
+		}
+		if _, err := service.Verify(ctx, "abc"); !errors.Is(err, ErrInvalidAPIKey) {
+			t.Errorf("chave sem prefixo: esperado ErrInvalidAPIKey, obtido %v", err)
+		}
+		if _, err := service.Verify(ctx, APIKeyPrefix+"inexistente"); !errors.Is(err, ErrInvalidAPIKey) {
+			t.Errorf("chave inexistente: esperado ErrInvalidAPIKey, obtido %v", err)
+		}
+		if _, err := service.Verify(context.Background(), APIKeyPrefix+"inexistente"); err == nil {
+			t.Error("verificação sem tenant deveria falhar")
+		}
+	})
+
+	t.Run("rotação mantém a chave antiga durante a transição", func(t *testing.T) {
+		service, entries, _ := newService()
+
+		old, _ := service.Create(ctx, 1, "Site", []string{entities.ScopeAppointmentsWrite}, "")
+		rotated, err := service.Rotate(ctx, 1, old.Key.ID(), "10.0.0.9")
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if rotated.Secret == old.Secret || rotated.Key.Name() != "Site" || !rotated.Key.IsActive(now) {
+			t.Errorf("nova chave incorreta: %+v", rotated.Key)
+		}
+		if last := (*entries)[len(*entries)-1]; last.Action() != entities.AuditAPIKeyRotated {
+			t.Errorf("rotação deveria ser auditada, obtido %s", last.Action())
+		}
+
+		now = now.Add(APIKeyRotationGrace - time.Minute)
+		if _, err := service.Verify(ctx, old.Secret); err != nil {
+			t.Errorf("chave antiga deveria valer durante a transição: %v", err)
+		}
+		now = now.Add(time.Minute)
+		if _, err := service.Verify(ctx, old.Secret); !errors.Is(err, ErrInvalidAPIKey) {
+			t.Errorf("chave antiga deveria ter expirado, obtido %v", err)
+		}
+		if _, err := service.Verify(ctx, rotated.Secret); err != nil {
+			t.Errorf("nova chave deveria continuar valendo: %v", err)
+		}
+		if _, err := service.Rotate(ctx, 1, old.Key.ID(), ""); !errors.Is(err, ErrAPIKeyNotFound) {
+			t.Errorf("chave expirada não pode ser rotacionada, obtido %v", err)
+		}
+	})
+
+	t.Run("revogação", func(t *testing.T) {
+		service, entries, _ := newService()
+
+		issued, _ := service.Create(ctx, 1, "PDV", []string{entities.ScopeServicesManage}, "")
+		if err := service.Revoke(ctx, 2, issued.Key.ID(), "10.0.0.9"); err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if _, err := service.Verify(ctx, issued.Secret); !errors.Is(err, ErrInvalidAPIKey) {
+			t.Errorf("chave revogada deveria ser recusada, obtido %v", err)
+		}
+		last := (*entries)[len(*entries)-1]
+		if last.Action() != entities.AuditAPIKeyRevoked || last.ActorID() == nil || *last.ActorID() != 2 {
+			t.Errorf("revogação deveria ser auditada com o administrador, obtido %+v", last)
+		}
+
+		if err := service.Revoke(ctx, 2, issued.Key.ID(), ""); !errors.Is(err, ErrAPIKeyNotFound) {
+			t.Errorf("revogar de novo: esperado ErrAPIKeyNotFound, obtido %v", err)
+		}
+		if err := service.Revoke(ctx, 2, 99, ""); !errors.Is(err, ErrAPIKeyNotFound) {
+			t.Errorf("chave inexistente: esperado ErrAPIKeyNotFound, obtido %v", err)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+)
+
+type AppointmentService struct {
+	logger          *slog.Logger
+	appointmentRepo repositories.AppointmentRepository
+}
+
+func NewAppointmentService(logger *slog.Logger, appointmentRepo repositories.AppointmentRepository) *AppointmentService {
+	return &AppointmentService{
+		logger:          logger,
+		appointmentRepo: appointmentRepo,
+	}
+}
+
+func (s *AppointmentService) ListByClient(ctx context.Context, clientID int) ([]*entities.Appointment, error) {
+	startTime := time.Now()
+
+	appointments, err := s.appointmentRepo.FindAllByClientID(ctx, clientID)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao listar agendamentos do cliente",
+			"error", err.Error(),
+			"client_id", clientID,
+			"operation", "appointment_service.list_by_client",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return nil, err
+	}
+
+	return appointments, nil
+}
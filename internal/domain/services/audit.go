@@ -0,0 +1,37 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+)
+
+// recordAudit grava uma ação de segurança. actorID é nil para ações do
+// próprio sistema.
+func recordAudit(
+	ctx context.Context,
+	logger *slog.Logger,
+	auditRepo repositories.AuditRepository,
+	actorID *int,
+	action, subject, ip string,
+) error {
+	entry, err := entities.NewAuditEntry(actorID, action, subject, ip)
+	if err != nil {
+		return err
+	}
+
+	if err := auditRepo.Record(ctx, entry); err != nil {
+		logger.ErrorContext(
+			ctx,
+			"Erro ao gravar registro de auditoria",
+			"error", err.Error(),
+			"action", action,
+			"operation", "audit.record",
+		)
+		return err
+	}
+
+	return nil
+}
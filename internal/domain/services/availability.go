@@ -0,0 +1,391 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/valueobject"
+)
+
+var ErrServiceNotOffered = errs.Validation("availability.service_not_offered")
+
+const defaultAppointmentDuration = 30 * time.Minute
+
+type AvailabilityService struct {
+	logger          *slog.Logger
+	slotRepo        repositories.AvailableSlotRepository
+	breakRepo       repositories.StaffBreakRepository
+	appointmentRepo repositories.AppointmentRepository
+	serviceRepo     repositories.ServiceRepository
+	resourceRepo    repositories.ResourceRepository
+	locationRepo    repositories.LocationRepository
+	settings        *BusinessSettingsService
+}
+
+func NewAvailabilityService(
+	logger *slog.Logger,
+	slotRepo repositories.AvailableSlotRepository,
+	breakRepo repositories.StaffBreakRepository,
+	appointmentRepo repositories.AppointmentRepository,
+	serviceRepo repositories.ServiceRepository,
+	resourceRepo repositories.ResourceRepository,
+	locationRepo repositories.LocationRepository,
+	settings *BusinessSettingsService,
+) *AvailabilityService {
+	return &AvailabilityService{
+		logger:          logger,
+		slotRepo:        slotRepo,
+		breakRepo:       breakRepo,
+		appointmentRepo: appointmentRepo,
+		serviceRepo:     serviceRepo,
+		resourceRepo:    resourceRepo,
+		locationRepo:    locationRepo,
+		settings:        settings,
+	}
+}
+
+// AvailableTimes devolve os horários de início livres para o serviço na data,
+// já descontando agendamentos e pausas (fixas e flutuantes) do profissional e
+// os horários em que algum recurso exigido pelo serviço está sem capacidade.
+// Com uma unidade informada, só valem os expedientes daquela unidade e o
+// deslocamento entre unidades é reservado antes e depois de cada agendamento.
+// O dia de date é interpretado no fuso horário configurado pelo negócio.
+func (s *AvailabilityService) AvailableTimes(ctx context.Context, staffID, serviceID, locationID int, date time.Time) ([]time.Time, error) {
+	settings, err := s.businessSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, settings.Timezone())
+
+	if locationID != 0 {
+		offered, err := s.locationRepo.OffersService(ctx, locationID, serviceID)
+		if err != nil {
+			s.logger.ErrorContext(
+				ctx,
+				"Erro ao verificar serviços da unidade",
+				"error", err.Error(),
+				"location_id", locationID,
+				"operation", "availability_service.offers_service",
+			)
+			return nil, err
+		}
+		if !offered {
+			return nil, ErrServiceNotOffered
+		}
+	}
+
+	service, err := s.serviceRepo.FindByID(ctx, serviceID)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar serviço para cálculo de disponibilidade",
+			"error", err.Error(),
+			"service_id", serviceID,
+			"operation", "availability_service.find_service",
+		)
+		return nil, err
+	}
+
+	duration := time.Duration(service.DurationMinutes()) * time.Minute
+
+	free, err := s.FreeRanges(ctx, staffID, locationID, date, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	times := startTimes(free, duration, settings.SlotStep())
+
+	return s.filterByResources(ctx, serviceID, date, duration, times)
+}
+
+func (s *AvailabilityService) filterByResources(ctx context.Context, serviceID int, date time.Time, duration time.Duration, times []time.Time) ([]time.Time, error) {
+	if len(times) == 0 {
+		return times, nil
+	}
+
+	requirements, err := s.resourceRepo.FindRequirementsByServiceID(ctx, serviceID)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar recursos exigidos pelo serviço",
+			"error", err.Error(),
+			"service_id", serviceID,
+			"operation", "availability_service.find_requirements",
+		)
+		return nil, err
+	}
+
+	for _, requirement := range requirements {
+		resource, err := s.resourceRepo.FindByID(ctx, requirement.ResourceID())
+		if err != nil {
+			return nil, err
+		}
+
+		allocations, err := s.resourceRepo.FindAllocationsByDate(ctx, requirement.ResourceID(), date)
+		if err != nil {
+			s.logger.ErrorContext(
+				ctx,
+				"Erro ao buscar ocupação do recurso",
+				"error", err.Error(),
+				"resource_id", requirement.ResourceID(),
+				"operation", "availability_service.find_allocations",
+			)
+			return nil, err
+		}
+
+		var kept []time.Time
+		for _, start := range times {
+			if entities.PeakUsage(allocations, start, start.Add(duration))+requirement.Quantity() <= resource.Capacity() {
+				kept = append(kept, start)
+			}
+		}
+		times = kept
+	}
+
+	return times, nil
+}
+
+// FreeRanges calcula os intervalos livres do profissional na data. A duração
+// informada é usada para posicionar pausas flutuantes onde elas removem o
+// menor número de horários possíveis.
+func (s *AvailabilityService) FreeRanges(ctx context.Context, staffID, locationID int, date time.Time, duration time.Duration) ([]valueobject.TimeRange, error) {
+	settings, err := s.businessSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	working, err := s.workingRanges(ctx, staffID, locationID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	booked, err := s.bookedRanges(ctx, staffID, locationID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	breaks, err := s.breakRepo.FindByWeekday(ctx, staffID, entities.FromTimeWeekday(date.Weekday()))
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar pausas do profissional",
+			"error", err.Error(),
+			"staff_id", staffID,
+			"operation", "availability_service.find_breaks",
+		)
+		return nil, err
+	}
+
+	free := valueobject.SubtractAll(working, booked)
+
+	var floating []*entities.StaffBreak
+	for _, b := range breaks {
+		if b.IsFloating() {
+			floating = append(floating, b)
+			continue
+		}
+		window, err := valueobject.NewTimeRange(atDate(date, b.StartTime()), atDate(date, b.EndTime()))
+		if err != nil {
+			return nil, err
+		}
+		free = valueobject.SubtractAll(free, []valueobject.TimeRange{window})
+	}
+
+	for _, b := range floating {
+		placed, ok := s.placeFloatingBreak(b, date, free, booked, duration, settings.SlotStep())
+		if !ok {
+			continue
+		}
+		free = valueobject.SubtractAll(free, []valueobject.TimeRange{placed})
+	}
+
+	return free, nil
+}
+
+func (s *AvailabilityService) workingRanges(ctx context.Context, staffID, locationID int, date time.Time) ([]valueobject.TimeRange, error) {
+	slots, err := s.slotRepo.FindSlotsByStaffAndDate(ctx, staffID, date)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar horários de trabalho do profissional",
+			"error", err.Error(),
+			"staff_id", staffID,
+			"operation", "availability_service.find_slots",
+		)
+		return nil, err
+	}
+
+	var ranges []valueobject.TimeRange
+	for _, slot := range slots {
+		if !slot.AtLocation(locationID) {
+			continue
+		}
+		r, err := valueobject.NewTimeRange(atDate(date, slot.StartTime()), atDate(date, slot.EndTime()))
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+
+	return ranges, nil
+}
+
+// bookedRanges devolve os intervalos ocupados pelos agendamentos do dia. Quando
+// o agendamento é em outra unidade, o intervalo é estendido pelo tempo de
+// deslocamento de ida (antes) e de volta (depois) entre as unidades.
+func (s *AvailabilityService) bookedRanges(ctx context.Context, staffID, locationID int, date time.Time) ([]valueobject.TimeRange, error) {
+	appointments, err := s.appointmentRepo.FindScheduledByStaffAndDate(ctx, staffID, date)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar agendamentos do profissional",
+			"error", err.Error(),
+			"staff_id", staffID,
+			"operation", "availability_service.find_appointments",
+		)
+		return nil, err
+	}
+
+	durations := map[int]time.Duration{}
+	travel := map[[2]int]time.Duration{}
+	var ranges []valueobject.TimeRange
+	for _, appointment := range appointments {
+		d, ok := durations[appointment.ServiceID()]
+		if !ok {
+			d = defaultAppointmentDuration
+			service, err := s.serviceRepo.FindByID(ctx, appointment.ServiceID())
+			if err != nil {
+				return nil, err
+			}
+			if service != nil {
+				d = time.Duration(service.DurationMinutes()) * time.Minute
+			}
+			durations[appointment.ServiceID()] = d
+		}
+
+		start := appointment.ScheduledAt()
+		end := start.Add(d)
+
+		if locationID != 0 && appointment.LocationID() != 0 && appointment.LocationID() != locationID {
+			before, err := s.travelTime(ctx, travel, locationID, appointment.LocationID())
+			if err != nil {
+				return nil, err
+			}
+			after, err := s.travelTime(ctx, travel, appointment.LocationID(), locationID)
+			if err != nil {
+				return nil, err
+			}
+			start = start.Add(-before)
+			end = end.Add(after)
+		}
+
+		r, err := valueobject.NewTimeRange(start, end)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, r)
+	}
+
+	return ranges, nil
+}
+
+func (s *AvailabilityService) travelTime(ctx context.Context, cache map[[2]int]time.Duration, from, to int) (time.Duration, error) {
+	key := [2]int{from, to}
+	if d, ok := cache[key]; ok {
+		return d, nil
+	}
+
+	d, err := s.locationRepo.TravelTime(ctx, from, to)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar deslocamento entre unidades",
+			"error", err.Error(),
+			"from_location_id", from,
+			"to_location_id", to,
+			"operation", "availability_service.travel_time",
+		)
+		return 0, err
+	}
+	cache[key] = d
+
+	return d, nil
+}
+
+// placeFloatingBreak escolhe a posição da pausa dentro da sua janela evitando
+// agendamentos já marcados e, entre as opções restantes, a que preserva mais
+// horários livres para o serviço. Em caso de empate vence a mais cedo.
+func (s *AvailabilityService) placeFloatingBreak(
+	b *entities.StaffBreak,
+	date time.Time,
+	free []valueobject.TimeRange,
+	booked []valueobject.TimeRange,
+	duration time.Duration,
+	step time.Duration,
+) (valueobject.TimeRange, bool) {
+	windowStart := atDate(date, b.StartTime())
+	windowEnd := atDate(date, b.EndTime())
+	last := windowEnd.Add(-b.Duration())
+
+	var candidates []time.Time
+	for start := windowStart; !start.After(last); start = start.Add(step) {
+		candidates = append(candidates, start)
+	}
+	if len(candidates) == 0 || !candidates[len(candidates)-1].Equal(last) {
+		candidates = append(candidates, last)
+	}
+
+	var best valueobject.TimeRange
+	found := false
+	bestOverlap := time.Duration(-1)
+	bestScore := -1
+
+	for _, start := range candidates {
+		candidate, err := valueobject.NewTimeRange(start, start.Add(b.Duration()))
+		if err != nil {
+			continue
+		}
+
+		var overlap time.Duration
+		for _, r := range booked {
+			overlap += candidate.Overlap(r)
+		}
+
+		remaining := valueobject.SubtractAll(free, []valueobject.TimeRange{candidate})
+		score := len(startTimes(remaining, duration, step))
+
+		if !found || overlap < bestOverlap || (overlap == bestOverlap && score > bestScore) {
+			best, bestOverlap, bestScore, found = candidate, overlap, score, true
+		}
+	}
+
+	return best, found
+}
+
+// businessSettings devolve as configurações do tenant ou os valores padrão
+// quando o serviço foi montado sem configurações.
+func (s *AvailabilityService) businessSettings(ctx context.Context) (*entities.BusinessSettings, error) {
+	if s.settings == nil {
+		return entities.DefaultBusinessSettings(), nil
+	}
+	return s.settings.Get(ctx)
+}
+
+func startTimes(free []valueobject.TimeRange, duration, step time.Duration) []time.Time {
+	var times []time.Time
+	for _, r := range free {
+		for t := r.Start(); !t.Add(duration).After(r.End()); t = t.Add(step) {
+			times = append(times, t)
+		}
+	}
+	return times
+}
+
+// atDate projeta o horário (hora/minuto/segundo) de clock no dia de date.
+func atDate(date, clock time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, date.Location())
+}
@@ -0,0 +1,360 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/tenant"
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func clock(hour, minute int) time.Time {
+	return time.Date(0, 1, 1, hour, minute, 0, 0, time.UTC)
+}
+
+func TestAvailabilityService_AvailableTimes(t *testing.T) {
+	monday := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	on := func(hour, minute int) time.Time {
+		return time.Date(2030, 1, 7, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name          string
+		slots         [][2]time.Time
+		breaks        func() []*entities.StaffBreak
+		appointments  []time.Time
+		wantAvailable []time.Time
+		wantBlocked   []time.Time
+	}{
+		{
+			name:          "sem pausas todo o expediente é ofertado",
+			slots:         [][2]time.Time{{clock(9, 0), clock(12, 0)}},
+			wantAvailable: []time.Time{on(9, 0), on(10, 30), on(11, 0)},
+			wantBlocked:   []time.Time{on(11, 15), on(12, 0)},
+		},
+		{
+			name:  "pausa fixa de almoço é subtraída",
+			slots: [][2]time.Time{{clock(9, 0), clock(17, 0)}},
+			breaks: func() []*entities.StaffBreak {
+				b, _ := entities.NewStaffBreak(1, entities.Monday, clock(12, 0), clock(13, 0), 0)
+				return []*entities.StaffBreak{b}
+			},
+			wantAvailable: []time.Time{on(11, 0), on(13, 0)},
+			wantBlocked:   []time.Time{on(11, 15), on(12, 0), on(12, 30)},
+		},
+		{
+			name:  "pausa flutuante evita agendamento existente",
+			slots: [][2]time.Time{{clock(9, 0), clock(17, 0)}},
+			breaks: func() []*entities.StaffBreak {
+				b, _ := entities.NewStaffBreak(1, entities.Monday, clock(12, 0), clock(14, 0), 30)
+				return []*entities.StaffBreak{b}
+			},
+			appointments:  []time.Time{on(12, 0)},
+			wantAvailable: []time.Time{on(11, 0), on(13, 30)},
+			wantBlocked:   []time.Time{on(12, 0), on(13, 0)},
+		},
+		{
+			name:  "pausa flutuante vai para onde remove menos horários",
+			slots: [][2]time.Time{{clock(9, 0), clock(13, 0)}},
+			breaks: func() []*entities.StaffBreak {
+				b, _ := entities.NewStaffBreak(1, entities.Monday, clock(12, 0), clock(13, 0), 30)
+				return []*entities.StaffBreak{b}
+			},
+			wantAvailable: []time.Time{on(11, 0), on(11, 30)},
+			wantBlocked:   []time.Time{on(11, 45), on(12, 0)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slotRepo := mocks.NewMockAvailableSlotRepository()
+			slotRepo.FindSlotsByStaffAndDateFunc = func(ctx context.Context, staffID int, date time.Time) ([]*entities.AvailableSlot, error) {
+				var slots []*entities.AvailableSlot
+				for _, s := range tt.slots {
+					slot, err := entities.NewAvailableSlot(staffID, entities.Monday, s[0], s[1])
+					if err != nil {
+						t.Fatalf("falha ao criar slot: %v", err)
+					}
+					slots = append(slots, slot)
+				}
+				return slots, nil
+			}
+
+			breakRepo := mocks.NewMockStaffBreakRepository()
+			breakRepo.FindByWeekdayFunc = func(ctx context.Context, staffID int, weekday entities.Weekday) ([]*entities.StaffBreak, error) {
+				if tt.breaks == nil {
+					return nil, nil
+				}
+				return tt.breaks(), nil
+			}
+
+			appointmentRepo := mocks.NewMockAppointmentRepository()
+			appointmentRepo.FindScheduledByStaffAndDateFunc = func(ctx context.Context, staffID int, date time.Time) ([]*entities.Appointment, error) {
+				var appointments []*entities.Appointment
+				for i, at := range tt.appointments {
+					a, err := entities.RebuildAppointment(i+1, 9, staffID, 1, at, "scheduled", at)
+					if err != nil {
+						t.Fatalf("falha ao criar agendamento: %v", err)
+					}
+					appointments = append(appointments, a)
+				}
+				return appointments, nil
+			}
+
+			serviceRepo := mocks.NewMockServiceRepository()
+			serviceRepo.FindByIDFunc = func(ctx context.Context, id int) (*entities.Service, error) {
+				return entities.NewService(id, 1, "Corte", 60, 50)
+			}
+
+			service := NewAvailabilityService(discardLogger(), slotRepo, breakRepo, appointmentRepo, serviceRepo, mocks.NewMockResourceRepository(), mocks.NewMockLocationRepository(), nil)
+			got, err := service.AvailableTimes(context.Background(), 1, 1, 0, monday)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			offered := map[time.Time]bool{}
+			for _, ts := range got {
+				offered[ts] = true
+			}
+			for _, ts := range tt.wantAvailable {
+				if !offered[ts] {
+					t.Errorf("horário %s deveria estar disponível", ts.Format("15:04"))
+				}
+			}
+			for _, ts := range tt.wantBlocked {
+				if offered[ts] {
+					t.Errorf("horário %s não deveria estar disponível", ts.Format("15:04"))
+				}
+			}
+		})
+	}
+}
+
+func TestAvailabilityService_AvailableTimes_Errors(t *testing.T) {
+	serviceRepo := mocks.NewMockServiceRepository()
+	serviceRepo.FindByIDFunc = func(ctx context.Context, id int) (*entities.Service, error) {
+		return nil, errors.New("serviço não encontrado")
+	}
+
+	service := NewAvailabilityService(
+		discardLogger(),
+		mocks.NewMockAvailableSlotRepository(),
+		mocks.NewMockStaffBreakRepository(),
+		mocks.NewMockAppointmentRepository(),
+		serviceRepo,
+		mocks.NewMockResourceRepository(),
+		mocks.NewMockLocationRepository(),
+		nil,
+	)
+
+	_, err := service.AvailableTimes(context.Background(), 1, 1, 0, time.Now())
+	if err == nil || err.Error() != "serviço não encontrado" {
+		t.Errorf("erro esperado 'serviço não encontrado', obtido %v", err)
+	}
+}
+
+func TestAvailabilityService_AvailableTimes_Resources(t *testing.T) {
+	monday := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	on := func(hour, minute int) time.Time {
+		return time.Date(2030, 1, 7, hour, minute, 0, 0, time.UTC)
+	}
+
+	slotRepo := mocks.NewMockAvailableSlotRepository()
+	slotRepo.FindSlotsByStaffAndDateFunc = func(ctx context.Context, staffID int, date time.Time) ([]*entities.AvailableSlot, error) {
+		slot, _ := entities.NewAvailableSlot(staffID, entities.Monday, clock(9, 0), clock(12, 0))
+		return []*entities.AvailableSlot{slot}, nil
+	}
+
+	serviceRepo := mocks.NewMockServiceRepository()
+	serviceRepo.FindByIDFunc = func(ctx context.Context, id int) (*entities.Service, error) {
+		return entities.NewService(id, 1, "Massagem", 60, 120)
+	}
+
+	resourceRepo := mocks.NewMockResourceRepository()
+	resourceRepo.FindRequirementsByServiceIDFunc = func(ctx context.Context, serviceID int) ([]*entities.ResourceRequirement, error) {
+		req, _ := entities.NewResourceRequirement(serviceID, 7, 1)
+		return []*entities.ResourceRequirement{req}, nil
+	}
+	resourceRepo.FindByIDFunc = func(ctx context.Context, id int) (*entities.Resource, error) {
+		return entities.NewResource(id, "Maca", 1)
+	}
+	resourceRepo.FindAllocationsByDateFunc = func(ctx context.Context, resourceID int, date time.Time) ([]*entities.ResourceAllocation, error) {
+		// outro profissional está usando a maca das 10:00 às 11:00
+		a, _ := entities.NewResourceAllocation(resourceID, 1, on(10, 0), on(11, 0))
+		return []*entities.ResourceAllocation{a}, nil
+	}
+
+	service := NewAvailabilityService(
+		discardLogger(),
+		slotRepo,
+		mocks.NewMockStaffBreakRepository(),
+		mocks.NewMockAppointmentRepository(),
+		serviceRepo,
+		resourceRepo,
+		mocks.NewMockLocationRepository(),
+		nil,
+	)
+
+	got, err := service.AvailableTimes(context.Background(), 1, 1, 0, monday)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	offered := map[time.Time]bool{}
+	for _, ts := range got {
+		offered[ts] = true
+	}
+	for _, ts := range []time.Time{on(9, 0), on(11, 0)} {
+		if !offered[ts] {
+			t.Errorf("horário %s deveria estar disponível", ts.Format("15:04"))
+		}
+	}
+	for _, ts := range []time.Time{on(9, 15), on(10, 0), on(10, 45)} {
+		if offered[ts] {
+			t.Errorf("horário %s não deveria estar disponível", ts.Format("15:04"))
+		}
+	}
+}
+
+func TestAvailabilityService_AvailableTimes_Locations(t *testing.T) {
+	monday := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	on := func(hour, minute int) time.Time {
+		return time.Date(2030, 1, 7, hour, minute, 0, 0, time.UTC)
+	}
+
+	newService := func(offered bool) *AvailabilityService {
+		slotRepo := mocks.NewMockAvailableSlotRepository()
+		slotRepo.FindSlotsByStaffAndDateFunc = func(ctx context.Context, staffID int, date time.Time) ([]*entities.AvailableSlot, error) {
+			morning, _ := entities.NewAvailableSlot(staffID, entities.Monday, clock(9, 0), clock(13, 0))
+			morning.SetLocationID(1)
+			afternoon, _ := entities.NewAvailableSlot(staffID, entities.Monday, clock(14, 0), clock(18, 0))
+			afternoon.SetLocationID(2)
+			return []*entities.AvailableSlot{morning, afternoon}, nil
+		}
+
+		appointmentRepo := mocks.NewMockAppointmentRepository()
+		appointmentRepo.FindScheduledByStaffAndDateFunc = func(ctx context.Context, staffID int, date time.Time) ([]*entities.Appointment, error) {
+			a, _ := entities.RebuildAppointment(1, 9, staffID, 1, on(11, 0), "scheduled", on(8, 0))
+			a.SetLocationID(2)
+			return []*entities.Appointment{a}, nil
+		}
+
+		serviceRepo := mocks.NewMockServiceRepository()
+		serviceRepo.FindByIDFunc = func(ctx context.Context, id int) (*entities.Service, error) {
+			return entities.NewService(id, 1, "Corte", 30, 50)
+		}
+
+		locationRepo := mocks.NewMockLocationRepository()
+		locationRepo.OffersServiceFunc = func(ctx context.Context, locationID, serviceID int) (bool, error) {
+			return offered, nil
+		}
+		locationRepo.TravelTimeFunc = func(ctx context.Context, from, to int) (time.Duration, error) {
+			return 30 * time.Minute, nil
+		}
+
+		return NewAvailabilityService(
+			discardLogger(),
+			slotRepo,
+			mocks.NewMockStaffBreakRepository(),
+			appointmentRepo,
+			serviceRepo,
+			mocks.NewMockResourceRepository(),
+			locationRepo,
+			nil,
+		)
+	}
+
+	t.Run("deslocamento entre unidades é reservado", func(t *testing.T) {
+		got, err := newService(true).AvailableTimes(context.Background(), 1, 1, 1, monday)
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+
+		offered := map[time.Time]bool{}
+		for _, ts := range got {
+			offered[ts] = true
+		}
+		for _, ts := range []time.Time{on(9, 0), on(10, 0), on(12, 0), on(12, 30)} {
+			if !offered[ts] {
+				t.Errorf("horário %s deveria estar disponível", ts.Format("15:04"))
+			}
+		}
+		// 10:15 terminaria às 10:45, sem tempo de chegar à outra unidade às 11:00;
+		// 14:00 é expediente de outra unidade.
+		for _, ts := range []time.Time{on(10, 15), on(11, 0), on(11, 45), on(14, 0)} {
+			if offered[ts] {
+				t.Errorf("horário %s não deveria estar disponível", ts.Format("15:04"))
+			}
+		}
+	})
+
+	t.Run("serviço não oferecido na unidade", func(t *testing.T) {
+		_, err := newService(false).AvailableTimes(context.Background(), 1, 1, 1, monday)
+		if err != ErrServiceNotOffered {
+			t.Errorf("erro esperado %v, obtido %v", ErrServiceNotOffered, err)
+		}
+	})
+}
+
+func TestAvailabilityService_AvailableTimes_Settings(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("falha ao carregar fuso: %v", err)
+	}
+
+	slotRepo := mocks.NewMockAvailableSlotRepository()
+	slotRepo.FindSlotsByStaffAndDateFunc = func(ctx context.Context, staffID int, date time.Time) ([]*entities.AvailableSlot, error) {
+		slot, _ := entities.NewAvailableSlot(staffID, entities.FromTimeWeekday(date.Weekday()), clock(9, 0), clock(11, 0))
+		return []*entities.AvailableSlot{slot}, nil
+	}
+
+	serviceRepo := mocks.NewMockServiceRepository()
+	serviceRepo.FindByIDFunc = func(ctx context.Context, id int) (*entities.Service, error) {
+		return entities.NewService(id, 1, "Corte", 60, 50)
+	}
+
+	settingsRepo := mocks.NewMockBusinessSettingsRepository()
+	settingsRepo.FindFunc = func(ctx context.Context) (*entities.BusinessSettings, error) {
+		return entities.NewBusinessSettings(30, "America/Sao_Paulo", "BRL", 0, 30, "")
+	}
+
+	service := NewAvailabilityService(
+		discardLogger(),
+		slotRepo,
+		mocks.NewMockStaffBreakRepository(),
+		mocks.NewMockAppointmentRepository(),
+		serviceRepo,
+		mocks.NewMockResourceRepository(),
+		mocks.NewMockLocationRepository(),
+		NewBusinessSettingsService(discardLogger(), settingsRepo),
+	)
+
+	monday := time.Date(2025, 12, 22, 0, 0, 0, 0, time.UTC)
+	got, err := service.AvailableTimes(tenant.WithID(context.Background(), 1), 1, 1, 0, monday)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	want := []time.Time{
+		time.Date(2025, 12, 22, 9, 0, 0, 0, saoPaulo),
+		time.Date(2025, 12, 22, 9, 30, 0, 0, saoPaulo),
+		time.Date(2025, 12, 22, 10, 0, 0, 0, saoPaulo),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("esperados %d horários, obtidos %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("horário %d esperado %v, obtido %v", i, want[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+)
+
+var (
+	ErrSlotUnavailable      = errs.Conflict("booking.slot_unavailable")
+	ErrBeyondBookingHorizon = errs.Unprocessable("booking.beyond_horizon")
+)
+
+type BookingService struct {
+	logger       *slog.Logger
+	availability *AvailabilityService
+	serviceRepo  repositories.ServiceRepository
+	resourceRepo repositories.ResourceRepository
+	bookingRepo  repositories.BookingRepository
+	settings     *BusinessSettingsService
+}
+
+func NewBookingService(
+	logger *slog.Logger,
+	availability *AvailabilityService,
+	serviceRepo repositories.ServiceRepository,
+	resourceRepo repositories.ResourceRepository,
+	bookingRepo repositories.BookingRepository,
+	settings *BusinessSettingsService,
+) *BookingService {
+	return &BookingService{
+		logger:       logger,
+		availability: availability,
+		serviceRepo:  serviceRepo,
+		resourceRepo: resourceRepo,
+		bookingRepo:  bookingRepo,
+		settings:     settings,
+	}
+}
+
+// Book confere se o horário é ofertado (expediente, pausas e recursos) e grava
+// o agendamento. A checagem definitiva de conflitos acontece na transação do
+// BookingRepository, que protege contra reservas concorrentes.
+func (s *BookingService) Book(ctx context.Context, appointment *entities.Appointment) error {
+	startTime := time.Now()
+
+	settings := entities.DefaultBusinessSettings()
+	if s.settings != nil {
+		var err error
+		settings, err = s.settings.Get(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if appointment.ScheduledAt().After(settings.BookingLimit(startTime)) {
+		return ErrBeyondBookingHorizon
+	}
+
+	service, err := s.serviceRepo.FindByID(ctx, appointment.ServiceID())
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar serviço do agendamento",
+			"error", err.Error(),
+			"service_id", appointment.ServiceID(),
+			"operation", "booking_service.find_service",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+	duration := time.Duration(service.DurationMinutes()) * time.Minute
+
+	// o dia do agendamento é o dia no fuso do negócio, não o do horário enviado
+	scheduledAt := appointment.ScheduledAt().In(settings.Timezone())
+	times, err := s.availability.AvailableTimes(ctx, appointment.StaffID(), appointment.ServiceID(), appointment.LocationID(), scheduledAt)
+	if err != nil {
+		return err
+	}
+
+	offered := false
+	for _, t := range times {
+		if t.Equal(appointment.ScheduledAt()) {
+			offered = true
+			break
+		}
+	}
+	if !offered {
+		return ErrSlotUnavailable
+	}
+
+	requirements, err := s.resourceRepo.FindRequirementsByServiceID(ctx, appointment.ServiceID())
+	if err != nil {
+		return err
+	}
+
+	if err := s.bookingRepo.Book(ctx, appointment, duration, requirements); err != nil {
+		if !errors.Is(err, repositories.ErrStaffUnavailable) && !errors.Is(err, repositories.ErrResourceUnavailable) {
+			s.logger.ErrorContext(
+				ctx,
+				"Erro ao tentar gravar o agendamento",
+				"error", err.Error(),
+				"staff_id", appointment.StaffID(),
+				"operation", "booking_service.book",
+				"duration_ms", time.Since(startTime).Milliseconds(),
+			)
+		}
+		return err
+	}
+
+	return nil
+}
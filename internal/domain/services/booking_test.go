@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/tenant"
+)
+
+func TestBookingService_Book(t *testing.T) {
+	day := time.Now().AddDate(0, 0, 7)
+	on := func(hour, minute int) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		scheduledAt time.Time
+		bookErr     error
+		wantErr     error
+		wantBooked  bool
+	}{
+		{
+			name:        "horário ofertado é reservado",
+			scheduledAt: on(10, 0),
+			wantBooked:  true,
+		},
+		{
+			name:        "horário fora do expediente",
+			scheduledAt: on(18, 0),
+			wantErr:     ErrSlotUnavailable,
+		},
+		{
+			name:        "conflito detectado na transação",
+			scheduledAt: on(10, 0),
+			bookErr:     repositories.ErrResourceUnavailable,
+			wantErr:     repositories.ErrResourceUnavailable,
+			wantBooked:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slotRepo := mocks.NewMockAvailableSlotRepository()
+			slotRepo.FindSlotsByStaffAndDateFunc = func(ctx context.Context, staffID int, date time.Time) ([]*entities.AvailableSlot, error) {
+				slot, _ := entities.NewAvailableSlot(staffID, entities.FromTimeWeekday(date.Weekday()), clock(9, 0), clock(17, 0))
+				return []*entities.AvailableSlot{slot}, nil
+			}
+
+			serviceRepo := mocks.NewMockServiceRepository()
+			serviceRepo.FindByIDFunc = func(ctx context.Context, id int) (*entities.Service, error) {
+				return entities.NewService(id, 1, "Massagem", 60, 120)
+			}
+
+			resourceRepo := mocks.NewMockResourceRepository()
+			resourceRepo.FindRequirementsByServiceIDFunc = func(ctx context.Context, serviceID int) ([]*entities.ResourceRequirement, error) {
+				req, _ := entities.NewResourceRequirement(serviceID, 7, 1)
+				return []*entities.ResourceRequirement{req}, nil
+			}
+			resourceRepo.FindByIDFunc = func(ctx context.Context, id int) (*entities.Resource, error) {
+				return entities.NewResource(id, "Maca", 1)
+			}
+
+			booked := false
+			bookingRepo := mocks.NewMockBookingRepository()
+			bookingRepo.BookFunc = func(ctx context.Context, appointment *entities.Appointment, duration time.Duration, requirements []*entities.ResourceRequirement) error {
+				booked = true
+				if duration != time.Hour {
+					t.Errorf("duração esperada 1h, obtida %v", duration)
+				}
+				if len(requirements) != 1 {
+					t.Errorf("esperado 1 requisito de recurso, obtido %d", len(requirements))
+				}
+				return tt.bookErr
+			}
+
+			availability := NewAvailabilityService(
+				discardLogger(),
+				slotRepo,
+				mocks.NewMockStaffBreakRepository(),
+				mocks.NewMockAppointmentRepository(),
+				serviceRepo,
+				resourceRepo,
+				mocks.NewMockLocationRepository(),
+				nil,
+			)
+			service := NewBookingService(discardLogger(), availability, serviceRepo, resourceRepo, bookingRepo, nil)
+
+			appointment, err := entities.NewAppointment(1, 2, 3, tt.scheduledAt)
+			if err != nil {
+				t.Fatalf("falha ao criar agendamento: %v", err)
+			}
+
+			err = service.Book(context.Background(), appointment)
+			if err != tt.wantErr {
+				t.Errorf("erro esperado %v, obtido %v", tt.wantErr, err)
+			}
+			if booked != tt.wantBooked {
+				t.Errorf("Book chamado = %v, esperado %v", booked, tt.wantBooked)
+			}
+		})
+	}
+}
+
+func TestBookingService_Book_BookingHorizon(t *testing.T) {
+	settingsRepo := mocks.NewMockBusinessSettingsRepository()
+	settingsRepo.FindFunc = func(ctx context.Context) (*entities.BusinessSettings, error) {
+		return entities.NewBusinessSettings(15, "UTC", "BRL", 0, 7, "")
+	}
+	settings := NewBusinessSettingsService(discardLogger(), settingsRepo)
+
+	bookingRepo := mocks.NewMockBookingRepository()
+	booked := false
+	bookingRepo.BookFunc = func(ctx context.Context, appointment *entities.Appointment, duration time.Duration, requirements []*entities.ResourceRequirement) error {
+		booked = true
+		return nil
+	}
+
+	serviceRepo := mocks.NewMockServiceRepository()
+	availability := NewAvailabilityService(
+		discardLogger(),
+		mocks.NewMockAvailableSlotRepository(),
+		mocks.NewMockStaffBreakRepository(),
+		mocks.NewMockAppointmentRepository(),
+		serviceRepo,
+		mocks.NewMockResourceRepository(),
+		mocks.NewMockLocationRepository(),
+		settings,
+	)
+	service := NewBookingService(discardLogger(), availability, serviceRepo, mocks.NewMockResourceRepository(), bookingRepo, settings)
+
+	appointment, err := entities.NewAppointment(1, 2, 3, time.Now().AddDate(0, 0, 8))
+	if err != nil {
+		t.Fatalf("falha ao criar agendamento: %v", err)
+	}
+
+	err = service.Book(tenant.WithID(context.Background(), 1), appointment)
+	if err != ErrBeyondBookingHorizon {
+		t.Errorf("erro esperado %v, obtido %v", ErrBeyondBookingHorizon, err)
+	}
+	if booked {
+		t.Error("Book não deveria ser chamado além do horizonte")
+	}
+}
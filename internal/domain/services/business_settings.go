@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/tenant"
+)
+
+// defaultSettingsTTL limita por quanto tempo uma instância pode servir uma
+// configuração alterada por outra instância da API.
+const defaultSettingsTTL = 5 * time.Minute
+
+type cachedSettings struct {
+	settings  *entities.BusinessSettings
+	expiresAt time.Time
+}
+
+// BusinessSettingsService entrega as configurações do tenant da requisição a
+// partir de um cache em memória. Update grava e invalida a entrada do tenant,
+// então a própria instância passa a enxergar a alteração imediatamente. Cada
+// invalidação avança a geração do tenant; uma leitura do repositório iniciada
+// antes dela não volta a popular o cache com a configuração antiga.
+type BusinessSettingsService struct {
+	logger       *slog.Logger
+	settingsRepo repositories.BusinessSettingsRepository
+	ttl          time.Duration
+	now          func() time.Time
+
+	mu          sync.RWMutex
+	cache       map[int]cachedSettings
+	generations map[int]uint64
+}
+
+func NewBusinessSettingsService(logger *slog.Logger, settingsRepo repositories.BusinessSettingsRepository) *BusinessSettingsService {
+	return &BusinessSettingsService{
+		logger:       logger,
+		settingsRepo: settingsRepo,
+		ttl:          defaultSettingsTTL,
+		now:          time.Now,
+		cache:        map[int]cachedSettings{},
+		generations:  map[int]uint64{},
+	}
+}
+
+func (s *BusinessSettingsService) Get(ctx context.Context) (*entities.BusinessSettings, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	entry, ok := s.cache[tenantID]
+	generation := s.generations[tenantID]
+	s.mu.RUnlock()
+	if ok && s.now().Before(entry.expiresAt) {
+		return entry.settings, nil
+	}
+
+	settings, err := s.settingsRepo.Find(ctx)
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar configurações do negócio",
+			"error", err.Error(),
+			"tenant_id", tenantID,
+			"operation", "business_settings_service.find",
+		)
+		return nil, err
+	}
+	if settings == nil {
+		settings = entities.DefaultBusinessSettings()
+	}
+
+	s.mu.Lock()
+	if s.generations[tenantID] == generation {
+		s.cache[tenantID] = cachedSettings{settings: settings, expiresAt: s.now().Add(s.ttl)}
+	}
+	s.mu.Unlock()
+
+	return settings, nil
+}
+
+func (s *BusinessSettingsService) Update(ctx context.Context, settings *entities.BusinessSettings) error {
+	startTime := time.Now()
+
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.settingsRepo.Save(ctx, settings); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao tentar salvar configurações do negócio",
+			"error", err.Error(),
+			"tenant_id", tenantID,
+			"operation", "business_settings_service.update",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	s.Invalidate(tenantID)
+
+	return nil
+}
+
+func (s *BusinessSettingsService) Invalidate(tenantID int) {
+	s.mu.Lock()
+	delete(s.cache, tenantID)
+	s.generations[tenantID]++
+	s.mu.Unlock()
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/tenant"
+)
+
+func TestBusinessSettingsService_Get(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 1)
+
+	t.Run("usa o cache após a primeira leitura", func(t *testing.T) {
+		calls := 0
+		repo := mocks.NewMockBusinessSettingsRepository()
+		repo.FindFunc = func(ctx context.Context) (*entities.BusinessSettings, error) {
+			calls++
+			return entities.NewBusinessSettings(30, "UTC", "BRL", 0, 30, "Studio")
+		}
+
+		service := NewBusinessSettingsService(discardLogger(), repo)
+		for i := 0; i < 3; i++ {
+			settings, err := service.Get(ctx)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if settings.SlotStep() != 30*time.Minute {
+				t.Errorf("SlotStep esperado 30m, obtido %v", settings.SlotStep())
+			}
+		}
+
+		if calls != 1 {
+			t.Errorf("esperada 1 leitura no repositório, obtidas %d", calls)
+		}
+	})
+
+	t.Run("tenant sem configuração recebe os valores padrão", func(t *testing.T) {
+		service := NewBusinessSettingsService(discardLogger(), mocks.NewMockBusinessSettingsRepository())
+
+		settings, err := service.Get(ctx)
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if settings.SlotStep() != entities.DefaultSlotStepMinutes*time.Minute {
+			t.Errorf("SlotStep padrão esperado, obtido %v", settings.SlotStep())
+		}
+	})
+
+	t.Run("cache separado por tenant", func(t *testing.T) {
+		repo := mocks.NewMockBusinessSettingsRepository()
+		repo.FindFunc = func(ctx context.Context) (*entities.BusinessSettings, error) {
+			id, _ := tenant.FromContext(ctx)
+			return entities.NewBusinessSettings(id*10, "UTC", "BRL", 0, 30, "")
+		}
+
+		service := NewBusinessSettingsService(discardLogger(), repo)
+		first, _ := service.Get(tenant.WithID(context.Background(), 1))
+		second, _ := service.Get(tenant.WithID(context.Background(), 2))
+
+		if first.SlotStep() == second.SlotStep() {
+			t.Errorf("tenants diferentes não deveriam compartilhar configuração: %v", first.SlotStep())
+		}
+	})
+
+	t.Run("entrada expirada é relida", func(t *testing.T) {
+		calls := 0
+		repo := mocks.NewMockBusinessSettingsRepository()
+		repo.FindFunc = func(ctx context.Context) (*entities.BusinessSettings, error) {
+			calls++
+			return nil, nil
+		}
+
+		now := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+		service := NewBusinessSettingsService(discardLogger(), repo)
+		service.now = func() time.Time { return now }
+
+		_, _ = service.Get(ctx)
+		now = now.Add(defaultSettingsTTL + time.Second)
+		_, _ = service.Get(ctx)
+
+		if calls != 2 {
+			t.Errorf("esperadas 2 leituras no repositório, obtidas %d", calls)
+		}
+	})
+
+	t.Run("sem tenant no contexto", func(t *testing.T) {
+		service := NewBusinessSettingsService(discardLogger(), mocks.NewMockBusinessSettingsRepository())
+
+		_, err := service.Get(context.Background())
+		if !errors.Is(err, tenant.ErrMissingTenant) {
+			t.Errorf("erro esperado '%v', obtido '%v'", tenant.ErrMissingTenant, err)
+		}
+	})
+}
+
+func TestBusinessSettingsService_Update(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 1)
+
+	stored, _ := entities.NewBusinessSettings(15, "UTC", "BRL", 0, 30, "")
+	repo := mocks.NewMockBusinessSettingsRepository()
+	repo.FindFunc = func(ctx context.Context) (*entities.BusinessSettings, error) {
+		return stored, nil
+	}
+	repo.SaveFunc = func(ctx context.Context, settings *entities.BusinessSettings) error {
+		stored = settings
+		return nil
+	}
+
+	service := NewBusinessSettingsService(discardLogger(), repo)
+	if _, err := service.Get(ctx); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	updated, _ := entities.NewBusinessSettings(45, "UTC", "BRL", 0, 30, "")
+	if err := service.Update(ctx, updated); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	got, _ := service.Get(ctx)
+	if got.SlotStep() != 45*time.Minute {
+		t.Errorf("cache deveria ser invalidado após Update: SlotStep esperado 45m, obtido %v", got.SlotStep())
+	}
+
+	t.Run("leitura anterior ao Update não repopula o cache", func(t *testing.T) {
+		stale, _ := entities.NewBusinessSettings(20, "UTC", "BRL", 0, 30, "")
+		fresh, _ := entities.NewBusinessSettings(50, "UTC", "BRL", 0, 30, "")
+
+		repo := mocks.NewMockBusinessSettingsRepository()
+		service := NewBusinessSettingsService(discardLogger(), repo)
+
+		current := stale
+		repo.SaveFunc = func(ctx context.Context, settings *entities.BusinessSettings) error {
+			current = settings
+			return nil
+		}
+		repo.FindFunc = func(ctx context.Context) (*entities.BusinessSettings, error) {
+			loaded := current
+			if loaded == stale {
+				// Update concluído enquanto esta leitura ainda estava em andamento
+				if err := service.Update(ctx, fresh); err != nil {
+					t.Fatalf("erro inesperado: %v", err)
+				}
+			}
+			return loaded, nil
+		}
+
+		if got, _ := service.Get(ctx); got.SlotStep() != 20*time.Minute {
+			t.Fatalf("SlotStep esperado 20m na leitura concorrente, obtido %v", got.SlotStep())
+		}
+
+		got, _ := service.Get(ctx)
+		if got.SlotStep() != 50*time.Minute {
+			t.Errorf("configuração antiga não deveria ficar em cache: SlotStep esperado 50m, obtido %v", got.SlotStep())
+		}
+	})
+
+	t.Run("erro ao salvar mantém o cache", func(t *testing.T) {
+		repo.SaveFunc = func(ctx context.Context, settings *entities.BusinessSettings) error {
+			return errors.New("database error")
+		}
+
+		broken, _ := entities.NewBusinessSettings(60, "UTC", "BRL", 0, 30, "")
+		if err := service.Update(ctx, broken); err == nil {
+			t.Fatal("erro esperado, mas não obtive nenhum")
+		}
+
+		got, _ := service.Get(ctx)
+		if got.SlotStep() != 45*time.Minute {
+			t.Errorf("SlotStep esperado 45m, obtido %v", got.SlotStep())
+		}
+	})
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+)
+
+var (
+	ErrExternalEmailMissing = errs.Validation("external_login.email_missing")
+	ErrExternalEmailInUse   = errs.Conflict("external_login.email_in_use")
+)
+
+// ExternalProfile é a identidade afirmada por um provedor OIDC depois que o
+// ID token foi validado.
+type ExternalProfile struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// ExternalLoginService resolve o usuário de um login feito por provedor
+// externo: pelo vínculo já existente, por uma conta com o mesmo email
+// verificado ou criando uma nova conta de cliente.
+type ExternalLoginService struct {
+	logger       *slog.Logger
+	userRepo     repositories.UserRepository
+	identityRepo repositories.ExternalIdentityRepository
+}
+
+func NewExternalLoginService(
+	logger *slog.Logger,
+	userRepo repositories.UserRepository,
+	identityRepo repositories.ExternalIdentityRepository,
+) *ExternalLoginService {
+	return &ExternalLoginService{
+		logger:       logger,
+		userRepo:     userRepo,
+		identityRepo: identityRepo,
+	}
+}
+
+func (s *ExternalLoginService) Login(ctx context.Context, profile ExternalProfile) (*entities.User, error) {
+	identity, err := s.identityRepo.FindBySubject(ctx, profile.Provider, profile.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if identity != nil {
+		user, err := s.userRepo.FindByID(ctx, identity.UserID())
+		if err != nil {
+			return nil, err
+		}
+		if user == nil {
+			return nil, repositories.ErrUserNotFound
+		}
+		return user, nil
+	}
+
+	if profile.Email == "" {
+		return nil, ErrExternalEmailMissing
+	}
+
+	existing, err := s.userRepo.FindByEmail(ctx, profile.Email)
+	switch {
+	case err == nil:
+		// sem email verificado, qualquer um poderia se apropriar da conta
+		// criando no provedor uma conta com o email da vítima
+		if !profile.EmailVerified {
+			return nil, ErrExternalEmailInUse
+		}
+		if err := s.link(ctx, &existing, profile); err != nil {
+			return nil, err
+		}
+		return &existing, s.markEmailVerified(ctx, &existing)
+
+	case errors.Is(err, repositories.ErrUserNotFound):
+		return s.createUser(ctx, profile)
+
+	default:
+		return nil, err
+	}
+}
+
+func (s *ExternalLoginService) createUser(ctx context.Context, profile ExternalProfile) (*entities.User, error) {
+	startTime := time.Now()
+
+	user, err := entities.NewExternalUser(profile.Name, profile.Email)
+	if err != nil {
+		return nil, err
+	}
+	if profile.EmailVerified {
+		user.MarkEmailVerified(time.Now())
+	}
+
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao criar usuário de login externo",
+			"error", err.Error(),
+			"provider", profile.Provider,
+			"operation", "external_login_service.create_user",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return nil, err
+	}
+
+	return user, s.link(ctx, user, profile)
+}
+
+func (s *ExternalLoginService) link(ctx context.Context, user *entities.User, profile ExternalProfile) error {
+	identity, err := entities.NewExternalIdentity(user.ID(), profile.Provider, profile.Subject, profile.Email)
+	if err != nil {
+		return err
+	}
+
+	if err := s.identityRepo.Create(ctx, identity); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao vincular identidade externa",
+			"error", err.Error(),
+			"user_id", user.ID(),
+			"provider", profile.Provider,
+			"operation", "external_login_service.link_identity",
+		)
+		return err
+	}
+
+	return nil
+}
+
+// markEmailVerified aproveita a verificação feita pelo provedor para a conta
+// local que acabou de ser vinculada.
+func (s *ExternalLoginService) markEmailVerified(ctx context.Context, user *entities.User) error {
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	if err := s.userRepo.MarkEmailVerified(ctx, user.ID()); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao marcar o email como verificado",
+			"error", err.Error(),
+			"user_id", user.ID(),
+			"operation", "external_login_service.mark_email_verified",
+		)
+		return err
+	}
+	user.MarkEmailVerified(time.Now())
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/valueobject"
+)
+
+func TestExternalLoginService_Login(t *testing.T) {
+	existingUser := func() entities.User {
+		email, _ := valueobject.NewEmail("maria@gmail.com")
+		return *entities.RebuildUser(7, "Maria", email, entities.RoleClient)
+	}
+
+	tests := []struct {
+		name         string
+		profile      ExternalProfile
+		linked       bool
+		emailExists  bool
+		wantUserID   int
+		wantCreated  bool
+		wantLinkedTo int
+		wantVerified bool
+		wantErr      error
+	}{
+		{
+			name:       "vínculo existente",
+			profile:    ExternalProfile{Provider: "google", Subject: "sub-1", Email: "outro@gmail.com"},
+			linked:     true,
+			wantUserID: 7,
+		},
+		{
+			name:         "email verificado de conta existente é vinculado",
+			profile:      ExternalProfile{Provider: "google", Subject: "sub-1", Email: "maria@gmail.com", EmailVerified: true},
+			emailExists:  true,
+			wantUserID:   7,
+			wantLinkedTo: 7,
+			wantVerified: true,
+		},
+		{
+			name:        "email não verificado de conta existente é recusado",
+			profile:     ExternalProfile{Provider: "google", Subject: "sub-1", Email: "maria@gmail.com"},
+			emailExists: true,
+			wantErr:     ErrExternalEmailInUse,
+		},
+		{
+			name:         "primeiro login cria conta de cliente",
+			profile:      ExternalProfile{Provider: "google", Subject: "sub-1", Email: "novo@gmail.com", Name: "Novo"},
+			wantUserID:   42,
+			wantCreated:  true,
+			wantLinkedTo: 42,
+		},
+		{
+			name:         "primeiro login com email verificado pelo provedor",
+			profile:      ExternalProfile{Provider: "google", Subject: "sub-1", Email: "novo@gmail.com", EmailVerified: true},
+			wantUserID:   42,
+			wantCreated:  true,
+			wantLinkedTo: 42,
+			wantVerified: true,
+		},
+		{
+			name:    "provedor sem email",
+			profile: ExternalProfile{Provider: "google", Subject: "sub-1"},
+			wantErr: ErrExternalEmailMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identities := mocks.NewMockExternalIdentityRepository()
+			identities.FindBySubjectFunc = func(ctx context.Context, provider, subject string) (*entities.ExternalIdentity, error) {
+				if !tt.linked {
+					return nil, nil
+				}
+				return entities.RebuildExternalIdentity(1, 7, provider, subject, "", time.Now()), nil
+			}
+			linkedTo := 0
+			identities.CreateFunc = func(ctx context.Context, identity *entities.ExternalIdentity) error {
+				linkedTo = identity.UserID()
+				return nil
+			}
+
+			users := mocks.NewMockUserRepository()
+			users.FindByIDFunc = func(ctx context.Context, id int) (*entities.User, error) {
+				user := existingUser()
+				return &user, nil
+			}
+			users.FindByEmailFunc = func(ctx context.Context, email string) (entities.User, error) {
+				if !tt.emailExists {
+					return entities.User{}, repositories.ErrUserNotFound
+				}
+				return existingUser(), nil
+			}
+			created := false
+			users.CreateFunc = func(ctx context.Context, user *entities.User) error {
+				created = true
+				if user.Role() != entities.RoleClient || user.PasswordHash() != "" {
+					t.Errorf("conta externa deveria ser cliente sem senha: papel=%s", user.Role())
+				}
+				user.SetID(42)
+				return nil
+			}
+
+			service := NewExternalLoginService(discardLogger(), users, identities)
+			user, err := service.Login(context.Background(), tt.profile)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("erro esperado %v, obtido %v", tt.wantErr, err)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if user.ID() != tt.wantUserID {
+				t.Errorf("usuário esperado %d, obtido %d", tt.wantUserID, user.ID())
+			}
+			if created != tt.wantCreated {
+				t.Errorf("conta criada = %v, esperado %v", created, tt.wantCreated)
+			}
+			if linkedTo != tt.wantLinkedTo {
+				t.Errorf("vínculo criado para %d, esperado %d", linkedTo, tt.wantLinkedTo)
+			}
+			if user.IsEmailVerified() != tt.wantVerified {
+				t.Errorf("email verificado = %v, esperado %v", user.IsEmailVerified(), tt.wantVerified)
+			}
+		})
+	}
+}
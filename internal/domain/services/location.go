@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+)
+
+var ErrInvalidTravelTime = errs.Validation("location.invalid_travel_time")
+
+type LocationService struct {
+	logger       *slog.Logger
+	locationRepo repositories.LocationRepository
+	serviceRepo  repositories.ServiceRepository
+}
+
+func NewLocationService(
+	logger *slog.Logger,
+	locationRepo repositories.LocationRepository,
+	serviceRepo repositories.ServiceRepository,
+) *LocationService {
+	return &LocationService{
+		logger:       logger,
+		locationRepo: locationRepo,
+		serviceRepo:  serviceRepo,
+	}
+}
+
+func (s *LocationService) Create(ctx context.Context, location *entities.Location) error {
+	startTime := time.Now()
+
+	if err := s.locationRepo.Save(ctx, location); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao tentar criar a unidade",
+			"error", err.Error(),
+			"operation", "location_service.create_location",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (s *LocationService) AddService(ctx context.Context, locationID, serviceID int) error {
+	startTime := time.Now()
+
+	if _, err := s.locationRepo.FindByID(ctx, locationID); err != nil {
+		return err
+	}
+	if _, err := s.serviceRepo.FindByID(ctx, serviceID); err != nil {
+		return err
+	}
+
+	if err := s.locationRepo.AddService(ctx, locationID, serviceID); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao tentar associar serviço à unidade",
+			"error", err.Error(),
+			"location_id", locationID,
+			"service_id", serviceID,
+			"operation", "location_service.add_service",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	return nil
+}
+
+func (s *LocationService) SetTravelTime(ctx context.Context, fromLocationID, toLocationID int, travel time.Duration) error {
+	startTime := time.Now()
+
+	if fromLocationID == toLocationID || travel < 0 {
+		return ErrInvalidTravelTime
+	}
+
+	if err := s.locationRepo.SetTravelTime(ctx, fromLocationID, toLocationID, travel); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao tentar gravar deslocamento entre unidades",
+			"error", err.Error(),
+			"from_location_id", fromLocationID,
+			"to_location_id", toLocationID,
+			"operation", "location_service.set_travel_time",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+)
+
+var ErrTooManyLoginAttempts = errs.RateLimited("auth.too_many_login_attempts")
+
+// LoginLockedError informa quanto tempo falta para o login voltar a ser
+// aceito. errors.Is(err, ErrTooManyLoginAttempts) continua valendo.
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string { return ErrTooManyLoginAttempts.Error() }
+func (e *LoginLockedError) Unwrap() error { return ErrTooManyLoginAttempts }
+
+// ThrottleRule define a reação às falhas seguidas de login. As primeiras
+// FreeAttempts falhas não têm efeito; a partir daí cada falha impõe uma
+// espera que dobra a cada tentativa, começando em BaseDelay e limitada a
+// MaxDelay. Ao chegar em MaxFailures a espera passa a ser LockoutDuration.
+// Falhas mais antigas que Window deixam de contar.
+type ThrottleRule struct {
+	FreeAttempts    int
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	MaxFailures     int
+	LockoutDuration time.Duration
+	Window          time.Duration
+}
+
+var (
+	DefaultAccountThrottle = ThrottleRule{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+		MaxFailures:     10,
+		LockoutDuration: 15 * time.Minute,
+		Window:          time.Hour,
+	}
+	// um IP pode ser compartilhado por muitos usuários (NAT, escritório),
+	// então tolera mais falhas que uma conta
+	DefaultIPThrottle = ThrottleRule{
+		FreeAttempts:    10,
+		BaseDelay:       time.Second,
+		MaxDelay:        30 * time.Second,
+		MaxFailures:     50,
+		LockoutDuration: 15 * time.Minute,
+		Window:          time.Hour,
+	}
+)
+
+// delay retorna a espera imposta depois da falha de número failures.
+func (r ThrottleRule) delay(failures int) time.Duration {
+	if r.MaxFailures > 0 && failures >= r.MaxFailures {
+		return r.LockoutDuration
+	}
+
+	extra := failures - r.FreeAttempts
+	if extra <= 0 || r.BaseDelay <= 0 {
+		return 0
+	}
+
+	delay := r.BaseDelay
+	for i := 1; i < extra && delay < r.MaxDelay; i++ {
+		delay *= 2
+	}
+	if r.MaxDelay > 0 && delay > r.MaxDelay {
+		delay = r.MaxDelay
+	}
+	return delay
+}
+
+// LoginThrottleService protege o login com senha e o segundo fator contra
+// força bruta, contando as falhas por conta e por IP. A conta é identificada
+// pelo email informado, mesmo que não exista, para que o bloqueio não revele
+// quais emails estão cadastrados. Os bloqueios e os desbloqueios feitos por
+// administradores vão para o registro de auditoria.
+type LoginThrottleService struct {
+	logger      *slog.Logger
+	userRepo    repositories.UserRepository
+	attemptRepo repositories.LoginAttemptRepository
+	auditRepo   repositories.AuditRepository
+	accountRule ThrottleRule
+	ipRule      ThrottleRule
+	now         func() time.Time
+}
+
+func NewLoginThrottleService(
+	logger *slog.Logger,
+	userRepo repositories.UserRepository,
+	attemptRepo repositories.LoginAttemptRepository,
+	auditRepo repositories.AuditRepository,
+	accountRule ThrottleRule,
+	ipRule ThrottleRule,
+) *LoginThrottleService {
+	return &LoginThrottleService{
+		logger:      logger,
+		userRepo:    userRepo,
+		attemptRepo: attemptRepo,
+		auditRepo:   auditRepo,
+		accountRule: accountRule,
+		ipRule:      ipRule,
+		now:         time.Now,
+	}
+}
+
+// Check recusa o login enquanto a conta ou o IP estiverem bloqueados, mesmo
+// que a senha esteja certa.
+func (s *LoginThrottleService) Check(ctx context.Context, email, ip string) error {
+	now := s.now()
+	var retryAfter time.Duration
+
+	for _, target := range s.targets(email, ip) {
+		attempts, err := s.attemptRepo.Find(ctx, target.scope, target.key)
+		if err != nil {
+			return err
+		}
+		if attempts == nil || !attempts.IsLocked(now) {
+			continue
+		}
+		if remaining := attempts.LockedUntil().Sub(now); remaining > retryAfter {
+			retryAfter = remaining
+		}
+	}
+
+	if retryAfter > 0 {
+		return &LoginLockedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// RecordFailure conta uma senha ou um código de verificação errado para a
+// conta e para o IP e aplica a espera correspondente.
+func (s *LoginThrottleService) RecordFailure(ctx context.Context, email, ip string) error {
+	now := s.now()
+
+	for _, target := range s.targets(email, ip) {
+		failures, err := s.attemptRepo.RecordFailure(ctx, target.scope, target.key, now, now.Add(-target.rule.Window))
+		if err != nil {
+			s.logger.ErrorContext(
+				ctx,
+				"Erro ao registrar falha de login",
+				"error", err.Error(),
+				"scope", target.scope,
+				"operation", "login_throttle_service.record_failure",
+			)
+			return err
+		}
+
+		delay := target.rule.delay(failures)
+		if delay == 0 {
+			continue
+		}
+		if err := s.attemptRepo.Lock(ctx, target.scope, target.key, now.Add(delay)); err != nil {
+			return err
+		}
+
+		if target.rule.MaxFailures > 0 && failures >= target.rule.MaxFailures {
+			s.logger.WarnContext(
+				ctx,
+				"Login bloqueado por excesso de falhas",
+				"scope", target.scope,
+				"key", target.key,
+				"failures", failures,
+				"locked_until", now.Add(delay),
+				"operation", "login_throttle_service.lock",
+			)
+			if err := recordAudit(ctx, s.logger, s.auditRepo, nil, lockAction(target.scope), target.key, ip); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// RecordSuccess zera as falhas da conta; com a verificação em duas etapas,
+// só depois do código. As do IP continuam contando, senão quem tem uma conta
+// válida poderia usá-la para liberar o IP entre tentativas contra outras
+// contas.
+func (s *LoginThrottleService) RecordSuccess(ctx context.Context, email string) error {
+	return s.attemptRepo.Reset(ctx, entities.LoginScopeAccount, normalizeLoginEmail(email))
+}
+
+// UnlockAccount libera a conta antes do fim do bloqueio.
+func (s *LoginThrottleService) UnlockAccount(ctx context.Context, actorID, userID int, ip string) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return repositories.ErrUserNotFound
+	}
+
+	key := normalizeLoginEmail(user.Email())
+	if err := s.attemptRepo.Reset(ctx, entities.LoginScopeAccount, key); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao desbloquear conta",
+			"error", err.Error(),
+			"user_id", userID,
+			"operation", "login_throttle_service.unlock_account",
+		)
+		return err
+	}
+
+	return recordAudit(ctx, s.logger, s.auditRepo, &actorID, entities.AuditAccountUnlocked, key, ip)
+}
+
+// UnlockIP libera um IP antes do fim do bloqueio.
+func (s *LoginThrottleService) UnlockIP(ctx context.Context, actorID int, address, ip string) error {
+	if err := s.attemptRepo.Reset(ctx, entities.LoginScopeIP, address); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao desbloquear IP",
+			"error", err.Error(),
+			"operation", "login_throttle_service.unlock_ip",
+		)
+		return err
+	}
+
+	return recordAudit(ctx, s.logger, s.auditRepo, &actorID, entities.AuditIPUnlocked, address, ip)
+}
+
+type throttleTarget struct {
+	scope string
+	key   string
+	rule  ThrottleRule
+}
+
+func (s *LoginThrottleService) targets(email, ip string) []throttleTarget {
+	targets := []throttleTarget{{entities.LoginScopeAccount, normalizeLoginEmail(email), s.accountRule}}
+	if ip != "" {
+		targets = append(targets, throttleTarget{entities.LoginScopeIP, ip, s.ipRule})
+	}
+	return targets
+}
+
+func lockAction(scope string) string {
+	if scope == entities.LoginScopeIP {
+		return entities.AuditIPLocked
+	}
+	return entities.AuditAccountLocked
+}
+
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
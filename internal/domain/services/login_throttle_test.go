@@ -0,0 +1,164 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/valueobject"
+)
+
+// memoryLoginAttempts reproduz em memória o contrato do repositório, sem
+// separar tenants.
+func memoryLoginAttempts() *mocks.MockLoginAttemptRepository {
+	type record struct {
+		failures      int
+		lastFailureAt time.Time
+		lockedUntil   *time.Time
+	}
+	records := map[string]*record{}
+	repo := mocks.NewMockLoginAttemptRepository()
+
+	repo.FindFunc = func(ctx context.Context, scope, key string) (*entities.LoginAttempts, error) {
+		r, ok := records[scope+"|"+key]
+		if !ok {
+			return nil, nil
+		}
+		return entities.RebuildLoginAttempts(scope, key, r.failures, r.lastFailureAt, r.lockedUntil), nil
+	}
+	repo.RecordFailureFunc = func(ctx context.Context, scope, key string, at, since time.Time) (int, error) {
+		r, ok := records[scope+"|"+key]
+		if !ok {
+			r = &record{}
+			records[scope+"|"+key] = r
+		}
+		if r.lastFailureAt.Before(since) {
+			r.failures = 0
+		}
+		r.failures++
+		r.lastFailureAt = at
+		return r.failures, nil
+	}
+	repo.LockFunc = func(ctx context.Context, scope, key string, until time.Time) error {
+		records[scope+"|"+key].lockedUntil = &until
+		return nil
+	}
+	repo.ResetFunc = func(ctx context.Context, scope, key string) error {
+		delete(records, scope+"|"+key)
+		return nil
+	}
+
+	return repo
+}
+
+func TestThrottleRule_Delay(t *testing.T) {
+	rule := ThrottleRule{FreeAttempts: 2, BaseDelay: time.Second, MaxDelay: 4 * time.Second, MaxFailures: 8, LockoutDuration: 15 * time.Minute}
+
+	want := []time.Duration{0, 0, time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second, 4 * time.Second, 15 * time.Minute, 15 * time.Minute}
+	for i, expected := range want {
+		if got := rule.delay(i + 1); got != expected {
+			t.Errorf("falha %d: espera esperada %v, obtida %v", i+1, expected, got)
+		}
+	}
+}
+
+func TestLoginThrottleService(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	accountRule := ThrottleRule{FreeAttempts: 2, BaseDelay: time.Second, MaxDelay: 4 * time.Second, MaxFailures: 4, LockoutDuration: 15 * time.Minute, Window: time.Hour}
+	ipRule := ThrottleRule{FreeAttempts: 5, BaseDelay: time.Second, MaxDelay: time.Second, MaxFailures: 6, LockoutDuration: time.Hour, Window: time.Hour}
+
+	newService := func() (*LoginThrottleService, *[]*entities.AuditEntry) {
+		var entries []*entities.AuditEntry
+		audit := mocks.NewMockAuditRepository()
+		audit.RecordFunc = func(ctx context.Context, entry *entities.AuditEntry) error {
+			entries = append(entries, entry)
+			return nil
+		}
+		users := mocks.NewMockUserRepository()
+		users.FindByIDFunc = func(ctx context.Context, id int) (*entities.User, error) {
+			email, _ := valueobject.NewEmail("ana@gmail.com")
+			return entities.RebuildUser(id, "Ana", email, entities.RoleClient), nil
+		}
+
+		service := NewLoginThrottleService(discardLogger(), users, memoryLoginAttempts(), audit, accountRule, ipRule)
+		service.now = func() time.Time { return now }
+		return service, &entries
+	}
+
+	t.Run("espera progressiva e bloqueio da conta", func(t *testing.T) {
+		service, entries := newService()
+
+		for i := 0; i < 2; i++ {
+			_ = service.RecordFailure(ctx, "Ana@gmail.com", "10.0.0.1")
+		}
+		if err := service.Check(ctx, "ana@gmail.com", "10.0.0.1"); err != nil {
+			t.Fatalf("as primeiras falhas não deveriam impor espera: %v", err)
+		}
+
+		_ = service.RecordFailure(ctx, "ana@gmail.com", "10.0.0.1")
+		var locked *LoginLockedError
+		err := service.Check(ctx, "ana@gmail.com", "10.0.0.2")
+		if !errors.As(err, &locked) || locked.RetryAfter != time.Second {
+			t.Fatalf("esperada espera de 1s, obtido %v", err)
+		}
+		if !errors.Is(err, ErrTooManyLoginAttempts) {
+			t.Error("LoginLockedError deveria envolver ErrTooManyLoginAttempts")
+		}
+
+		now = now.Add(time.Second)
+		if err := service.Check(ctx, "ana@gmail.com", "10.0.0.1"); err != nil {
+			t.Fatalf("a espera já deveria ter passado: %v", err)
+		}
+
+		_ = service.RecordFailure(ctx, "ana@gmail.com", "10.0.0.1")
+		err = service.Check(ctx, "ana@gmail.com", "10.0.0.1")
+		if !errors.As(err, &locked) || locked.RetryAfter != 15*time.Minute {
+			t.Fatalf("esperado bloqueio de 15min, obtido %v", err)
+		}
+		if len(*entries) != 1 || (*entries)[0].Action() != entities.AuditAccountLocked || (*entries)[0].ActorID() != nil {
+			t.Fatalf("bloqueio deveria ser auditado como ação do sistema, obtido %+v", *entries)
+		}
+
+		if err := service.UnlockAccount(ctx, 1, 7, "10.0.0.9"); err != nil {
+			t.Fatalf("erro inesperado ao desbloquear: %v", err)
+		}
+		if err := service.Check(ctx, "ana@gmail.com", "10.0.0.1"); err != nil {
+			t.Errorf("conta deveria ter sido desbloqueada: %v", err)
+		}
+		last := (*entries)[len(*entries)-1]
+		if last.Action() != entities.AuditAccountUnlocked || last.ActorID() == nil || *last.ActorID() != 1 || last.Subject() != "ana@gmail.com" {
+			t.Errorf("desbloqueio deveria ser auditado com o administrador, obtido %+v", last)
+		}
+	})
+
+	t.Run("login certo zera só a conta", func(t *testing.T) {
+		service, _ := newService()
+
+		for i := 0; i < 5; i++ {
+			_ = service.RecordFailure(ctx, "ana@gmail.com", "10.0.0.1")
+			now = now.Add(time.Minute)
+		}
+		_ = service.RecordSuccess(ctx, "ana@gmail.com")
+
+		_ = service.RecordFailure(ctx, "bia@gmail.com", "10.0.0.1")
+		var locked *LoginLockedError
+		if err := service.Check(ctx, "bia@gmail.com", "10.0.0.1"); !errors.As(err, &locked) || locked.RetryAfter != time.Hour {
+			t.Fatalf("IP deveria seguir contando as falhas e ser bloqueado, obtido %v", err)
+		}
+		if err := service.Check(ctx, "ana@gmail.com", ""); err != nil {
+			t.Errorf("conta deveria estar liberada após o login certo: %v", err)
+		}
+
+		if err := service.UnlockIP(ctx, 1, "10.0.0.1", "10.0.0.9"); err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if err := service.Check(ctx, "bia@gmail.com", "10.0.0.1"); err != nil {
+			t.Errorf("IP deveria ter sido desbloqueado: %v", err)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+)
+
+var ErrRequirementExceedsCapacity = errs.Conflict("resource.requirement_exceeds_capacity")
+
+type ResourceService struct {
+	logger       *slog.Logger
+	resourceRepo repositories.ResourceRepository
+}
+
+func NewResourceService(logger *slog.Logger, resourceRepo repositories.ResourceRepository) *ResourceService {
+	return &ResourceService{
+		logger:       logger,
+		resourceRepo: resourceRepo,
+	}
+}
+
+func (s *ResourceService) Create(ctx context.Context, resource *entities.Resource) error {
+	startTime := time.Now()
+
+	if err := s.resourceRepo.Save(ctx, resource); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao tentar criar o recurso",
+			"error", err.Error(),
+			"operation", "resource_service.create_resource",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// Require associa o recurso ao serviço. Uma quantidade maior que a capacidade
+// nunca poderia ser atendida, então é rejeitada já no cadastro.
+func (s *ResourceService) Require(ctx context.Context, requirement *entities.ResourceRequirement) error {
+	startTime := time.Now()
+
+	resource, err := s.resourceRepo.FindByID(ctx, requirement.ResourceID())
+	if err != nil {
+		return err
+	}
+	if requirement.Quantity() > resource.Capacity() {
+		return ErrRequirementExceedsCapacity
+	}
+
+	if err := s.resourceRepo.SaveRequirement(ctx, requirement); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao tentar associar recurso ao serviço",
+			"error", err.Error(),
+			"service_id", requirement.ServiceID(),
+			"resource_id", requirement.ResourceID(),
+			"operation", "resource_service.save_requirement",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/token"
+)
+
+const DefaultRefreshTTL = 30 * 24 * time.Hour
+
+var ErrInvalidRefreshToken = errs.Unauthorized("auth.invalid_refresh_token")
+
+// Session é o par de tokens entregue no login e em cada renovação.
+type Session struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// SessionService emite tokens de acesso de vida curta e refresh tokens
+// rotativos. Cada refresh token vale uma única renovação; reapresentar um
+// token já usado revoga todos os tokens da mesma sessão. A política de contas
+// não verificadas é aplicada tanto no login quanto em cada renovação.
+//
+// Start não confere o segundo fator: quem chama deve passar pelo
+// TwoFactorService antes. Por isso o claim MFA reflete apenas se o usuário tem
+// um autenticador ativo.
+type SessionService struct {
+	logger        *slog.Logger
+	userRepo      repositories.UserRepository
+	refreshRepo   repositories.RefreshTokenRepository
+	twoFactorRepo repositories.TwoFactorRepository
+	issuer        token.Issuer
+	refreshTTL    time.Duration
+	policy        auth.UnverifiedPolicy
+	now           func() time.Time
+}
+
+func NewSessionService(
+	logger *slog.Logger,
+	userRepo repositories.UserRepository,
+	refreshRepo repositories.RefreshTokenRepository,
+	twoFactorRepo repositories.TwoFactorRepository,
+	issuer token.Issuer,
+	refreshTTL time.Duration,
+	policy auth.UnverifiedPolicy,
+) *SessionService {
+	return &SessionService{
+		logger:        logger,
+		userRepo:      userRepo,
+		refreshRepo:   refreshRepo,
+		twoFactorRepo: twoFactorRepo,
+		issuer:        issuer,
+		refreshTTL:    refreshTTL,
+		policy:        policy,
+		now:           time.Now,
+	}
+}
+
+// Start abre uma nova sessão para um usuário já autenticado.
+func (s *SessionService) Start(ctx context.Context, user *entities.User) (*Session, error) {
+	return s.issue(ctx, user, uuid.NewString())
+}
+
+// Refresh troca um refresh token válido por um novo par de tokens.
+func (s *SessionService) Refresh(ctx context.Context, refreshToken string) (*Session, error) {
+	current, err := s.refreshRepo.FindByHash(ctx, hashToken(refreshToken))
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar refresh token",
+			"error", err.Error(),
+			"operation", "session_service.find_refresh_token",
+		)
+		return nil, err
+	}
+	if current == nil || current.IsExpired(s.now()) {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if current.IsRevoked() {
+		s.revokeReused(ctx, current)
+		return nil, ErrInvalidRefreshToken
+	}
+
+	revoked, err := s.refreshRepo.Revoke(ctx, current.ID())
+	if err != nil {
+		return nil, err
+	}
+	if !revoked {
+		// outra requisição usou o mesmo token entre a busca e a revogação
+		s.revokeReused(ctx, current)
+		return nil, ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepo.FindByID(ctx, current.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	return s.issue(ctx, user, current.FamilyID())
+}
+
+// Revoke encerra a sessão do refresh token informado (logout). Tokens
+// desconhecidos são ignorados para que o logout seja idempotente.
+func (s *SessionService) Revoke(ctx context.Context, refreshToken string) error {
+	current, err := s.refreshRepo.FindByHash(ctx, hashToken(refreshToken))
+	if err != nil || current == nil {
+		return err
+	}
+
+	return s.refreshRepo.RevokeFamily(ctx, current.FamilyID())
+}
+
+func (s *SessionService) issue(ctx context.Context, user *entities.User, familyID string) (*Session, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.policy.AllowsLogin(user.IsEmailVerified()) {
+		return nil, auth.ErrEmailNotVerified
+	}
+
+	credential, err := s.twoFactorRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, accessExpiresAt, err := s.issuer.CreateToken(token.Claims{
+		UserID:        user.ID(),
+		Role:          user.Role(),
+		TenantID:      tenantID,
+		EmailVerified: user.IsEmailVerified(),
+		MFA:           credential != nil && credential.IsEnabled(),
+		Locale:        string(user.Locale()),
+	})
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao criar o token de acesso",
+			"error", err.Error(),
+			"user_id", user.ID(),
+			"operation", "session_service.create_access_token",
+		)
+		return nil, err
+	}
+
+	refreshToken, err := newOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	refreshExpiresAt := s.now().Add(s.refreshTTL)
+	stored, err := entities.NewRefreshToken(user.ID(), hashToken(refreshToken), familyID, refreshExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.refreshRepo.Create(ctx, stored); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao salvar refresh token",
+			"error", err.Error(),
+			"user_id", user.ID(),
+			"operation", "session_service.create_refresh_token",
+		)
+		return nil, err
+	}
+
+	return &Session{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+func (s *SessionService) revokeReused(ctx context.Context, current *entities.RefreshToken) {
+	s.logger.WarnContext(
+		ctx,
+		"Refresh token reutilizado; revogando a sessão",
+		"user_id", current.UserID(),
+		"family_id", current.FamilyID(),
+		"operation", "session_service.refresh_token_reuse",
+	)
+
+	if err := s.refreshRepo.RevokeFamily(ctx, current.FamilyID()); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao revogar sessão",
+			"error", err.Error(),
+			"family_id", current.FamilyID(),
+			"operation", "session_service.revoke_family",
+		)
+	}
+}
+
+// newOpaqueToken gera os tokens entregues ao cliente: refresh tokens e os
+// links de redefinição de senha e verificação de email.
+func newOpaqueToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashToken usa SHA-256 sem salt: o token já tem 256 bits aleatórios,
+// e o hash determinístico permite buscá-lo por índice.
+func hashToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/token"
+	"scheduling/internal/domain/valueobject"
+)
+
+type fakeIssuer struct {
+	issued []token.Claims
+}
+
+func (f *fakeIssuer) CreateToken(claims token.Claims) (string, time.Time, error) {
+	f.issued = append(f.issued, claims)
+	return "access-token", time.Now().Add(time.Minute), nil
+}
+
+func (f *fakeIssuer) VerifyToken(tokenString string) (*token.Claims, error) {
+	return nil, token.ErrInvalidToken
+}
+
+// memoryRefreshTokens guarda os tokens em memória para exercitar a rotação.
+func memoryRefreshTokens() (*mocks.MockRefreshTokenRepository, map[string]*entities.RefreshToken) {
+	stored := map[string]*entities.RefreshToken{}
+	repo := mocks.NewMockRefreshTokenRepository()
+
+	repo.CreateFunc = func(ctx context.Context, t *entities.RefreshToken) error {
+		t.SetID(len(stored) + 1)
+		stored[t.TokenHash()] = t
+		return nil
+	}
+	repo.FindByHashFunc = func(ctx context.Context, hash string) (*entities.RefreshToken, error) {
+		return stored[hash], nil
+	}
+	revoke := func(t *entities.RefreshToken) {
+		now := time.Now()
+		stored[t.TokenHash()] = entities.RebuildRefreshToken(t.ID(), t.UserID(), t.TokenHash(), t.FamilyID(), t.ExpiresAt(), &now, t.CreatedAt())
+	}
+	repo.RevokeFunc = func(ctx context.Context, id int) (bool, error) {
+		for _, t := range stored {
+			if t.ID() == id && !t.IsRevoked() {
+				revoke(t)
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	repo.RevokeFamilyFunc = func(ctx context.Context, familyID string) error {
+		for _, t := range stored {
+			if t.FamilyID() == familyID && !t.IsRevoked() {
+				revoke(t)
+			}
+		}
+		return nil
+	}
+
+	return repo, stored
+}
+
+func newTestSessionService(issuer token.Issuer, refreshRepo *mocks.MockRefreshTokenRepository) *SessionService {
+	users := mocks.NewMockUserRepository()
+	users.FindByIDFunc = func(ctx context.Context, id int) (*entities.User, error) {
+		email, _ := valueobject.NewEmail("maria@gmail.com")
+		return entities.RebuildUser(id, "Maria", email, entities.RoleAdmin), nil
+	}
+
+	return NewSessionService(discardLogger(), users, refreshRepo, mocks.NewMockTwoFactorRepository(), issuer, time.Hour, auth.UnverifiedAllow)
+}
+
+func TestSessionService_StartAndRefresh(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+	issuer := &fakeIssuer{}
+	refreshRepo, stored := memoryRefreshTokens()
+	service := newTestSessionService(issuer, refreshRepo)
+
+	email, _ := valueobject.NewEmail("maria@gmail.com")
+	session, err := service.Start(ctx, entities.RebuildUser(7, "Maria", email, entities.RoleAdmin))
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	want := token.Claims{UserID: 7, Role: entities.RoleAdmin, TenantID: 4}
+	if issuer.issued[0] != want {
+		t.Errorf("claims esperados %+v, obtidos %+v", want, issuer.issued[0])
+	}
+	if _, ok := stored[session.RefreshToken]; ok {
+		t.Error("o refresh token não pode ser guardado em texto puro")
+	}
+
+	renewed, err := service.Refresh(ctx, session.RefreshToken)
+	if err != nil {
+		t.Fatalf("erro inesperado ao renovar: %v", err)
+	}
+	if renewed.RefreshToken == session.RefreshToken {
+		t.Error("a renovação deveria emitir um novo refresh token")
+	}
+
+	// reapresentar o token já usado revoga a sessão inteira
+	if _, err := service.Refresh(ctx, session.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("reuso deveria retornar ErrInvalidRefreshToken, obtido %v", err)
+	}
+	if _, err := service.Refresh(ctx, renewed.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("token da sessão revogada deveria ser recusado, obtido %v", err)
+	}
+}
+
+func TestSessionService_Refresh_Invalid(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+
+	t.Run("token desconhecido", func(t *testing.T) {
+		refreshRepo, _ := memoryRefreshTokens()
+		service := newTestSessionService(&fakeIssuer{}, refreshRepo)
+
+		if _, err := service.Refresh(ctx, "desconhecido"); !errors.Is(err, ErrInvalidRefreshToken) {
+			t.Errorf("esperado ErrInvalidRefreshToken, obtido %v", err)
+		}
+	})
+
+	t.Run("token expirado", func(t *testing.T) {
+		refreshRepo, _ := memoryRefreshTokens()
+		service := newTestSessionService(&fakeIssuer{}, refreshRepo)
+
+		email, _ := valueobject.NewEmail("maria@gmail.com")
+		session, _ := service.Start(ctx, entities.RebuildUser(7, "Maria", email, entities.RoleClient))
+
+		service.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+		if _, err := service.Refresh(ctx, session.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+			t.Errorf("esperado ErrInvalidRefreshToken, obtido %v", err)
+		}
+	})
+}
+
+func TestSessionService_Revoke(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+	refreshRepo, _ := memoryRefreshTokens()
+	service := newTestSessionService(&fakeIssuer{}, refreshRepo)
+
+	email, _ := valueobject.NewEmail("maria@gmail.com")
+	session, _ := service.Start(ctx, entities.RebuildUser(7, "Maria", email, entities.RoleClient))
+
+	if err := service.Revoke(ctx, session.RefreshToken); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if _, err := service.Refresh(ctx, session.RefreshToken); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("token revogado no logout deveria ser recusado, obtido %v", err)
+	}
+
+	if err := service.Revoke(ctx, "desconhecido"); err != nil {
+		t.Errorf("logout com token desconhecido não deveria falhar: %v", err)
+	}
+}
+
+func TestSessionService_UnverifiedPolicy(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+	email, _ := valueobject.NewEmail("maria@gmail.com")
+
+	unverified := entities.RebuildUser(7, "Maria", email, entities.RoleClient)
+	verified := entities.RebuildUser(7, "Maria", email, entities.RoleClient)
+	verified.MarkEmailVerified(time.Now())
+
+	tests := []struct {
+		name    string
+		policy  auth.UnverifiedPolicy
+		user    *entities.User
+		wantErr error
+	}{
+		{name: "verificação opcional", policy: auth.UnverifiedAllow, user: unverified},
+		{name: "política restritiva ainda permite o login", policy: auth.UnverifiedRestrict, user: unverified},
+		{name: "política de bloqueio recusa o login", policy: auth.UnverifiedBlock, user: unverified, wantErr: auth.ErrEmailNotVerified},
+		{name: "política de bloqueio com email verificado", policy: auth.UnverifiedBlock, user: verified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer := &fakeIssuer{}
+			refreshRepo, _ := memoryRefreshTokens()
+			service := NewSessionService(discardLogger(), mocks.NewMockUserRepository(), refreshRepo, mocks.NewMockTwoFactorRepository(), issuer, time.Hour, tt.policy)
+
+			_, err := service.Start(ctx, tt.user)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("erro esperado %v, obtido %v", tt.wantErr, err)
+			}
+			if err == nil && issuer.issued[0].EmailVerified != tt.user.IsEmailVerified() {
+				t.Errorf("claim email_verified esperado %v, obtido %v", tt.user.IsEmailVerified(), issuer.issued[0].EmailVerified)
+			}
+		})
+	}
+}
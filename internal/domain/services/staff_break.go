@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/valueobject"
+)
+
+var (
+	ErrBreakOutsideWorkingHours = errs.Conflict("staff_break.outside_working_hours")
+	ErrBreakOverlap             = errs.Conflict("staff_break.overlap")
+)
+
+type StaffBreakService struct {
+	logger    *slog.Logger
+	slotRepo  repositories.AvailableSlotRepository
+	breakRepo repositories.StaffBreakRepository
+}
+
+func NewStaffBreakService(
+	logger *slog.Logger,
+	slotRepo repositories.AvailableSlotRepository,
+	breakRepo repositories.StaffBreakRepository,
+) *StaffBreakService {
+	return &StaffBreakService{
+		logger:    logger,
+		slotRepo:  slotRepo,
+		breakRepo: breakRepo,
+	}
+}
+
+func (s *StaffBreakService) Create(ctx context.Context, staffBreak *entities.StaffBreak) error {
+	startTime := time.Now()
+
+	window, err := clockRange(staffBreak.StartTime(), staffBreak.EndTime())
+	if err != nil {
+		return err
+	}
+
+	slots, err := s.slotRepo.FindAllByStaffID(ctx, staffBreak.StaffID())
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar horários de trabalho do profissional",
+			"error", err.Error(),
+			"staff_id", staffBreak.StaffID(),
+			"operation", "staff_break_service.find_slots",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	inside := false
+	for _, slot := range slots {
+		if slot.Weekday() != staffBreak.Weekday() {
+			continue
+		}
+		working, err := clockRange(slot.StartTime(), slot.EndTime())
+		if err != nil {
+			continue
+		}
+		if working.Contains(window) {
+			inside = true
+			break
+		}
+	}
+	if !inside {
+		return ErrBreakOutsideWorkingHours
+	}
+
+	existing, err := s.breakRepo.FindByWeekday(ctx, staffBreak.StaffID(), staffBreak.Weekday())
+	if err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao buscar pausas do profissional",
+			"error", err.Error(),
+			"staff_id", staffBreak.StaffID(),
+			"operation", "staff_break_service.find_breaks",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	for _, other := range existing {
+		otherWindow, err := clockRange(other.StartTime(), other.EndTime())
+		if err != nil {
+			continue
+		}
+		if otherWindow.Overlaps(window) {
+			return ErrBreakOverlap
+		}
+	}
+
+	if err := s.breakRepo.Save(ctx, staffBreak); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao tentar criar a pausa",
+			"error", err.Error(),
+			"staff_id", staffBreak.StaffID(),
+			"operation", "staff_break_service.create_break",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	return nil
+}
+
+// clockRange compara apenas os horários, ignorando a data gravada junto ao TIME.
+func clockRange(start, end time.Time) (valueobject.TimeRange, error) {
+	ref := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	return valueobject.NewTimeRange(atDate(ref, start), atDate(ref, end))
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories/mocks"
+)
+
+func TestStaffBreakService_Create(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    [2]int
+		end      [2]int
+		weekday  entities.Weekday
+		existing [][2][2]int
+		wantErr  error
+		wantSave bool
+	}{
+		{
+			name:     "pausa dentro do expediente",
+			start:    [2]int{12, 0},
+			end:      [2]int{13, 0},
+			weekday:  entities.Monday,
+			wantSave: true,
+		},
+		{
+			name:    "pausa fora do expediente",
+			start:   [2]int{18, 0},
+			end:     [2]int{19, 0},
+			weekday: entities.Monday,
+			wantErr: ErrBreakOutsideWorkingHours,
+		},
+		{
+			name:    "pausa em dia sem expediente",
+			start:   [2]int{12, 0},
+			end:     [2]int{13, 0},
+			weekday: entities.Sunday,
+			wantErr: ErrBreakOutsideWorkingHours,
+		},
+		{
+			name:     "pausa sobreposta a outra",
+			start:    [2]int{12, 0},
+			end:      [2]int{13, 0},
+			weekday:  entities.Monday,
+			existing: [][2][2]int{{{12, 30}, {14, 0}}},
+			wantErr:  ErrBreakOverlap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slotRepo := mocks.NewMockAvailableSlotRepository()
+			slotRepo.FindAllByStaffIDFunc = func(ctx context.Context, staffID int) ([]*entities.AvailableSlot, error) {
+				slot, _ := entities.NewAvailableSlot(staffID, entities.Monday, clock(9, 0), clock(18, 0))
+				return []*entities.AvailableSlot{slot}, nil
+			}
+
+			saved := false
+			breakRepo := mocks.NewMockStaffBreakRepository()
+			breakRepo.FindByWeekdayFunc = func(ctx context.Context, staffID int, weekday entities.Weekday) ([]*entities.StaffBreak, error) {
+				var breaks []*entities.StaffBreak
+				for _, e := range tt.existing {
+					b, _ := entities.NewStaffBreak(staffID, weekday, clock(e[0][0], e[0][1]), clock(e[1][0], e[1][1]), 0)
+					breaks = append(breaks, b)
+				}
+				return breaks, nil
+			}
+			breakRepo.SaveFunc = func(ctx context.Context, staffBreak *entities.StaffBreak) error {
+				saved = true
+				return nil
+			}
+
+			staffBreak, err := entities.NewStaffBreak(1, tt.weekday, clock(tt.start[0], tt.start[1]), clock(tt.end[0], tt.end[1]), 0)
+			if err != nil {
+				t.Fatalf("falha ao criar pausa: %v", err)
+			}
+
+			service := NewStaffBreakService(discardLogger(), slotRepo, breakRepo)
+			err = service.Create(context.Background(), staffBreak)
+
+			if err != tt.wantErr {
+				t.Errorf("erro esperado %v, obtido %v", tt.wantErr, err)
+			}
+			if saved != tt.wantSave {
+				t.Errorf("Save chamado = %v, esperado %v", saved, tt.wantSave)
+			}
+		})
+	}
+}
@@ -0,0 +1,403 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/totp"
+)
+
+const (
+	MFAChallengeTTL   = 5 * time.Minute
+	recoveryCodeCount = 10
+)
+
+var (
+	ErrInvalidMFACode      = errs.Validation("mfa.invalid_code")
+	ErrMFAChallengeExpired = errs.Unauthorized("mfa.challenge_expired")
+	ErrTOTPAlreadyEnabled  = errs.Conflict("mfa.already_enabled")
+	ErrTOTPNotEnrolled     = errs.Validation("mfa.not_enrolled")
+)
+
+// TOTPEnrollment é o que o usuário precisa para cadastrar o autenticador: o
+// segredo para digitação manual e a URI exibida como QR code.
+type TOTPEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// TOTPActivation é o resultado da confirmação do cadastro. Os códigos de
+// recuperação são exibidos uma única vez.
+type TOTPActivation struct {
+	User          *entities.User
+	RecoveryCodes []string
+}
+
+// TwoFactorService cuida da verificação em duas etapas por TOTP (RFC 6238).
+// Quando o usuário tem um autenticador ativo, o login com senha ou por
+// provedor externo não abre a sessão: devolve um desafio de uso único que
+// precisa ser respondido com um código do autenticador ou de recuperação.
+type TwoFactorService struct {
+	logger        *slog.Logger
+	userRepo      repositories.UserRepository
+	twoFactorRepo repositories.TwoFactorRepository
+	tokenRepo     repositories.UserTokenRepository
+	refreshRepo   repositories.RefreshTokenRepository
+	policy        auth.MFAPolicy
+	issuer        string
+	now           func() time.Time
+}
+
+func NewTwoFactorService(
+	logger *slog.Logger,
+	userRepo repositories.UserRepository,
+	twoFactorRepo repositories.TwoFactorRepository,
+	tokenRepo repositories.UserTokenRepository,
+	refreshRepo repositories.RefreshTokenRepository,
+	policy auth.MFAPolicy,
+	issuer string,
+) *TwoFactorService {
+	return &TwoFactorService{
+		logger:        logger,
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		tokenRepo:     tokenRepo,
+		refreshRepo:   refreshRepo,
+		policy:        policy,
+		issuer:        issuer,
+		now:           time.Now,
+	}
+}
+
+// Challenge retorna o token do desafio quando o usuário precisa da segunda
+// etapa, ou vazio quando a sessão pode ser aberta direto.
+func (s *TwoFactorService) Challenge(ctx context.Context, user *entities.User) (string, error) {
+	credential, err := s.twoFactorRepo.FindByUserID(ctx, user.ID())
+	if err != nil {
+		return "", err
+	}
+	if credential == nil || !credential.IsEnabled() {
+		return "", nil
+	}
+
+	plainToken, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	challenge, err := entities.NewUserToken(user.ID(), entities.TokenPurposeMFAChallenge, hashToken(plainToken), s.now().Add(MFAChallengeTTL))
+	if err != nil {
+		return "", err
+	}
+	if err := s.tokenRepo.Create(ctx, challenge); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao criar desafio de verificação em duas etapas",
+			"error", err.Error(),
+			"user_id", user.ID(),
+			"operation", "two_factor_service.challenge",
+		)
+		return "", err
+	}
+
+	return plainToken, nil
+}
+
+// Verify responde o desafio com um código do autenticador ou, na falta dele,
+// com um código de recuperação. O desafio vale uma única tentativa: com um
+// código errado é preciso entrar de novo com a senha, o que impede testar
+// todos os códigos com o mesmo desafio.
+func (s *TwoFactorService) Verify(ctx context.Context, challengeToken, code, recoveryCode string) (*entities.User, error) {
+	user, err := s.OpenChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.VerifyCode(ctx, user.ID(), code, recoveryCode); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// OpenChallenge consome o desafio e devolve o usuário a quem ele pertence,
+// ainda sem conferir o código. Com as duas etapas separadas, o login pode
+// contar o código errado como falha da conta (ver VerifyCode).
+func (s *TwoFactorService) OpenChallenge(ctx context.Context, challengeToken string) (*entities.User, error) {
+	challenge, err := consumeUserToken(ctx, s.tokenRepo, entities.TokenPurposeMFAChallenge, challengeToken, s.now())
+	if errors.Is(err, ErrInvalidUserToken) {
+		return nil, ErrMFAChallengeExpired
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, challenge.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, repositories.ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+// VerifyCode confere o código do autenticador ou, na falta dele, o código de
+// recuperação de userID. Devolve ErrInvalidMFACode para códigos recusados.
+func (s *TwoFactorService) VerifyCode(ctx context.Context, userID int, code, recoveryCode string) error {
+	credential, err := s.enabledCredential(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if recoveryCode != "" {
+		err = s.useRecoveryCode(ctx, userID, recoveryCode)
+	} else {
+		err = s.checkCode(ctx, credential, code)
+	}
+	if err != nil {
+		s.logger.WarnContext(
+			ctx,
+			"Código de verificação em duas etapas recusado",
+			"user_id", userID,
+			"recovery_code", recoveryCode != "",
+			"operation", "two_factor_service.verify",
+		)
+		return err
+	}
+
+	return nil
+}
+
+// BeginEnrollment gera um novo segredo para o usuário. O autenticador só
+// passa a valer depois de ConfirmEnrollment.
+func (s *TwoFactorService) BeginEnrollment(ctx context.Context, userID int) (*TOTPEnrollment, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, repositories.ErrUserNotFound
+	}
+
+	current, err := s.twoFactorRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && current.IsEnabled() {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := entities.NewTOTPCredential(userID, secret)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.twoFactorRepo.Save(ctx, credential); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao salvar o autenticador",
+			"error", err.Error(),
+			"user_id", userID,
+			"operation", "two_factor_service.begin_enrollment",
+		)
+		return nil, err
+	}
+
+	return &TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(s.issuer, user.Email(), secret),
+	}, nil
+}
+
+// ConfirmEnrollment ativa o autenticador com o primeiro código gerado por
+// ele, cria os códigos de recuperação e encerra as outras sessões do usuário,
+// que foram abertas sem a segunda etapa.
+func (s *TwoFactorService) ConfirmEnrollment(ctx context.Context, userID int, code string) (*TOTPActivation, error) {
+	credential, err := s.twoFactorRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if credential == nil {
+		return nil, ErrTOTPNotEnrolled
+	}
+	if credential.IsEnabled() {
+		return nil, ErrTOTPAlreadyEnabled
+	}
+
+	if err := s.checkCode(ctx, credential, code); err != nil {
+		return nil, err
+	}
+
+	credential.Enable(s.now())
+	if err := s.twoFactorRepo.Save(ctx, credential); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao ativar o autenticador",
+			"error", err.Error(),
+			"user_id", userID,
+			"operation", "two_factor_service.confirm_enrollment",
+		)
+		return nil, err
+	}
+
+	codes, err := s.replaceRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.refreshRepo.RevokeAllForUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, repositories.ErrUserNotFound
+	}
+
+	return &TOTPActivation{User: user, RecoveryCodes: codes}, nil
+}
+
+// Disable remove o autenticador e os códigos de recuperação. Papéis que a
+// política obriga a usar a verificação em duas etapas não podem desativá-la.
+func (s *TwoFactorService) Disable(ctx context.Context, principal auth.Principal, code string) error {
+	if s.policy.Requires(principal.Role) {
+		return auth.ErrMFARequired
+	}
+
+	credential, err := s.enabledCredential(ctx, principal.UserID)
+	if err != nil {
+		return err
+	}
+	if err := s.checkCode(ctx, credential, code); err != nil {
+		return err
+	}
+
+	if err := s.twoFactorRepo.Delete(ctx, principal.UserID); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao desativar o autenticador",
+			"error", err.Error(),
+			"user_id", principal.UserID,
+			"operation", "two_factor_service.disable",
+		)
+		return err
+	}
+
+	return nil
+}
+
+// RegenerateRecoveryCodes troca todos os códigos de recuperação, inclusive
+// os ainda não usados.
+func (s *TwoFactorService) RegenerateRecoveryCodes(ctx context.Context, userID int, code string) ([]string, error) {
+	credential, err := s.enabledCredential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCode(ctx, credential, code); err != nil {
+		return nil, err
+	}
+
+	return s.replaceRecoveryCodes(ctx, userID)
+}
+
+func (s *TwoFactorService) enabledCredential(ctx context.Context, userID int) (*entities.TOTPCredential, error) {
+	credential, err := s.twoFactorRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if credential == nil || !credential.IsEnabled() {
+		return nil, ErrTOTPNotEnrolled
+	}
+	return credential, nil
+}
+
+// checkCode aceita cada janela do autenticador uma única vez.
+func (s *TwoFactorService) checkCode(ctx context.Context, credential *entities.TOTPCredential, code string) error {
+	step, ok := totp.Verify(credential.Secret(), strings.TrimSpace(code), s.now())
+	if !ok || step <= credential.LastUsedStep() {
+		return ErrInvalidMFACode
+	}
+
+	marked, err := s.twoFactorRepo.MarkStepUsed(ctx, credential.UserID(), step)
+	if err != nil {
+		return err
+	}
+	if !marked {
+		return ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+func (s *TwoFactorService) useRecoveryCode(ctx context.Context, userID int, code string) error {
+	used, err := s.twoFactorRepo.UseRecoveryCode(ctx, userID, hashToken(normalizeRecoveryCode(code)))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+func (s *TwoFactorService) replaceRecoveryCodes(ctx context.Context, userID int) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+		hashes[i] = hashToken(normalizeRecoveryCode(code))
+	}
+
+	if err := s.twoFactorRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		s.logger.ErrorContext(
+			ctx,
+			"Erro ao gravar os códigos de recuperação",
+			"error", err.Error(),
+			"user_id", userID,
+			"operation", "two_factor_service.replace_recovery_codes",
+		)
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// newRecoveryCode gera códigos de 50 bits no formato xxxxx-xxxxx, fáceis de
+// copiar à mão.
+func newRecoveryCode() (string, error) {
+	buf := make([]byte, 7)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(recoveryEncoding.EncodeToString(buf))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.ReplaceAll(code, "-", "")
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/totp"
+	"scheduling/internal/domain/valueobject"
+)
+
+// memoryTwoFactor guarda a credencial e os códigos de recuperação de um único
+// usuário em memória.
+func memoryTwoFactor() *mocks.MockTwoFactorRepository {
+	var stored *entities.TOTPCredential
+	recovery := map[string]bool{}
+	repo := mocks.NewMockTwoFactorRepository()
+
+	repo.FindByUserIDFunc = func(ctx context.Context, userID int) (*entities.TOTPCredential, error) {
+		if stored == nil {
+			return nil, nil
+		}
+		c := *stored
+		return &c, nil
+	}
+	repo.SaveFunc = func(ctx context.Context, credential *entities.TOTPCredential) error {
+		step := int64(0)
+		if stored != nil {
+			step = stored.LastUsedStep()
+		}
+		stored = entities.RebuildTOTPCredential(credential.UserID(), credential.Secret(), credential.EnabledAt(), step, credential.CreatedAt())
+		return nil
+	}
+	repo.DeleteFunc = func(ctx context.Context, userID int) error {
+		stored = nil
+		recovery = map[string]bool{}
+		return nil
+	}
+	repo.MarkStepUsedFunc = func(ctx context.Context, userID int, step int64) (bool, error) {
+		if stored == nil || step <= stored.LastUsedStep() {
+			return false, nil
+		}
+		stored = entities.RebuildTOTPCredential(stored.UserID(), stored.Secret(), stored.EnabledAt(), step, stored.CreatedAt())
+		return true, nil
+	}
+	repo.ReplaceRecoveryCodesFunc = func(ctx context.Context, userID int, hashes []string) error {
+		recovery = map[string]bool{}
+		for _, hash := range hashes {
+			recovery[hash] = true
+		}
+		return nil
+	}
+	repo.UseRecoveryCodeFunc = func(ctx context.Context, userID int, hash string) (bool, error) {
+		if !recovery[hash] {
+			return false, nil
+		}
+		delete(recovery, hash)
+		return true, nil
+	}
+
+	return repo
+}
+
+func TestTwoFactorService_EnrollmentAndLogin(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	users := mocks.NewMockUserRepository()
+	users.FindByIDFunc = func(ctx context.Context, id int) (*entities.User, error) {
+		email, _ := valueobject.NewEmail("admin@gmail.com")
+		return entities.RebuildUser(id, "Admin", email, entities.RoleAdmin), nil
+	}
+	refreshRepo := mocks.NewMockRefreshTokenRepository()
+	revokedFor := 0
+	refreshRepo.RevokeAllForUserFunc = func(ctx context.Context, userID int) error {
+		revokedFor = userID
+		return nil
+	}
+
+	service := NewTwoFactorService(discardLogger(), users, memoryTwoFactor(), memoryUserTokens(), refreshRepo, auth.NewMFAPolicy(), "Agenda")
+	service.now = func() time.Time { return now }
+	user, _ := users.FindByID(ctx, 1)
+
+	enrollment, err := service.BeginEnrollment(ctx, 1)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if enrollment.ProvisioningURI != totp.ProvisioningURI("Agenda", "admin@gmail.com", enrollment.Secret) {
+		t.Errorf("URI de cadastro incorreta: %s", enrollment.ProvisioningURI)
+	}
+
+	// cadastro pendente ainda não exige a segunda etapa
+	if challenge, _ := service.Challenge(ctx, user); challenge != "" {
+		t.Fatal("autenticador não confirmado não deveria gerar desafio")
+	}
+
+	if _, err := service.ConfirmEnrollment(ctx, 1, "000000"); !errors.Is(err, ErrInvalidMFACode) {
+		t.Fatalf("código errado deveria ser recusado, obtido %v", err)
+	}
+
+	code, _ := totp.Code(enrollment.Secret, now)
+	activation, err := service.ConfirmEnrollment(ctx, 1, code)
+	if err != nil {
+		t.Fatalf("erro inesperado ao confirmar: %v", err)
+	}
+	if len(activation.RecoveryCodes) != recoveryCodeCount {
+		t.Errorf("esperados %d códigos de recuperação, obtidos %d", recoveryCodeCount, len(activation.RecoveryCodes))
+	}
+	if revokedFor != 1 {
+		t.Error("a ativação deveria encerrar as sessões abertas sem a segunda etapa")
+	}
+
+	challenge, err := service.Challenge(ctx, user)
+	if err != nil || challenge == "" {
+		t.Fatalf("autenticador ativo deveria gerar desafio: %q, %v", challenge, err)
+	}
+
+	// o código da confirmação não pode ser reutilizado
+	if _, err := service.Verify(ctx, challenge, code, ""); !errors.Is(err, ErrInvalidMFACode) {
+		t.Errorf("código reutilizado deveria ser recusado, obtido %v", err)
+	}
+	if _, err := service.Verify(ctx, challenge, code, ""); !errors.Is(err, ErrMFAChallengeExpired) {
+		t.Errorf("desafio já usado deveria ser recusado, obtido %v", err)
+	}
+
+	now = now.Add(totp.Period)
+	next, _ := totp.Code(enrollment.Secret, now)
+	challenge, _ = service.Challenge(ctx, user)
+	verified, err := service.Verify(ctx, challenge, next, "")
+	if err != nil {
+		t.Fatalf("erro inesperado ao verificar: %v", err)
+	}
+	if verified.ID() != 1 {
+		t.Errorf("usuário esperado 1, obtido %d", verified.ID())
+	}
+
+	recoveryCode := activation.RecoveryCodes[0]
+	challenge, _ = service.Challenge(ctx, user)
+	if _, err := service.Verify(ctx, challenge, "", recoveryCode); err != nil {
+		t.Fatalf("código de recuperação deveria ser aceito: %v", err)
+	}
+	challenge, _ = service.Challenge(ctx, user)
+	if _, err := service.Verify(ctx, challenge, "", recoveryCode); !errors.Is(err, ErrInvalidMFACode) {
+		t.Errorf("código de recuperação já usado deveria ser recusado, obtido %v", err)
+	}
+}
+
+func TestTwoFactorService_Disable(t *testing.T) {
+	ctx := tenant.WithID(context.Background(), 4)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	repo := memoryTwoFactor()
+	secret, _ := totp.NewSecret()
+	credential, _ := entities.NewTOTPCredential(1, secret)
+	credential.Enable(now)
+	_ = repo.Save(ctx, credential)
+
+	code, _ := totp.Code(secret, now)
+
+	t.Run("papel obrigado pela política", func(t *testing.T) {
+		service := NewTwoFactorService(discardLogger(), mocks.NewMockUserRepository(), repo, memoryUserTokens(), mocks.NewMockRefreshTokenRepository(), auth.NewMFAPolicy(entities.RoleAdmin), "Agenda")
+		service.now = func() time.Time { return now }
+
+		err := service.Disable(ctx, auth.Principal{UserID: 1, Role: entities.RoleAdmin}, code)
+		if !errors.Is(err, auth.ErrMFARequired) {
+			t.Errorf("esperado ErrMFARequired, obtido %v", err)
+		}
+	})
+
+	t.Run("verificação opcional", func(t *testing.T) {
+		service := NewTwoFactorService(discardLogger(), mocks.NewMockUserRepository(), repo, memoryUserTokens(), mocks.NewMockRefreshTokenRepository(), auth.NewMFAPolicy(), "Agenda")
+		service.now = func() time.Time { return now }
+
+		if err := service.Disable(ctx, auth.Principal{UserID: 1, Role: entities.RoleAdmin}, code); err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if stored, _ := repo.FindByUserID(ctx, 1); stored != nil {
+			t.Error("autenticador deveria ter sido removido")
+		}
+	})
+}
@@ -2,35 +2,45 @@ package services
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"log/slog"
 	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/password"
 	"scheduling/internal/domain/repositories"
 	"time"
 )
 
+// ErrInvalidCredentials não distingue email inexistente de senha errada para
+// não revelar quais emails estão cadastrados.
+var ErrInvalidCredentials = errs.Unauthorized("auth.invalid_credentials")
+
 type UserService struct {
 	logger   *slog.Logger
 	userRepo repositories.UserRepository
+	hasher   password.Hasher
 }
 
 func NewUserService(
 	logger *slog.Logger,
 	userRepo repositories.UserRepository,
+	hasher password.Hasher,
 ) *UserService {
 	return &UserService{
 		logger:   logger,
 		userRepo: userRepo,
+		hasher:   hasher,
 	}
 }
 
-func (userService *UserService) Create(ctx context.Context, user entities.User) error {
+func (userService *UserService) Create(ctx context.Context, user *entities.User) error {
 
 	startTime := time.Now()
 	
 	exist, err := userService.userRepo.EmailExist(ctx, user.Email())
 	if err != nil {
-		userService.logger.Error(
+		userService.logger.ErrorContext(
+			ctx,
 			"Erro ao verificar existencia do email do usuário",
 			"error", err.Error(),
 			"email", user.Email(),
@@ -41,43 +51,117 @@ func (userService *UserService) Create(ctx context.Context, user entities.User)
 	}
 	
 	if exist {
-		userService.logger.Warn("tentativa de criar usuário com email existente",
+		userService.logger.WarnContext(ctx, "tentativa de criar usuário com email existente",
 			"email",  user.Email(),
 			"operation", "user_service.duplicate_email",
 			"duration_ms", time.Since(startTime).Milliseconds(),
 		)
-		return fmt.Errorf("email já existe: %s", user.Email())
+		return errs.Conflict("user.email_taken").With("email", user.Email())
 	}
 
-	err = userService.userRepo.Create(ctx, &user)
+	err = user.HashPassword(userService.hasher)
 	if err != nil {
-		userService.logger.Error(
+		userService.logger.ErrorContext(
+			ctx,
+			"Erro ao gerar o hash da senha do usuário",
+			"error", err.Error(),
+			"operation", "user_service.hash_password",
+			"duration_ms", time.Since(startTime).Milliseconds(),
+		)
+		return err
+	}
+
+	err = userService.userRepo.Create(ctx, user)
+	if err != nil {
+		userService.logger.ErrorContext(
+			ctx,
 			"Erro ao tentar criar o usuário",
-			"payload",user,
+			"error", err.Error(),
+			"email", user.Email(),
 			"operation", "user_service.create_user",
-			"durations_ms", time.Since(startTime).Milliseconds(),
+			"duration_ms", time.Since(startTime).Milliseconds(),
 		)
+		return err
 	}
 
 	return nil
 }
 
-func (userService *UserService) Authentication(ctx context.Context, user entities.User) (bool, error) {
-	
-	userDB, err := userService.userRepo.FindByEmail(ctx, user.Email())	
+// Authentication confere a senha informada com o hash guardado. Quando o hash
+// foi gerado com outro algoritmo, com custo menor que o atual ou é uma senha
+// antiga em texto puro, ele é regravado com o hasher atual. Email inexistente
+// e senha errada retornam ErrInvalidCredentials.
+func (userService *UserService) Authentication(ctx context.Context, email, plain string) (*entities.User, error) {
+
+	userDB, err := userService.userRepo.FindByEmail(ctx, email)
+	if errors.Is(err, repositories.ErrUserNotFound) {
+		// Calcula um hash mesmo assim para que o tempo de resposta não
+		// indique se o email existe.
+		_, _ = userService.hasher.Hash(plain)
+		return nil, ErrInvalidCredentials
+	}
 	if err != nil {
-		userService.logger.Error(
+		userService.logger.ErrorContext(
+			ctx,
 			"Erro ao buscar usuário por email",
 			"error", err.Error(),
-			"email", user.Email(),
+			"email", email,
 			"operation", "user_service.fidByEmail",
 		)
-		return false, err
+		return nil, err
 	}
 
-	if !user.CheckPassword(userDB.Password()) {
-		return false, nil
+	ok, err := userDB.CheckPassword(userService.hasher, plain)
+	if err != nil {
+		return nil, err
 	}
-	
-	return true, nil
-}
\ No newline at end of file
+	if !ok {
+		return nil, ErrInvalidCredentials
+	}
+
+	if userService.hasher.NeedsRehash(userDB.PasswordHash()) {
+		userService.rehash(ctx, userDB.ID(), plain)
+	}
+
+	return &userDB, nil
+}
+
+// rehash não interrompe o login: se falhar, o hash antigo continua válido e a
+// atualização é tentada de novo no próximo acesso.
+func (userService *UserService) rehash(ctx context.Context, userID int, plain string) {
+	hash, err := userService.hasher.Hash(plain)
+	if err == nil {
+		err = userService.userRepo.UpdatePasswordHash(ctx, userID, hash)
+	}
+	if err != nil {
+		userService.logger.WarnContext(
+			ctx,
+			"Erro ao atualizar o hash da senha do usuário",
+			"error", err.Error(),
+			"user_id", userID,
+			"operation", "user_service.rehash_password",
+		)
+	}
+}
+
+// UpdateLocale troca o idioma preferido do usuário; vazio volta a seguir o
+// Accept-Language. O token de acesso só traz o novo idioma depois que a
+// sessão for renovada.
+func (userService *UserService) UpdateLocale(ctx context.Context, userID int, tag string) error {
+	locale, err := entities.ParseLocale(tag)
+	if err != nil {
+		return err
+	}
+
+	if err := userService.userRepo.UpdateLocale(ctx, userID, string(locale)); err != nil {
+		userService.logger.ErrorContext(
+			ctx,
+			"Erro ao atualizar o idioma do usuário",
+			"error", err.Error(),
+			"user_id", userID,
+			"operation", "user_service.update_locale",
+		)
+		return err
+	}
+	return nil
+}
@@ -1,7 +1,146 @@
 package services
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/valueobject"
+)
+
+// versionedHasher simula um hasher cujo formato atual é "v2:"; qualquer outro
+// hash ainda é verificável, mas precisa ser regravado.
+type versionedHasher struct{}
+
+func (versionedHasher) Hash(plain string) (string, error) { return "v2:" + plain, nil }
+func (versionedHasher) Verify(hash, plain string) (bool, error) {
+	return strings.TrimPrefix(strings.TrimPrefix(hash, "v1:"), "v2:") == plain, nil
+}
+func (versionedHasher) NeedsRehash(hash string) bool { return !strings.HasPrefix(hash, "v2:") }
 
 func TestUser_Create(t *testing.T) {
-	
-}
\ No newline at end of file
+	var stored string
+	repo := mocks.NewMockUserRepository()
+	repo.CreateFunc = func(ctx context.Context, user *entities.User) error {
+		stored = user.PasswordHash()
+		return nil
+	}
+
+	service := NewUserService(discardLogger(), repo, versionedHasher{})
+
+	user, err := entities.NewUser(0, "Maria", "maria@gmail.com", "senha123", entities.RoleClient)
+	if err != nil {
+		t.Fatalf("falha ao criar usuário: %v", err)
+	}
+
+	if err := service.Create(context.Background(), user); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if stored != "v2:senha123" {
+		t.Errorf("hash gravado esperado 'v2:senha123', obtido '%s'", stored)
+	}
+}
+
+func TestUserService_Authentication(t *testing.T) {
+	tests := []struct {
+		name       string
+		storedHash string
+		password   string
+		wantErr    error
+		wantRehash string
+	}{
+		{
+			name:       "hash atual não é regravado",
+			storedHash: "v2:senha123",
+			password:   "senha123",
+		},
+		{
+			name:       "hash antigo é regravado no login",
+			storedHash: "v1:senha123",
+			password:   "senha123",
+			wantRehash: "v2:senha123",
+		},
+		{
+			name:       "senha errada não regrava o hash",
+			storedHash: "v1:senha123",
+			password:   "outra",
+			wantErr:    ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mocks.NewMockUserRepository()
+			repo.FindByEmailFunc = func(ctx context.Context, email string) (entities.User, error) {
+				emailVO, _ := valueobject.NewEmail(email)
+				user := entities.RebuildUser(7, "Maria", emailVO, entities.RoleClient)
+				user.SetPasswordHash(tt.storedHash)
+				return *user, nil
+			}
+
+			var rehashed string
+			repo.UpdatePasswordHashFunc = func(ctx context.Context, id int, hash string) error {
+				if id != 7 {
+					t.Errorf("ID esperado 7, obtido %d", id)
+				}
+				rehashed = hash
+				return nil
+			}
+
+			service := NewUserService(discardLogger(), repo, versionedHasher{})
+			user, err := service.Authentication(context.Background(), "maria@gmail.com", tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("erro esperado %v, obtido %v", tt.wantErr, err)
+			}
+			if tt.wantErr == nil && user.ID() != 7 {
+				t.Errorf("usuário esperado 7, obtido %d", user.ID())
+			}
+			if rehashed != tt.wantRehash {
+				t.Errorf("hash regravado esperado '%s', obtido '%s'", tt.wantRehash, rehashed)
+			}
+		})
+	}
+}
+
+func TestUserService_Authentication_UnknownEmail(t *testing.T) {
+	repo := mocks.NewMockUserRepository()
+	repo.FindByEmailFunc = func(ctx context.Context, email string) (entities.User, error) {
+		return entities.User{}, repositories.ErrUserNotFound
+	}
+
+	service := NewUserService(discardLogger(), repo, versionedHasher{})
+	_, err := service.Authentication(context.Background(), "ninguem@gmail.com", "senha123")
+	if !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("email inexistente deveria retornar ErrInvalidCredentials, obtido: %v", err)
+	}
+}
+
+func TestUserService_UpdateLocale(t *testing.T) {
+	stored := "-"
+	repo := mocks.NewMockUserRepository()
+	repo.UpdateLocaleFunc = func(ctx context.Context, id int, locale string) error {
+		stored = locale
+		return nil
+	}
+	service := NewUserService(discardLogger(), repo, versionedHasher{})
+
+	if err := service.UpdateLocale(context.Background(), 3, "en-US"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if stored != "en" {
+		t.Errorf("idioma gravado esperado 'en', obtido '%s'", stored)
+	}
+
+	stored = "-"
+	if err := service.UpdateLocale(context.Background(), 3, "fr"); errs.KindOf(err) != errs.KindValidation {
+		t.Errorf("idioma não suportado deveria ser erro de validação, obtido %v", err)
+	}
+	if stored != "-" {
+		t.Error("idioma não suportado não deveria ser gravado")
+	}
+}
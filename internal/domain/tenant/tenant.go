@@ -0,0 +1,35 @@
+package tenant
+
+import (
+	"context"
+
+	"scheduling/internal/domain/errs"
+)
+
+var (
+	ErrMissingTenant = errs.Validation("tenant.missing")
+	ErrUnknownTenant = errs.NotFound("tenant.unknown")
+)
+
+type contextKey struct{}
+
+// WithID devolve um contexto carregando o tenant da requisição. Todos os
+// repositórios leem o tenant daqui para escopar as consultas.
+func WithID(ctx context.Context, id int) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+func FromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(contextKey{}).(int)
+	return id, ok && id > 0
+}
+
+// Require é usado pelos repositórios: sem tenant no contexto nenhuma consulta
+// é executada, evitando vazamento de dados entre negócios.
+func Require(ctx context.Context) (int, error) {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return 0, ErrMissingTenant
+	}
+	return id, nil
+}
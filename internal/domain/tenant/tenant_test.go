@@ -0,0 +1,31 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRequire(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    int
+		wantErr error
+	}{
+		{"contexto com tenant", WithID(context.Background(), 7), 7, nil},
+		{"contexto sem tenant", context.Background(), 0, ErrMissingTenant},
+		{"tenant inválido", WithID(context.Background(), 0), 0, ErrMissingTenant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Require(tt.ctx)
+			if err != tt.wantErr {
+				t.Errorf("erro esperado %v, obtido %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("tenant esperado %d, obtido %d", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package token
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+var ErrInvalidToken = errs.Unauthorized("auth.invalid_token")
+
+// Claims são os dados carregados pelo token de acesso. ID e ExpiresAt são
+// preenchidos por quem emite o token. EmailVerified reflete o usuário no
+// momento da emissão; depois de verificar o email, o cliente precisa renovar
+// a sessão para que o novo token traga a verificação. MFA indica que a
+// sessão foi aberta com a verificação em duas etapas. Locale é o idioma
+// preferido do usuário, vazio quando ele não escolheu nenhum.
+type Claims struct {
+	ID            string
+	UserID        int
+	Role          string
+	TenantID      int
+	EmailVerified bool
+	MFA           bool
+	Locale        string
+	ExpiresAt     time.Time
+}
+
+// Issuer emite e valida tokens de acesso. VerifyToken retorna ErrInvalidToken
+// para tokens malformados, com assinatura inválida ou expirados.
+type Issuer interface {
+	CreateToken(claims Claims) (string, time.Time, error)
+	VerifyToken(tokenString string) (*Claims, error)
+}
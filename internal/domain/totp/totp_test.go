@@ -0,0 +1,85 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// Vetores do apêndice B da RFC 6238 para SHA-1, com 8 dígitos.
+func TestHOTP_RFC6238(t *testing.T) {
+	key := []byte("12345678901234567890")
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, tt := range tests {
+		if got := hotp(key, uint64(Step(time.Unix(tt.unix, 0))), 8); got != tt.want {
+			t.Errorf("T=%d: esperado %s, obtido %s", tt.unix, tt.want, got)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	now := time.Unix(1111111111, 0)
+
+	code, err := Code(secret, now)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if code != "050471" {
+		t.Errorf("código esperado 050471, obtido %s", code)
+	}
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"mesma janela", now, true},
+		{"janela seguinte", now.Add(Period), true},
+		{"janela anterior", now.Add(-Period), true},
+		{"duas janelas depois", now.Add(2 * Period), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Verify(secret, code, tt.at)
+			if ok != tt.want {
+				t.Fatalf("Verify() = %v, esperado %v", ok, tt.want)
+			}
+			if ok && step != Step(now) {
+				t.Errorf("janela esperada %d, obtida %d", Step(now), step)
+			}
+		})
+	}
+
+	if _, ok := Verify(secret, "12345", now); ok {
+		t.Error("código com tamanho errado não deveria ser aceito")
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri, err := url.Parse(ProvisioningURI("Agenda", "maria@gmail.com", "JBSWY3DPEHPK3PXP"))
+	if err != nil {
+		t.Fatalf("URI inválida: %v", err)
+	}
+
+	if uri.Scheme != "otpauth" || uri.Host != "totp" || uri.Path != "/Agenda:maria@gmail.com" {
+		t.Errorf("URI incorreta: %s", uri)
+	}
+	if uri.Query().Get("secret") != "JBSWY3DPEHPK3PXP" || uri.Query().Get("issuer") != "Agenda" {
+		t.Errorf("parâmetros incorretos: %s", uri.RawQuery)
+	}
+}
@@ -1,11 +1,12 @@
 package valueobject
 
 import (
-	"errors"
 	"regexp"
+
+	"scheduling/internal/domain/errs"
 )
 
-var ErrInvalidEmail = errors.New("invalid email format")
+var ErrInvalidEmail = errs.Validation("email.invalid")
 
 type Email struct {
 	address string
@@ -0,0 +1,74 @@
+package valueobject
+
+import (
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+var ErrInvalidTimeRange = errs.Validation("time_range.invalid")
+
+type TimeRange struct {
+	start time.Time
+	end   time.Time
+}
+
+func NewTimeRange(start, end time.Time) (TimeRange, error) {
+	if !start.Before(end) {
+		return TimeRange{}, ErrInvalidTimeRange
+	}
+	return TimeRange{start: start, end: end}, nil
+}
+
+func (r TimeRange) Start() time.Time        { return r.start }
+func (r TimeRange) End() time.Time          { return r.end }
+func (r TimeRange) Duration() time.Duration { return r.end.Sub(r.start) }
+
+func (r TimeRange) Overlaps(other TimeRange) bool {
+	return r.start.Before(other.end) && other.start.Before(r.end)
+}
+
+func (r TimeRange) Contains(other TimeRange) bool {
+	return !other.start.Before(r.start) && !other.end.After(r.end)
+}
+
+func (r TimeRange) Overlap(other TimeRange) time.Duration {
+	if !r.Overlaps(other) {
+		return 0
+	}
+	start, end := r.start, r.end
+	if other.start.After(start) {
+		start = other.start
+	}
+	if other.end.Before(end) {
+		end = other.end
+	}
+	return end.Sub(start)
+}
+
+// Subtract remove de r o trecho ocupado por busy, podendo dividir r em duas partes.
+func (r TimeRange) Subtract(busy TimeRange) []TimeRange {
+	if !r.Overlaps(busy) {
+		return []TimeRange{r}
+	}
+
+	var result []TimeRange
+	if r.start.Before(busy.start) {
+		result = append(result, TimeRange{start: r.start, end: busy.start})
+	}
+	if busy.end.Before(r.end) {
+		result = append(result, TimeRange{start: busy.end, end: r.end})
+	}
+	return result
+}
+
+func SubtractAll(free []TimeRange, busy []TimeRange) []TimeRange {
+	for _, b := range busy {
+		var next []TimeRange
+		for _, f := range free {
+			next = append(next, f.Subtract(b)...)
+		}
+		free = next
+	}
+	return free
+}
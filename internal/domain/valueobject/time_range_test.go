@@ -0,0 +1,107 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+)
+
+func at(hour, minute int) time.Time {
+	return time.Date(2030, 1, 7, hour, minute, 0, 0, time.UTC)
+}
+
+func mustRange(t *testing.T, start, end time.Time) TimeRange {
+	t.Helper()
+	r, err := NewTimeRange(start, end)
+	if err != nil {
+		t.Fatalf("falha ao criar intervalo: %v", err)
+	}
+	return r
+}
+
+func TestNewTimeRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   time.Time
+		end     time.Time
+		wantErr bool
+	}{
+		{"intervalo valido", at(9, 0), at(10, 0), false},
+		{"inicio igual ao fim", at(9, 0), at(9, 0), true},
+		{"inicio depois do fim", at(10, 0), at(9, 0), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewTimeRange(tt.start, tt.end)
+			if tt.wantErr {
+				if err != ErrInvalidTimeRange {
+					t.Errorf("NewTimeRange() error = %v, expectedErr %v", err, ErrInvalidTimeRange)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if r.Duration() != tt.end.Sub(tt.start) {
+				t.Errorf("Duration() = %v, esperado %v", r.Duration(), tt.end.Sub(tt.start))
+			}
+		})
+	}
+}
+
+func TestTimeRange_Overlaps(t *testing.T) {
+	base := mustRange(t, at(9, 0), at(12, 0))
+
+	tests := []struct {
+		name        string
+		other       TimeRange
+		overlaps    bool
+		contains    bool
+		overlapTime time.Duration
+	}{
+		{"totalmente dentro", mustRange(t, at(10, 0), at(11, 0)), true, true, time.Hour},
+		{"sobrepoe o inicio", mustRange(t, at(8, 0), at(9, 30)), true, false, 30 * time.Minute},
+		{"sobrepoe o fim", mustRange(t, at(11, 30), at(13, 0)), true, false, 30 * time.Minute},
+		{"encosta no fim", mustRange(t, at(12, 0), at(13, 0)), false, false, 0},
+		{"antes do intervalo", mustRange(t, at(7, 0), at(8, 0)), false, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := base.Overlaps(tt.other); got != tt.overlaps {
+				t.Errorf("Overlaps() = %v, esperado %v", got, tt.overlaps)
+			}
+			if got := base.Contains(tt.other); got != tt.contains {
+				t.Errorf("Contains() = %v, esperado %v", got, tt.contains)
+			}
+			if got := base.Overlap(tt.other); got != tt.overlapTime {
+				t.Errorf("Overlap() = %v, esperado %v", got, tt.overlapTime)
+			}
+		})
+	}
+}
+
+func TestSubtractAll(t *testing.T) {
+	free := []TimeRange{mustRange(t, at(9, 0), at(18, 0))}
+	busy := []TimeRange{
+		mustRange(t, at(12, 0), at(13, 0)),
+		mustRange(t, at(8, 0), at(9, 30)),
+		mustRange(t, at(17, 0), at(19, 0)),
+	}
+
+	got := SubtractAll(free, busy)
+	want := []TimeRange{
+		mustRange(t, at(9, 30), at(12, 0)),
+		mustRange(t, at(13, 0), at(17, 0)),
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("esperado %d intervalos, obtido %d", len(want), len(got))
+	}
+	for i := range want {
+		if !got[i].Start().Equal(want[i].Start()) || !got[i].End().Equal(want[i].End()) {
+			t.Errorf("intervalo %d = [%v, %v], esperado [%v, %v]",
+				i, got[i].Start(), got[i].End(), want[i].Start(), want[i].End())
+		}
+	}
+}
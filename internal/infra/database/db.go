@@ -6,12 +6,27 @@ import (
 	"log"
 	"os"
 
+	"github.com/XSAM/otelsql"
 	_ "github.com/go-sql-driver/mysql"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// Connect abre o banco com o driver instrumentado: cada consulta feita com
+// um context.Context vira um span filho do span da requisição.
 func Connect() *sql.DB {
 	dsn := getDSN()
-	db, err := sql.Open("mysql", dsn)
+	db, err := otelsql.Open(
+		"mysql",
+		dsn,
+		otelsql.WithAttributes(attribute.String("db.system", "mysql")),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			// só as consultas; leitura de linhas e reset de conexão viram ruído
+			OmitRows:             true,
+			OmitConnResetSession: true,
+			OmitConnPrepare:      true,
+			DisableErrSkip:       true,
+		}),
+	)
 	if err != nil {
 		log.Fatalf("erro ao abrir conexão com o banco: %v", err)
 	}
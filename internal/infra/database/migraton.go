@@ -1,50 +1,399 @@
 package database
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 	"log"
+	"regexp"
+	"sort"
+	"strings"
 )
 
+var createTables = []string{
+	`CREATE TABLE IF NOT EXISTS tenants (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		name VARCHAR(255),
+		slug VARCHAR(63) UNIQUE,
+		created_at DATETIME
+	)`,
+	`CREATE TABLE IF NOT EXISTS users (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		name VARCHAR(255),
+		email VARCHAR(255),
+		password VARCHAR(255),
+		role VARCHAR(50),
+		created_at DATETIME,
+		email_verified_at DATETIME NULL,
+		locale VARCHAR(10) NOT NULL DEFAULT '',
+		tenant_id INT NOT NULL DEFAULT 1,
+		UNIQUE KEY uq_users_tenant_email (tenant_id, email)
+	)`,
+	`CREATE TABLE IF NOT EXISTS services (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		staff_id INT,
+		name VARCHAR(255),
+		duration INT,
+		price DECIMAL(10,2),
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1
+	)`,
+	`CREATE TABLE IF NOT EXISTS appointments (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		client_id INT,
+		staff_id INT,
+		service_id INT,
+		scheduled_at DATETIME,
+		status VARCHAR(50),
+		created_at DATETIME,
+		location_id INT NULL,
+		tenant_id INT NOT NULL DEFAULT 1
+	)`,
+	`CREATE TABLE IF NOT EXISTS available_slots (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		staff_id INT,
+		weekday VARCHAR(20),
+		start_time TIME,
+		end_time TIME,
+		location_id INT NULL,
+		tenant_id INT NOT NULL DEFAULT 1
+	)`,
+	`CREATE TABLE IF NOT EXISTS staff_breaks (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		staff_id INT,
+		weekday VARCHAR(20),
+		start_time TIME,
+		end_time TIME,
+		duration_minutes INT,
+		tenant_id INT NOT NULL DEFAULT 1
+	)`,
+	`CREATE TABLE IF NOT EXISTS resources (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		name VARCHAR(255),
+		capacity INT,
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1
+	)`,
+	`CREATE TABLE IF NOT EXISTS service_resources (
+		service_id INT,
+		resource_id INT,
+		quantity INT,
+		tenant_id INT NOT NULL DEFAULT 1,
+		PRIMARY KEY (service_id, resource_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS appointment_resources (
+		appointment_id INT,
+		resource_id INT,
+		quantity INT,
+		starts_at DATETIME,
+		ends_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1,
+		PRIMARY KEY (appointment_id, resource_id),
+		INDEX idx_appointment_resources_period (resource_id, starts_at, ends_at)
+	)`,
+	`CREATE TABLE IF NOT EXISTS locations (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		name VARCHAR(255),
+		address VARCHAR(255),
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1
+	)`,
+	`CREATE TABLE IF NOT EXISTS location_services (
+		location_id INT,
+		service_id INT,
+		tenant_id INT NOT NULL DEFAULT 1,
+		PRIMARY KEY (location_id, service_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS location_travel_times (
+		from_location_id INT,
+		to_location_id INT,
+		minutes INT,
+		tenant_id INT NOT NULL DEFAULT 1,
+		PRIMARY KEY (from_location_id, to_location_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS business_settings (
+		tenant_id INT PRIMARY KEY,
+		slot_step_minutes INT,
+		timezone VARCHAR(64),
+		currency CHAR(3),
+		cancellation_notice_minutes INT,
+		booking_horizon_days INT,
+		branding_name VARCHAR(100),
+		updated_at DATETIME
+	)`,
+	`CREATE TABLE IF NOT EXISTS refresh_tokens (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		user_id INT,
+		token_hash CHAR(64) UNIQUE,
+		family_id CHAR(36),
+		expires_at DATETIME,
+		revoked_at DATETIME NULL,
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1,
+		INDEX idx_refresh_tokens_family (family_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS external_identities (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		user_id INT,
+		provider VARCHAR(50),
+		subject VARCHAR(255),
+		email VARCHAR(100),
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1,
+		UNIQUE KEY uq_external_identities_subject (tenant_id, provider, subject)
+	)`,
+	`CREATE TABLE IF NOT EXISTS user_tokens (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		user_id INT,
+		purpose VARCHAR(32),
+		token_hash CHAR(64) UNIQUE,
+		expires_at DATETIME,
+		used_at DATETIME NULL,
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1,
+		INDEX idx_user_tokens_user (user_id, purpose)
+	)`,
+	`CREATE TABLE IF NOT EXISTS user_totp (
+		user_id INT PRIMARY KEY,
+		secret VARCHAR(64),
+		enabled_at DATETIME NULL,
+		last_used_step BIGINT NOT NULL DEFAULT 0,
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1
+	)`,
+	`CREATE TABLE IF NOT EXISTS recovery_codes (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		user_id INT,
+		code_hash CHAR(64),
+		used_at DATETIME NULL,
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1,
+		INDEX idx_recovery_codes_user (user_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS login_attempts (
+		scope VARCHAR(16),
+		attempt_key VARCHAR(255),
+		failures INT NOT NULL DEFAULT 0,
+		last_failure_at DATETIME,
+		locked_until DATETIME NULL,
+		tenant_id INT NOT NULL DEFAULT 1,
+		PRIMARY KEY (tenant_id, scope, attempt_key)
+	)`,
+	`CREATE TABLE IF NOT EXISTS audit_log (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		actor_id INT NULL,
+		action VARCHAR(50),
+		subject VARCHAR(255),
+		ip VARCHAR(45),
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1,
+		INDEX idx_audit_log_created (tenant_id, created_at)
+	)`,
+	`CREATE TABLE IF NOT EXISTS api_keys (
+		id INT AUTO_INCREMENT PRIMARY KEY,
+		name VARCHAR(100),
+		prefix VARCHAR(16),
+		key_hash CHAR(64) UNIQUE,
+		scopes VARCHAR(255),
+		created_by INT,
+		expires_at DATETIME NULL,
+		last_used_at DATETIME NULL,
+		revoked_at DATETIME NULL,
+		created_at DATETIME,
+		tenant_id INT NOT NULL DEFAULT 1
+	)`,
+}
+
+// addedColumns são as colunas incluídas depois da criação das tabelas.
+var addedColumns = []struct{ table, column, definition string }{
+	{"appointments", "location_id", "INT NULL"},
+	{"available_slots", "location_id", "INT NULL"},
+	{"users", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"users", "email_verified_at", "DATETIME NULL"},
+	{"users", "locale", "VARCHAR(10) NOT NULL DEFAULT ''"},
+	{"services", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"appointments", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"available_slots", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"staff_breaks", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"resources", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"service_resources", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"appointment_resources", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"locations", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"location_services", "tenant_id", "INT NOT NULL DEFAULT 1"},
+	{"location_travel_times", "tenant_id", "INT NOT NULL DEFAULT 1"},
+}
+
+// addedIndexes são os índices incluídos depois da criação das tabelas,
+// criados antes da remoção dos índices de droppedIndexes que substituem.
+var addedIndexes = []struct{ table, index, definition string }{
+	{"users", "uq_users_tenant_email", "UNIQUE KEY uq_users_tenant_email (tenant_id, email)"},
+}
+
+// droppedIndexes são os índices de versões anteriores que não devem mais
+// existir.
+var droppedIndexes = []struct{ table, index string }{
+	// antes do multi-tenant o email era único no banco todo
+	// (email VARCHAR(255) UNIQUE), o que impede o mesmo email em outro tenant
+	{"users", "email"},
+}
+
+var tableName = regexp.MustCompile(`CREATE TABLE IF NOT EXISTS (\w+)`)
+
+// CheckMigrations confere se as tabelas, colunas e índices de Migrate estão
+// aplicados no banco, sem alterá-lo. Usado na prontidão da aplicação, que
+// chama Migrate na subida.
+func CheckMigrations(ctx context.Context, db *sql.DB) error {
+	existing := map[string]bool{}
+	rows, err := db.QueryContext(ctx, "SELECT table_name, column_name FROM information_schema.columns WHERE table_schema = DATABASE()")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var table, column string
+		if err := rows.Scan(&table, &column); err != nil {
+			return err
+		}
+		existing[table] = true
+		existing[table+"."+column] = true
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	indexes, err := existingIndexes(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+	for _, query := range createTables {
+		if table := tableName.FindStringSubmatch(query)[1]; !existing[table] {
+			missing = append(missing, table)
+		}
+	}
+	for _, c := range addedColumns {
+		if existing[c.table] && !existing[c.table+"."+c.column] {
+			missing = append(missing, c.table+"."+c.column)
+		}
+	}
+	for _, i := range addedIndexes {
+		if existing[i.table] && !indexes[i.table+"."+i.index] {
+			missing = append(missing, "índice "+i.table+"."+i.index)
+		}
+	}
+	for _, i := range droppedIndexes {
+		if indexes[i.table+"."+i.index] {
+			missing = append(missing, "remover índice "+i.table+"."+i.index)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("migrations pendentes: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// Migrate cria as tabelas e aplica as colunas e índices pendentes. Pode rodar
+// a cada subida: cada passo confere o schema antes de alterá-lo.
 func Migrate(db *sql.DB) {
-	queries := []string{
-		`CREATE TABLE IF NOT EXISTS users (
-			id INT AUTO_INCREMENT PRIMARY KEY,
-			name VARCHAR(255),
-			email VARCHAR(255) UNIQUE,
-			password VARCHAR(255),
-			role VARCHAR(50),
-			created_at DATETIME
-		)`,
-		`CREATE TABLE IF NOT EXISTS services (
-			id INT AUTO_INCREMENT PRIMARY KEY,
-			staff_id INT,
-			name VARCHAR(255),
-			duration INT,
-			price DECIMAL(10,2),
-			created_at DATETIME
-		)`,
-		`CREATE TABLE IF NOT EXISTS appointments (
-			id INT AUTO_INCREMENT PRIMARY KEY,
-			client_id INT,
-			staff_id INT,
-			service_id INT,
-			scheduled_at DATETIME,
-			status VARCHAR(50),
-			created_at DATETIME
-		)`,
-		`CREATE TABLE IF NOT EXISTS available_slots (
-			id INT AUTO_INCREMENT PRIMARY KEY,
-			staff_id INT,
-			weekday VARCHAR(20),
-			start_time TIME,
-			end_time TIME
-		)`,
-	}
-
-	for _, q := range queries {
+	for _, q := range createTables {
 		_, err := db.Exec(q)
 		if err != nil {
 			log.Fatalf("erro ao executar migration: %v", err)
 		}
 	}
+
+	for _, c := range addedColumns {
+		if err := addColumnIfMissing(db, c.table, c.column, c.definition); err != nil {
+			log.Fatalf("erro ao executar migration: %v", err)
+		}
+	}
+
+	for _, i := range addedIndexes {
+		if err := addIndexIfMissing(db, i.table, i.index, i.definition); err != nil {
+			log.Fatalf("erro ao executar migration: %v", err)
+		}
+	}
+	for _, i := range droppedIndexes {
+		if err := dropIndexIfExists(db, i.table, i.index); err != nil {
+			log.Fatalf("erro ao executar migration: %v", err)
+		}
+	}
+
+	// dados anteriores ao multi-tenant ficam no tenant padrão
+	_, err := db.Exec("INSERT IGNORE INTO tenants (id, name, slug, created_at) VALUES (1, 'default', 'default', NOW())")
+	if err != nil {
+		log.Fatalf("erro ao executar migration: %v", err)
+	}
+}
+
+// addColumnIfMissing cobre bancos criados antes da coluna existir, já que o
+// CREATE TABLE IF NOT EXISTS não altera tabelas existentes.
+func addColumnIfMissing(db *sql.DB, table, column, definition string) error {
+	var count int
+	err := db.QueryRow(
+		"SELECT COUNT(*) FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ? AND column_name = ?",
+		table, column,
+	).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	_, err = db.Exec("ALTER TABLE " + table + " ADD COLUMN " + column + " " + definition)
+	return err
+}
+
+func existingIndexes(ctx context.Context, db *sql.DB) (map[string]bool, error) {
+	indexes := map[string]bool{}
+	rows, err := db.QueryContext(ctx, "SELECT DISTINCT table_name, index_name FROM information_schema.statistics WHERE table_schema = DATABASE()")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var table, index string
+		if err := rows.Scan(&table, &index); err != nil {
+			return nil, err
+		}
+		indexes[table+"."+index] = true
+	}
+	return indexes, rows.Err()
+}
+
+func indexExists(db *sql.DB, table, index string) (bool, error) {
+	var count int
+	err := db.QueryRow(
+		"SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?",
+		table, index,
+	).Scan(&count)
+	return count > 0, err
+}
+
+// addIndexIfMissing, como addColumnIfMissing, cobre tabelas criadas antes do
+// índice existir.
+func addIndexIfMissing(db *sql.DB, table, index, definition string) error {
+	exists, err := indexExists(db, table, index)
+	if err != nil || exists {
+		return err
+	}
+
+	_, err = db.Exec("ALTER TABLE " + table + " ADD " + definition)
+	return err
+}
+
+func dropIndexIfExists(db *sql.DB, table, index string) error {
+	exists, err := indexExists(db, table, index)
+	if err != nil || !exists {
+		return err
+	}
+
+	_, err = db.Exec("ALTER TABLE " + table + " DROP INDEX " + index)
+	return err
 }
@@ -0,0 +1,192 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+const (
+	columnsQuery = "SELECT table_name, column_name FROM information_schema.columns"
+	indexesQuery = "SELECT DISTINCT table_name, index_name FROM information_schema.statistics"
+	indexQuery   = "SELECT COUNT\\(\\*\\) FROM information_schema.statistics"
+)
+
+// schemaRows devolve as colunas de todas as tabelas de Migrate, menos as
+// indicadas em skip (tabela ou tabela.coluna).
+func schemaRows(skip ...string) *sqlmock.Rows {
+	skipped := map[string]bool{}
+	for _, name := range skip {
+		skipped[name] = true
+	}
+
+	rows := sqlmock.NewRows([]string{"table_name", "column_name"})
+	for _, query := range createTables {
+		table := tableName.FindStringSubmatch(query)[1]
+		if !skipped[table] {
+			rows.AddRow(table, "id")
+		}
+	}
+	for _, c := range addedColumns {
+		if !skipped[c.table] && !skipped[c.table+"."+c.column] {
+			rows.AddRow(c.table, c.column)
+		}
+	}
+	return rows
+}
+
+// indexRows devolve os índices de addedIndexes, menos os de skip
+// (tabela.índice), mais os de extra.
+func indexRows(skip []string, extra ...string) *sqlmock.Rows {
+	skipped := map[string]bool{}
+	for _, name := range skip {
+		skipped[name] = true
+	}
+
+	rows := sqlmock.NewRows([]string{"table_name", "index_name"}).AddRow("users", "PRIMARY")
+	for _, i := range addedIndexes {
+		if !skipped[i.table+"."+i.index] {
+			rows.AddRow(i.table, i.index)
+		}
+	}
+	for _, name := range extra {
+		table, index, _ := strings.Cut(name, ".")
+		rows.AddRow(table, index)
+	}
+	return rows
+}
+
+func TestCheckMigrations(t *testing.T) {
+	tests := []struct {
+		name         string
+		skip         []string
+		extraIndexes []string
+		wantErr      string
+	}{
+		{
+			name: "todas aplicadas",
+		},
+		{
+			name:    "tabela ausente",
+			skip:    []string{"api_keys"},
+			wantErr: "migrations pendentes: api_keys",
+		},
+		{
+			name:    "coluna ausente",
+			skip:    []string{"users.locale", "appointments.location_id"},
+			wantErr: "migrations pendentes: appointments.location_id, users.locale",
+		},
+		{
+			name:    "índice por tenant ausente",
+			skip:    []string{"users.uq_users_tenant_email"},
+			wantErr: "migrations pendentes: índice users.uq_users_tenant_email",
+		},
+		{
+			name:         "email único no banco todo",
+			extraIndexes: []string{"users.email"},
+			wantErr:      "migrations pendentes: remover índice users.email",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("falha ao criar mock: %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectQuery(columnsQuery).WillReturnRows(schemaRows(tt.skip...))
+			mock.ExpectQuery(indexesQuery).WillReturnRows(indexRows(tt.skip, tt.extraIndexes...))
+
+			err = CheckMigrations(context.Background(), db)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("erro esperado %q, obtido %v", tt.wantErr, err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas não atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestMigrateIndexes(t *testing.T) {
+	countRows := func(count int) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"count"}).AddRow(count)
+	}
+
+	t.Run("cria o índice ausente", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("falha ao criar mock: %v", err)
+		}
+		defer db.Close()
+
+		mock.ExpectQuery(indexQuery).WithArgs("users", "uq_users_tenant_email").WillReturnRows(countRows(0))
+		mock.ExpectExec("ALTER TABLE users ADD UNIQUE KEY uq_users_tenant_email \\(tenant_id, email\\)").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		if err := addIndexIfMissing(db, "users", "uq_users_tenant_email", "UNIQUE KEY uq_users_tenant_email (tenant_id, email)"); err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("expectativas não atendidas: %v", err)
+		}
+	})
+
+	t.Run("não recria o índice existente", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("falha ao criar mock: %v", err)
+		}
+		defer db.Close()
+
+		mock.ExpectQuery(indexQuery).WithArgs("users", "uq_users_tenant_email").WillReturnRows(countRows(1))
+
+		if err := addIndexIfMissing(db, "users", "uq_users_tenant_email", "UNIQUE KEY uq_users_tenant_email (tenant_id, email)"); err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("expectativas não atendidas: %v", err)
+		}
+	})
+
+	t.Run("remove o índice global do email", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("falha ao criar mock: %v", err)
+		}
+		defer db.Close()
+
+		mock.ExpectQuery(indexQuery).WithArgs("users", "email").WillReturnRows(countRows(1))
+		mock.ExpectExec("ALTER TABLE users DROP INDEX email").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		if err := dropIndexIfExists(db, "users", "email"); err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("expectativas não atendidas: %v", err)
+		}
+	})
+
+	t.Run("banco novo não tem o índice antigo", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("falha ao criar mock: %v", err)
+		}
+		defer db.Close()
+
+		mock.ExpectQuery(indexQuery).WithArgs("users", "email").WillReturnRows(countRows(0))
+
+		if err := dropIndexIfExists(db, "users", "email"); err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("expectativas não atendidas: %v", err)
+		}
+	})
+}
@@ -1,6 +1,10 @@
 package adapter
 
 import (
+	"bytes"
+	"context"
+	"io"
+
 	http "scheduling/internal/infra/gin"
 
 	"github.com/gin-gonic/gin"
@@ -10,19 +14,63 @@ type GinContext struct {
 	ctx *gin.Context
 }
 
+func (g *GinContext) Context() context.Context {
+	return g.ctx.Request.Context()
+}
+func (g *GinContext) SetContext(ctx context.Context) {
+	g.ctx.Request = g.ctx.Request.WithContext(ctx)
+}
+func (g *GinContext) GetHeader(key string) string {
+	return g.ctx.GetHeader(key)
+}
+func (g *GinContext) Cookie(name string) (string, bool) {
+	value, err := g.ctx.Cookie(name)
+	return value, err == nil
+}
+func (g *GinContext) Host() string {
+	return g.ctx.Request.Host
+}
+func (g *GinContext) ClientIP() string {
+	return g.ctx.ClientIP()
+}
+func (g *GinContext) Method() string {
+	return g.ctx.Request.Method
+}
+func (g *GinContext) Path() string {
+	return g.ctx.Request.URL.Path
+}
 func (g *GinContext) Param(name string) string {
 	return g.ctx.Param(name)
 }
 func (g *GinContext) Query(name string) string {
 	return g.ctx.Query(name)
 }
+func (g *GinContext) Body() ([]byte, error) {
+	if g.ctx.Request.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(g.ctx.Request.Body)
+	if err != nil {
+		return nil, err
+	}
+	g.ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
 func (g *GinContext) Bind(obj interface{}) error {
-	return g.ctx.ShouldBindJSON(obj)
+	return http.DecodeJSON(g.ctx.Request.Body, obj, false)
+}
+func (g *GinContext) BindStrict(obj interface{}) error {
+	return http.DecodeJSON(g.ctx.Request.Body, obj, true)
 }
 func (g *GinContext) JSON(status int, obj interface{}) error {
 	g.ctx.JSON(status, obj)
 	return nil
 }
+func (g *GinContext) Problem(status int, err error) error {
+	writeProblem(g.ctx, status, err)
+	return nil
+}
 func (g *GinContext) Status(code int) {
 	g.ctx.Status(code)
 }
@@ -32,18 +80,33 @@ func (g *GinContext) Header(key, value string) {
 func (g *GinContext) Set(key string, value any) {
 	g.ctx.Set(key, value)
 }
+func (g *GinContext) Get(key string) (any, bool) {
+	return g.ctx.Get(key)
+}
 
 func wrapHandler(h http.HandlerFunc) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		_ = h(&GinContext{ctx: c})
+		if err := h(&GinContext{ctx: c}); err != nil {
+			handleError(c, err)
+		}
 	}
 }
 
 func wrapMiddleware(m http.MiddlewareFunc) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		_ = m(func(ctx http.Context) error {
+		called := false
+		err := m(func(ctx http.Context) error {
+			called = true
 			c.Next()
 			return nil
 		})(&GinContext{ctx: c})
+		if err != nil {
+			handleError(c, err)
+		}
+
+		// middleware que responde sem chamar next interrompe a cadeia
+		if !called {
+			c.Abort()
+		}
 	}
 }
@@ -2,6 +2,7 @@ package adapter
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http/httptest"
 	"testing"
@@ -444,4 +445,324 @@ func TestWrapMiddleware(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestWrapMiddleware_ShortCircuit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handlerCalled := false
+	reject := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			return ctx.JSON(401, map[string]string{"error": "unauthorized"})
+		}
+	}
+
+	router := gin.New()
+	router.Use(wrapMiddleware(reject))
+	router.GET("/test", func(c *gin.Context) {
+		handlerCalled = true
+		c.Status(200)
+	})
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if handlerCalled {
+		t.Error("handler should not be called when middleware does not call next")
+	}
+	if w.Code != 401 {
+		t.Errorf("expected status 401, got %d", w.Code)
+	}
+}
+
+type ctxKey struct{}
+
+func TestGinContext_SetContext(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	setValue := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			ctx.SetContext(context.WithValue(ctx.Context(), ctxKey{}, "value"))
+			return next(ctx)
+		}
+	}
+
+	var got any
+	var host, header string
+	router := gin.New()
+	router.Use(wrapMiddleware(setValue))
+	router.GET("/test", wrapHandler(func(ctx http.Context) error {
+		got = ctx.Context().Value(ctxKey{})
+		host = ctx.Host()
+		header = ctx.GetHeader("X-Tenant-ID")
+		ctx.Status(200)
+		return nil
+	}))
+
+	req := httptest.NewRequest("GET", "http://acme.example.com/test", nil)
+	req.Header.Set("X-Tenant-ID", "acme")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if got != "value" {
+		t.Errorf("expected context value 'value', got %v", got)
+	}
+	if host != "acme.example.com" {
+		t.Errorf("expected host acme.example.com, got %s", host)
+	}
+	if header != "acme" {
+		t.Errorf("expected header acme, got %s", header)
+	}
+}
+
+func TestGinContext_ClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var ip string
+	router := gin.New()
+	router.GET("/test", wrapHandler(func(ctx http.Context) error {
+		ip = ctx.ClientIP()
+		ctx.Status(200)
+		return nil
+	}))
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.RemoteAddr = "203.0.113.7:52114"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if ip != "203.0.113.7" {
+		t.Errorf("expected client IP 203.0.113.7, got %s", ip)
+	}
+}
+
+func TestGinContext_BindValidation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	type Input struct {
+		Name     string   `json:"name" validate:"required,max=5"`
+		Email    string   `json:"email" validate:"omitempty,email"`
+		Role     string   `json:"role" validate:"omitempty,oneof=client admin"`
+		Age      int      `json:"age" validate:"gte=0"`
+		Tags     []string `json:"tags" validate:"max=2"`
+		Code     string   `json:"code" validate:"required_without=Recovery"`
+		Recovery string   `json:"recovery_code"`
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		strict     bool
+		wantStatus int
+		wantCode   string
+		wantFields []http.FieldError
+	}{
+		{
+			name:       "valid body",
+			body:       `{"name":"Ana","email":"ana@gmail.com","code":"123456"}`,
+			wantStatus: 200,
+		},
+		{
+			name:       "unknown fields are ignored by default",
+			body:       `{"name":"Ana","recovery_code":"abc","admin":true}`,
+			wantStatus: 200,
+		},
+		{
+			name:       "rule violations",
+			body:       `{"name":"Joaquim","email":"invalid","role":"staff","age":-1,"tags":["a","b","c"]}`,
+			wantStatus: 400,
+			wantCode:   "request.invalid_fields",
+			wantFields: []http.FieldError{
+				{Field: "name", Code: "validation.max_length", Message: "must be at most 5 characters long"},
+				{Field: "email", Code: "validation.email", Message: "must be a valid email"},
+				{Field: "role", Code: "validation.oneof", Message: "must be one of: client, admin"},
+				{Field: "age", Code: "validation.gte", Message: "must be greater than or equal to 0"},
+				{Field: "tags", Code: "validation.max_items", Message: "must have at most 2 items"},
+				{Field: "code", Code: "validation.required_without", Message: "is required when recovery_code is not present"},
+			},
+		},
+		{
+			name:       "wrong type",
+			body:       `{"name":"Ana","age":"ten"}`,
+			wantStatus: 400,
+			wantCode:   "request.invalid_fields",
+			wantFields: []http.FieldError{{Field: "age", Code: "validation.type", Message: "invalid type, expected number"}},
+		},
+		{
+			name:       "strict mode rejects unknown fields",
+			body:       `{"name":"Ana","code":"1","admin":true}`,
+			strict:     true,
+			wantStatus: 400,
+			wantCode:   "request.invalid_fields",
+			wantFields: []http.FieldError{{Field: "admin", Code: "validation.unknown_field", Message: "unknown field"}},
+		},
+		{
+			name:       "malformed body",
+			body:       `{"name":`,
+			wantStatus: 400,
+			wantCode:   "request.invalid_body",
+		},
+		{
+			name:       "empty body",
+			wantStatus: 400,
+			wantCode:   "request.invalid_body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/test", wrapHandler(func(ctx http.Context) error {
+				var input Input
+				bind := ctx.Bind
+				if tt.strict {
+					bind = ctx.BindStrict
+				}
+				if err := bind(&input); err != nil {
+					return err
+				}
+				return ctx.JSON(200, input)
+			}))
+
+			req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(tt.body))
+			req.Header.Set("Accept-Language", "en")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == 200 {
+				return
+			}
+
+			var problem http.Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+			}
+			if problem.Code != tt.wantCode {
+				t.Errorf("expected code %s, got %s", tt.wantCode, problem.Code)
+			}
+			if len(problem.Errors) != len(tt.wantFields) {
+				t.Fatalf("expected field errors %+v, got %+v", tt.wantFields, problem.Errors)
+			}
+			for i, want := range tt.wantFields {
+				if problem.Errors[i] != want {
+					t.Errorf("expected field error %+v, got %+v", want, problem.Errors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGinContext_Request(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var method, path, header, cookie string
+	var hasCookie, hasMissing bool
+	var got any
+	router := gin.New()
+	router.PUT("/users/:id", wrapHandler(func(ctx http.Context) error {
+		method = ctx.Method()
+		path = ctx.Path()
+		header = ctx.GetHeader("X-Request-ID")
+		cookie, hasCookie = ctx.Cookie("session")
+		_, hasMissing = ctx.Cookie("missing")
+		got = ctx.Context().Value(ctxKey{})
+		ctx.Status(204)
+		return nil
+	}))
+
+	req := httptest.NewRequest("PUT", "/users/42?expand=roles", nil)
+	req = req.WithContext(context.WithValue(req.Context(), ctxKey{}, "from request"))
+	req.Header.Set("X-Request-ID", "abc")
+	req.Header.Set("Cookie", "session=s3cr3t")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if method != "PUT" {
+		t.Errorf("expected method PUT, got %s", method)
+	}
+	if path != "/users/42" {
+		t.Errorf("expected path /users/42, got %s", path)
+	}
+	if header != "abc" {
+		t.Errorf("expected header abc, got %s", header)
+	}
+	if !hasCookie || cookie != "s3cr3t" {
+		t.Errorf("expected cookie s3cr3t, got %q (found %v)", cookie, hasCookie)
+	}
+	if hasMissing {
+		t.Error("expected missing cookie not to be found")
+	}
+	if got != "from request" {
+		t.Errorf("expected request context value, got %v", got)
+	}
+}
+
+func TestGinContext_Get(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	setValue := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			ctx.Set("request_id", "abc")
+			return next(ctx)
+		}
+	}
+
+	var value, missing any
+	var found, foundMissing bool
+	router := gin.New()
+	router.Use(wrapMiddleware(setValue))
+	router.GET("/test", wrapHandler(func(ctx http.Context) error {
+		value, found = ctx.Get("request_id")
+		missing, foundMissing = ctx.Get("missing")
+		ctx.Status(200)
+		return nil
+	}))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+
+	if !found || value != "abc" {
+		t.Errorf("expected value abc, got %v (found %v)", value, found)
+	}
+	if foundMissing || missing != nil {
+		t.Errorf("expected missing key not to be found, got %v", missing)
+	}
+}
+
+func TestGinContext_Body(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var raw []byte
+	var input struct {
+		Name string `json:"name"`
+	}
+	router := gin.New()
+	router.POST("/test", wrapHandler(func(ctx http.Context) error {
+		var err error
+		if raw, err = ctx.Body(); err != nil {
+			return err
+		}
+		if err := ctx.Bind(&input); err != nil {
+			return err
+		}
+		ctx.Status(200)
+		return nil
+	}))
+
+	body := `{"name":"test"}`
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/test", bytes.NewBufferString(body)))
+
+	if w.Code != 200 {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if string(raw) != body {
+		t.Errorf("expected raw body %s, got %s", body, raw)
+	}
+	if input.Name != "test" {
+		t.Errorf("expected body to be bound after reading it, got name %q", input.Name)
+	}
+}
@@ -0,0 +1,58 @@
+package adapter
+
+import (
+	"encoding/json"
+	"log/slog"
+
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/i18n"
+	http "scheduling/internal/infra/gin"
+
+	"github.com/gin-gonic/gin"
+)
+
+// handleError é o tratamento central dos erros devolvidos por handlers e
+// middlewares. Se a resposta já foi escrita o erro é só registrado.
+func handleError(c *gin.Context, err error) {
+	_ = c.Error(err)
+	if c.Writer.Written() {
+		return
+	}
+
+	if errs.KindOf(err) == errs.KindInternal {
+		slog.ErrorContext(
+			c.Request.Context(),
+			"Erro não tratado na requisição",
+			"error", err.Error(),
+			"method", c.Request.Method,
+			"path", c.FullPath(),
+			"operation", "http.handle_error",
+		)
+	}
+
+	writeProblem(c, 0, err)
+	c.Abort()
+}
+
+func writeProblem(c *gin.Context, status int, err error) {
+	problem := http.NewProblem(status, err, c.GetString(http.TraceIDKey), requestLocale(c))
+	if problem.Status == 401 {
+		c.Header("WWW-Authenticate", "Bearer")
+	}
+
+	body, marshalErr := json.Marshal(problem)
+	if marshalErr != nil {
+		c.Status(problem.Status)
+		return
+	}
+	c.Data(problem.Status, http.ProblemContentType, body)
+}
+
+// requestLocale é o idioma definido pelo middleware de idioma ou, nas rotas
+// sem ele, o negociado pelo Accept-Language.
+func requestLocale(c *gin.Context) i18n.Locale {
+	if locale, ok := i18n.LocaleFrom(c.Request.Context()); ok {
+		return locale
+	}
+	return i18n.Negotiate(c.GetHeader("Accept-Language"))
+}
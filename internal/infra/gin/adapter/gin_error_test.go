@@ -0,0 +1,228 @@
+package adapter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"scheduling/internal/domain/errs"
+	http "scheduling/internal/infra/gin"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestWrapHandler_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		body       string
+		language   string
+		wantStatus int
+		wantType   string
+		wantCode   string
+		wantDetail string
+		wantFields []http.FieldError
+		wantAuth   bool
+	}{
+		{
+			name:       "validation error",
+			handler:    func(ctx http.Context) error { return errs.Validation("user.name_required") },
+			wantStatus: 400,
+			wantType:   "validation",
+			wantCode:   "user.name_required",
+			wantDetail: "nome é obrigatório",
+		},
+		{
+			name:       "validation error in english",
+			handler:    func(ctx http.Context) error { return errs.Validation("user.name_required") },
+			language:   "en-US,en;q=0.9",
+			wantStatus: 400,
+			wantType:   "validation",
+			wantCode:   "user.name_required",
+			wantDetail: "name is required",
+		},
+		{
+			name: "validation error with fields",
+			handler: func(ctx http.Context) error {
+				return errs.Invalid("request.invalid_body", errs.FieldError{Field: "name", Code: "user.name_required"})
+			},
+			language:   "en",
+			wantStatus: 400,
+			wantType:   "validation",
+			wantCode:   "request.invalid_body",
+			wantDetail: "invalid request body",
+			wantFields: []http.FieldError{{Field: "name", Code: "user.name_required", Message: "name is required"}},
+		},
+		{
+			name:       "not found error",
+			handler:    func(ctx http.Context) error { return errs.NotFound("user.not_found") },
+			wantStatus: 404,
+			wantType:   "not_found",
+			wantCode:   "user.not_found",
+			wantDetail: "usuário não encontrado",
+		},
+		{
+			name: "wrapped conflict error with params",
+			handler: func(ctx http.Context) error {
+				return fmt.Errorf("ao cadastrar: %w", errs.Conflict("user.email_taken").With("email", "ana@gmail.com"))
+			},
+			language:   "en",
+			wantStatus: 409,
+			wantType:   "conflict",
+			wantCode:   "user.email_taken",
+			wantDetail: "email already exists: ana@gmail.com",
+		},
+		{
+			name:       "forbidden error",
+			handler:    func(ctx http.Context) error { return errs.Forbidden("auth.forbidden") },
+			wantStatus: 403,
+			wantType:   "forbidden",
+			wantCode:   "auth.forbidden",
+			wantDetail: "acesso negado",
+		},
+		{
+			name:       "unauthorized error",
+			handler:    func(ctx http.Context) error { return errs.Unauthorized("auth.invalid_token") },
+			wantStatus: 401,
+			wantType:   "unauthorized",
+			wantCode:   "auth.invalid_token",
+			wantDetail: "token inválido",
+			wantAuth:   true,
+		},
+		{
+			name:       "rate limited error",
+			handler:    func(ctx http.Context) error { return errs.RateLimited("auth.too_many_login_attempts") },
+			wantStatus: 429,
+			wantType:   "rate_limited",
+			wantCode:   "auth.too_many_login_attempts",
+		},
+		{
+			name:       "unprocessable error",
+			handler:    func(ctx http.Context) error { return errs.Unprocessable("booking.beyond_horizon") },
+			language:   "en",
+			wantStatus: 422,
+			wantType:   "unprocessable",
+			wantCode:   "booking.beyond_horizon",
+			wantDetail: "date is beyond the booking limit",
+		},
+		{
+			name:       "unclassified error hides its message",
+			handler:    func(ctx http.Context) error { return errors.New("dial tcp: connection refused") },
+			language:   "en",
+			wantStatus: 500,
+			wantType:   "internal_server_error",
+			wantCode:   "internal_server_error",
+			wantDetail: "internal error",
+		},
+		{
+			name: "bind error",
+			handler: func(ctx http.Context) error {
+				var input struct{ Name string }
+				return ctx.Bind(&input)
+			},
+			body:       `{"name":`,
+			wantStatus: 400,
+			wantType:   "validation",
+			wantCode:   "request.invalid_body",
+		},
+		{
+			name: "explicit status",
+			handler: func(ctx http.Context) error {
+				return ctx.Problem(429, errors.New("muitas tentativas"))
+			},
+			wantStatus: 429,
+			wantType:   "too_many_requests",
+			wantCode:   "too_many_requests",
+			wantDetail: "muitas tentativas",
+		},
+		{
+			name: "response already written",
+			handler: func(ctx http.Context) error {
+				_ = ctx.Problem(422, errs.Validation("booking.beyond_horizon"))
+				return errs.Validation("user.name_required")
+			},
+			wantStatus: 422,
+			wantType:   "validation",
+			wantCode:   "booking.beyond_horizon",
+			wantDetail: "data além do limite permitido para agendamentos",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(func(c *gin.Context) { c.Set(http.TraceIDKey, "trace-123") })
+			router.POST("/test", wrapHandler(tt.handler))
+
+			req := httptest.NewRequest("POST", "/test", strings.NewReader(tt.body))
+			if tt.language != "" {
+				req.Header.Set("Accept-Language", tt.language)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != http.ProblemContentType {
+				t.Errorf("expected content type %s, got %s", http.ProblemContentType, contentType)
+			}
+
+			var problem http.Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+			}
+			if problem.Status != tt.wantStatus || problem.Code != tt.wantCode || problem.Type != "/problems/"+tt.wantType {
+				t.Errorf("unexpected problem: %+v", problem)
+			}
+			if problem.Title == "" || problem.Instance != "trace-123" {
+				t.Errorf("expected title and trace ID as instance, got %+v", problem)
+			}
+			if tt.wantDetail != "" && problem.Detail != tt.wantDetail {
+				t.Errorf("expected detail %q, got %q", tt.wantDetail, problem.Detail)
+			}
+			if !reflect.DeepEqual(problem.Errors, tt.wantFields) {
+				t.Errorf("expected field errors %v, got %v", tt.wantFields, problem.Errors)
+			}
+
+			if got := w.Header().Get("WWW-Authenticate") != ""; got != tt.wantAuth {
+				t.Errorf("expected WWW-Authenticate present = %v, got %v", tt.wantAuth, got)
+			}
+		})
+	}
+}
+
+func TestWrapMiddleware_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handlerCalled := false
+	reject := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			return errs.Forbidden("auth.forbidden")
+		}
+	}
+
+	router := gin.New()
+	router.Use(wrapMiddleware(reject))
+	router.GET("/test", func(c *gin.Context) {
+		handlerCalled = true
+		c.Status(200)
+	})
+
+	req := httptest.NewRequest("GET", "/test", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	if handlerCalled {
+		t.Error("handler should not be called when middleware returns an error")
+	}
+	if w.Code != 403 {
+		t.Errorf("expected status 403, got %d", w.Code)
+	}
+}
@@ -2,6 +2,9 @@ package adapter
 
 import (
 	"fmt"
+	"io/fs"
+	"log"
+	nethttp "net/http"
 
 	http "scheduling/internal/infra/gin"
 
@@ -9,50 +12,108 @@ import (
 )
 
 type ginRouter struct {
-	group gin.IRoutes
-	root  *gin.Engine
+	group       *gin.RouterGroup
+	root        *gin.Engine
+	middlewares []http.MiddlewareFunc
+	routes      *[]http.RouteInfo
 }
 
 func NewRouter() http.Router {
 	engine := gin.Default()
+	// 405 com o header Allow, como no adaptador de net/http, em vez de 404
+	engine.HandleMethodNotAllowed = true
+
+	// sem TRUSTED_PROXIES o IP do cliente é o da conexão; aceitar
+	// X-Forwarded-For de qualquer origem permitiria burlar o limite de
+	// tentativas de login por IP
+	if err := engine.SetTrustedProxies(http.TrustedProxies()); err != nil {
+		log.Fatalf("TRUSTED_PROXIES inválido: %v", err)
+	}
+
 	return &ginRouter{
-		group: engine,
-		root:  engine,
+		group:  &engine.RouterGroup,
+		root:   engine,
+		routes: &[]http.RouteInfo{},
 	}
 }
 
 func (r *ginRouter) GET(path string, handler http.HandlerFunc) {
-	r.group.GET(path, wrapHandler(handler))
+	r.handle(nethttp.MethodGet, path, handler)
 }
 
 func (r *ginRouter) POST(path string, handler http.HandlerFunc) {
-	r.group.POST(path, wrapHandler(handler))
+	r.handle(nethttp.MethodPost, path, handler)
 }
 
 func (r *ginRouter) PUT(path string, handler http.HandlerFunc) {
-	r.group.PUT(path, wrapHandler(handler))
+	r.handle(nethttp.MethodPut, path, handler)
 }
 
 func (r *ginRouter) DELETE(path string, handler http.HandlerFunc) {
-	r.group.DELETE(path, wrapHandler(handler))
+	r.handle(nethttp.MethodDelete, path, handler)
+}
+
+func (r *ginRouter) PATCH(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodPatch, path, handler)
+}
+
+func (r *ginRouter) HEAD(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodHead, path, handler)
+}
+
+func (r *ginRouter) OPTIONS(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodOptions, path, handler)
+}
+
+func (r *ginRouter) Any(path string, handler http.HandlerFunc) {
+	r.group.Any(path, wrapHandler(handler))
+	r.addRoute(http.MethodAny, path, handler)
+}
+
+func (r *ginRouter) Static(path string, fsys fs.FS) {
+	handler := staticHandler(fsys)
+	r.GET(path+"/*filepath", handler)
+	r.HEAD(path+"/*filepath", handler)
 }
 
 func (r *ginRouter) Use(middlewares ...http.MiddlewareFunc) {
 	for _, m := range middlewares {
-		r.group.Use(wrapMiddleware(m))
+		// só o Use do engine chega também às respostas 404 e 405
+		if r.group == &r.root.RouterGroup {
+			r.root.Use(wrapMiddleware(m))
+		} else {
+			r.group.Use(wrapMiddleware(m))
+		}
 	}
+	r.middlewares = append(r.middlewares, middlewares...)
 }
 
-func (r *ginRouter) Group(path string) http.Router {
-	if group, ok := r.group.(*gin.Engine); ok {
-		newGroup := group.Group(path)
-		return &ginRouter{group: newGroup, root: r.root}
-	}
-	if group, ok := r.group.(*gin.RouterGroup); ok {
-		newGroup := group.Group(path)
-		return &ginRouter{group: newGroup, root: r.root}
+func (r *ginRouter) NoRoute(handler http.HandlerFunc) {
+	r.root.NoRoute(wrapHandler(handler))
+}
+
+func (r *ginRouter) NoMethod(handler http.HandlerFunc) {
+	r.root.NoMethod(wrapHandler(handler))
+}
+
+func (r *ginRouter) Routes() []http.RouteInfo {
+	return append([]http.RouteInfo(nil), *r.routes...)
+}
+
+func (r *ginRouter) Group(path string, middlewares ...http.MiddlewareFunc) http.Router {
+	group := &ginRouter{
+		group:  r.group.Group(path),
+		root:   r.root,
+		routes: r.routes,
+		// cópia para que o Use do grupo não altere o pai
+		middlewares: append([]http.MiddlewareFunc(nil), r.middlewares...),
 	}
-	panic("unsupported gin.IRoutes type in Group()")
+	group.Use(middlewares...)
+	return group
+}
+
+func (r *ginRouter) ServeHTTP(w nethttp.ResponseWriter, req *nethttp.Request) {
+	r.root.ServeHTTP(w, req)
 }
 
 func (r *ginRouter) Run(addr string) error {
@@ -61,3 +122,25 @@ func (r *ginRouter) Run(addr string) error {
 	}
 	return fmt.Errorf("no root engine to run")
 }
+
+func (r *ginRouter) handle(method, path string, handler http.HandlerFunc) {
+	r.group.Handle(method, path, wrapHandler(handler))
+	r.addRoute(method, path, handler)
+}
+
+func (r *ginRouter) addRoute(method, path string, handler http.HandlerFunc) {
+	fullPath := http.JoinPaths(r.group.BasePath(), path)
+	*r.routes = append(*r.routes, http.NewRouteInfo(method, fullPath, handler, r.middlewares))
+}
+
+// staticHandler serve o arquivo do curinga filepath da rota de Static.
+func staticHandler(fsys fs.FS) http.HandlerFunc {
+	files := http.StaticFiles(fsys)
+	return func(ctx http.Context) error {
+		g := ctx.(*GinContext)
+		req := g.ctx.Request.Clone(g.ctx.Request.Context())
+		req.URL.Path = ctx.Param("filepath")
+		files.ServeHTTP(g.ctx.Writer, req)
+		return nil
+	}
+}
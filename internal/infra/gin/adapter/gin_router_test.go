@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	http "scheduling/internal/infra/gin"
+	"scheduling/internal/infra/gin/routertest"
 
 	"github.com/gin-gonic/gin"
 )
@@ -505,4 +506,10 @@ func TestGinRouter_ComplexRouting(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestGinRouter_Conformance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	routertest.Run(t, NewRouter)
+}
@@ -0,0 +1,175 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/i18n"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Códigos dos erros de entrada. Os problemas de cada campo usam
+// "validation.<regra>", ex.: validation.required.
+const (
+	invalidBodyCode   = "request.invalid_body"
+	invalidFieldsCode = "request.invalid_fields"
+	typeErrorCode     = "validation.type"
+	unknownFieldCode  = "validation.unknown_field"
+	invalidFieldCode  = "validation.invalid"
+)
+
+var (
+	validate     *validator.Validate
+	validateOnce sync.Once
+)
+
+// DecodeJSON lê o corpo JSON em obj e aplica as regras declaradas nas tags
+// validate do DTO (ex.: `validate:"required,email"`). Em modo estrito campos
+// desconhecidos são recusados. Os erros de tipo, de campo desconhecido e de
+// validação voltam juntos como errs.Invalid, com um problema por campo
+// identificado pelo nome no JSON.
+func DecodeJSON(body io.Reader, obj any, strict bool) error {
+	if body == nil {
+		return errs.Validation(invalidBodyCode)
+	}
+
+	decoder := json.NewDecoder(body)
+	if strict {
+		decoder.DisallowUnknownFields()
+	}
+
+	if err := decoder.Decode(obj); err != nil {
+		return decodeError(err)
+	}
+	return Validate(obj)
+}
+
+// Validate aplica as regras das tags validate em obj.
+func Validate(obj any) error {
+	validateOnce.Do(func() {
+		validate = validator.New(validator.WithRequiredStructEnabled())
+		validate.RegisterTagNameFunc(jsonName)
+	})
+
+	err := validate.Struct(obj)
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		// obj não é uma struct (ex.: mapa) ou não tem regras
+		return nil
+	}
+
+	fields := make([]errs.FieldError, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		fields = append(fields, ruleError(obj, fieldErr))
+	}
+	return errs.Invalid(invalidFieldsCode, fields...)
+}
+
+func decodeError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		return errs.Invalid(invalidFieldsCode, errs.FieldError{
+			Field:  typeErr.Field,
+			Code:   typeErrorCode,
+			Params: i18n.Params{"type": jsonType(typeErr.Type)},
+		})
+	}
+
+	// o pacote json não exporta um tipo para campos desconhecidos
+	if field, ok := strings.CutPrefix(err.Error(), "json: unknown field "); ok {
+		return errs.Invalid(invalidFieldsCode, errs.FieldError{
+			Field: strings.Trim(field, `"`),
+			Code:  unknownFieldCode,
+		})
+	}
+
+	return errs.Wrap(errs.KindValidation, invalidBodyCode, err)
+}
+
+// ruleError converte a regra violada em um problema do campo. As regras de
+// tamanho têm mensagens diferentes para textos, listas e números.
+func ruleError(obj any, fieldErr validator.FieldError) errs.FieldError {
+	field := fieldErr.Namespace()
+	if _, path, ok := strings.Cut(field, "."); ok {
+		field = path
+	}
+
+	code := "validation." + fieldErr.Tag()
+	switch fieldErr.Tag() {
+	case "min", "max", "len":
+		switch fieldErr.Kind() {
+		case reflect.String:
+			code += "_length"
+		case reflect.Slice, reflect.Array, reflect.Map:
+			code += "_items"
+		}
+	case "oneof":
+		return errs.FieldError{Field: field, Code: code, Params: i18n.Params{
+			"param": strings.Join(strings.Fields(fieldErr.Param()), ", "),
+		}}
+	case "required_without", "required_with":
+		return errs.FieldError{Field: field, Code: code, Params: i18n.Params{
+			"param": siblingName(obj, fieldErr),
+		}}
+	}
+
+	if !i18n.Has(code) {
+		code = invalidFieldCode
+	}
+	return errs.FieldError{Field: field, Code: code, Params: i18n.Params{"param": fieldErr.Param()}}
+}
+
+// jsonName usa o nome do campo no JSON nos caminhos dos erros.
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	}
+	return name
+}
+
+// siblingName traduz o campo citado em regras como required_without, que
+// vem com o nome da struct, para o nome no JSON.
+func siblingName(obj any, fieldErr validator.FieldError) string {
+	parent := reflect.TypeOf(obj)
+	for parent.Kind() == reflect.Pointer {
+		parent = parent.Elem()
+	}
+
+	// só os campos do primeiro nível; nos aninhados o nome fica como está
+	if strings.Count(fieldErr.StructNamespace(), ".") == 1 && parent.Kind() == reflect.Struct {
+		if sibling, ok := parent.FieldByName(fieldErr.Param()); ok {
+			return jsonName(sibling)
+		}
+	}
+	return fieldErr.Param()
+}
+
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Pointer:
+		return jsonType(t.Elem())
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	}
+	return t.String()
+}
@@ -1,24 +1,73 @@
 package http
 
+import (
+	"context"
+	"io/fs"
+	"net/http"
+)
+
 type Context interface {
+	// Context é o context.Context da requisição, com cancelamento, prazo e os
+	// valores gravados pelos middlewares (tenant, principal, idioma).
+	Context() context.Context
+	SetContext(ctx context.Context)
+	GetHeader(key string) string
+	Cookie(name string) (string, bool)
+	Host() string
+	ClientIP() string
+	Method() string
+	// Path é o caminho requisitado, sem a query string.
+	Path() string
 	Param(name string) string
 	Query(name string) string
+	// Body devolve o corpo bruto, ex.: para conferir a assinatura de um
+	// webhook. O corpo continua disponível para Bind depois da leitura.
+	Body() ([]byte, error)
+	// Bind lê o corpo JSON no DTO e aplica as regras das tags validate (ver
+	// DecodeJSON). BindStrict também recusa campos desconhecidos.
 	Bind(obj any) error
+	BindStrict(obj any) error
 	JSON(status int, obj any) error
+	// Problem responde com err no formato problem+json (RFC 7807). Com status
+	// zero o status vem do tipo do erro.
+	Problem(status int, err error) error
 	Status(code int)
 	Header(key, value string)
 	Set(key string, value any)
+	Get(key string) (any, bool)
 }
 
+// Router registra as rotas no formato do Gin (/users/:id, /files/*path) em
+// qualquer um dos adaptadores. Também é o http.Handler da aplicação.
 type Router interface {
 	GET(path string, handler HandlerFunc)
 	POST(path string, handler HandlerFunc)
 	PUT(path string, handler HandlerFunc)
 	DELETE(path string, handler HandlerFunc)
+	PATCH(path string, handler HandlerFunc)
+	HEAD(path string, handler HandlerFunc)
+	OPTIONS(path string, handler HandlerFunc)
+	// Any atende a rota em qualquer método.
+	Any(path string, handler HandlerFunc)
+	// Static serve os arquivos de fsys (os.DirFS, embed.FS) abaixo de path.
+	// Diretórios sem index.html não são listados.
+	Static(path string, fsys fs.FS)
+	// Use vale para as rotas registradas depois dele; no roteador raiz vale
+	// também para NoRoute e NoMethod.
 	Use(middleware ...MiddlewareFunc)
+	// NoRoute e NoMethod respondem quando nenhuma rota casa com o caminho ou
+	// quando o caminho existe só em outros métodos (405, com o header Allow).
+	NoRoute(handler HandlerFunc)
+	NoMethod(handler HandlerFunc)
+	// Routes lista todas as rotas registradas, de qualquer grupo, na ordem
+	// de registro.
+	Routes() []RouteInfo
 	Run(addr string) error
-	Group(path string) Router
+	Group(path string, middleware ...MiddlewareFunc) Router
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
 }
 
+// HandlerFunc pode responder por conta própria ou devolver um erro, que o
+// adaptador converte na resposta conforme o tipo (errs.Kind) do erro.
 type HandlerFunc func(Context) error
 type MiddlewareFunc func(HandlerFunc) HandlerFunc
@@ -0,0 +1,117 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/i18n"
+)
+
+const (
+	ProblemContentType = "application/problem+json"
+	// TraceIDKey é a chave do ID de rastreio entre os valores da requisição.
+	// Vira o instance dos problemas.
+	TraceIDKey = "trace_id"
+	// problemTypeBase prefixa a categoria do erro no type do problema. É uma
+	// referência relativa, como o RFC 7807 permite.
+	problemTypeBase = "/problems/"
+	// internalErrorCode substitui o código e a mensagem dos erros não
+	// classificados, que podem expor detalhes do banco ou da infraestrutura.
+	internalErrorCode = "internal"
+)
+
+// Problem é o corpo de todas as respostas de erro, no formato do RFC 7807
+// (application/problem+json). Type indica a categoria do erro e Code o erro
+// exato, estável entre idiomas; Detail vem traduzido. Errors traz os
+// problemas de cada campo nos erros de validação.
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"`
+	Code     string       `json:"code"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewProblem descreve err no idioma informado. Com status zero o status vem
+// do tipo do erro (errs.Kind); instance é o ID de rastreio da requisição.
+func NewProblem(status int, err error, instance string, locale i18n.Locale) Problem {
+	kind := errs.KindOf(err)
+	if status == 0 {
+		status = StatusFor(kind)
+	}
+
+	category := kind.String()
+	if kind == errs.KindInternal {
+		category = statusCode(status)
+	}
+
+	code := errs.CodeOf(err)
+	if code == "" {
+		code = category
+	}
+	detail := errs.Message(err, locale)
+	if kind == errs.KindInternal && status >= http.StatusInternalServerError {
+		code = category
+		detail = i18n.Translate(locale, internalErrorCode, nil)
+	}
+
+	var fields []FieldError
+	for _, field := range errs.FieldsOf(err) {
+		fields = append(fields, FieldError{
+			Field:   field.Field,
+			Code:    field.Code,
+			Message: i18n.Translate(locale, field.Code, field.Params),
+		})
+	}
+
+	return Problem{
+		Type:     problemTypeBase + category,
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   detail,
+		Instance: instance,
+		Code:     code,
+		Errors:   fields,
+	}
+}
+
+func StatusFor(kind errs.Kind) int {
+	switch kind {
+	case errs.KindValidation:
+		return http.StatusBadRequest
+	case errs.KindNotFound:
+		return http.StatusNotFound
+	case errs.KindConflict:
+		return http.StatusConflict
+	case errs.KindForbidden:
+		return http.StatusForbidden
+	case errs.KindUnauthorized:
+		return http.StatusUnauthorized
+	case errs.KindRateLimited:
+		return http.StatusTooManyRequests
+	case errs.KindUnprocessable:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// statusCode gera o código dos erros não classificados a partir do status,
+// ex.: 429 vira too_many_requests.
+func statusCode(status int) string {
+	text := http.StatusText(status)
+	if text == "" {
+		return internalErrorCode
+	}
+	text = strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(text)
+	return strings.ToLower(text)
+}
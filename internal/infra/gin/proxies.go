@@ -0,0 +1,18 @@
+package http
+
+import (
+	"os"
+	"strings"
+)
+
+// TrustedProxies lê os IPs ou CIDRs separados por vírgula dos proxies que
+// podem informar o IP do cliente em X-Forwarded-For.
+func TrustedProxies() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
@@ -0,0 +1,763 @@
+// Package routertest reúne os testes que todo adaptador das portas de
+// internal/infra/gin precisa passar, para que os adaptadores sejam
+// intercambiáveis.
+package routertest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"scheduling/internal/domain/errs"
+	http "scheduling/internal/infra/gin"
+)
+
+// Run roda a suíte contra os roteadores criados por newRouter. Cada teste
+// usa um roteador novo.
+func Run(t *testing.T, newRouter func() http.Router) {
+	t.Run("methods and params", func(t *testing.T) { testMethods(t, newRouter) })
+	t.Run("query", func(t *testing.T) { testQuery(t, newRouter) })
+	t.Run("routes", func(t *testing.T) { testRoutes(t, newRouter) })
+	t.Run("groups and middleware", func(t *testing.T) { testGroups(t, newRouter) })
+	t.Run("middleware short circuit", func(t *testing.T) { testShortCircuit(t, newRouter) })
+	t.Run("errors", func(t *testing.T) { testErrors(t, newRouter) })
+	t.Run("bind", func(t *testing.T) { testBind(t, newRouter) })
+	t.Run("response", func(t *testing.T) { testResponse(t, newRouter) })
+	t.Run("request", func(t *testing.T) { testRequest(t, newRouter) })
+	t.Run("values", func(t *testing.T) { testValues(t, newRouter) })
+	t.Run("more methods", func(t *testing.T) { testMoreMethods(t, newRouter) })
+	t.Run("group middleware", func(t *testing.T) { testGroupMiddleware(t, newRouter) })
+	t.Run("static files", func(t *testing.T) { testStatic(t, newRouter) })
+	t.Run("no route and no method", func(t *testing.T) { testNoRoute(t, newRouter) })
+	t.Run("route table", func(t *testing.T) { testRoutesTable(t, newRouter) })
+}
+
+type ctxKey struct{}
+
+func serve(router http.Router, method, url, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(method, url, strings.NewReader(body)))
+	return w
+}
+
+func testMethods(t *testing.T, newRouter func() http.Router) {
+	tests := []struct {
+		method string
+		path   string
+		url    string
+	}{
+		{"GET", "/test", "/test"},
+		{"POST", "/test", "/test"},
+		{"PUT", "/users/:id", "/users/42"},
+		{"DELETE", "/users/:id/roles/:role", "/users/42/roles/admin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			router := newRouter()
+			handler := func(ctx http.Context) error {
+				return ctx.JSON(200, map[string]string{
+					"method": tt.method,
+					"id":     ctx.Param("id"),
+					"role":   ctx.Param("role"),
+				})
+			}
+
+			register := map[string]func(string, http.HandlerFunc){
+				"GET": router.GET, "POST": router.POST, "PUT": router.PUT, "DELETE": router.DELETE,
+			}
+			register[tt.method](tt.path, handler)
+
+			w := serve(router, tt.method, tt.url, "")
+			if w.Code != 200 {
+				t.Fatalf("expected status 200, got %d", w.Code)
+			}
+
+			var response map[string]string
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if response["method"] != tt.method {
+				t.Errorf("expected method %s, got %s", tt.method, response["method"])
+			}
+			if strings.Contains(tt.path, ":id") && response["id"] != "42" {
+				t.Errorf("expected id 42, got %q", response["id"])
+			}
+			if strings.Contains(tt.path, ":role") && response["role"] != "admin" {
+				t.Errorf("expected role admin, got %q", response["role"])
+			}
+		})
+	}
+}
+
+func testQuery(t *testing.T, newRouter func() http.Router) {
+	router := newRouter()
+	var page, missing string
+	router.GET("/items", func(ctx http.Context) error {
+		page = ctx.Query("page")
+		missing = ctx.Query("missing")
+		ctx.Status(200)
+		return nil
+	})
+
+	serve(router, "GET", "/items?page=2&sort=name", "")
+
+	if page != "2" {
+		t.Errorf("expected page 2, got %q", page)
+	}
+	if missing != "" {
+		t.Errorf("expected empty missing query, got %q", missing)
+	}
+}
+
+func testRoutes(t *testing.T, newRouter func() http.Router) {
+	router := newRouter()
+	router.GET("/", func(ctx http.Context) error { return ctx.JSON(200, "root") })
+	router.GET("/files/*path", func(ctx http.Context) error { return ctx.JSON(200, ctx.Param("path")) })
+
+	tests := []struct {
+		url        string
+		wantStatus int
+		wantBody   string
+	}{
+		{"/", 200, `"root"`},
+		{"/files/docs/a.txt", 200, `"/docs/a.txt"`},
+		{"/unknown", 404, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			w := serve(router, "GET", tt.url, "")
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("expected body %s, got %s", tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func testGroups(t *testing.T, newRouter func() http.Router) {
+	var calls []string
+	record := func(name string) http.MiddlewareFunc {
+		return func(next http.HandlerFunc) http.HandlerFunc {
+			return func(ctx http.Context) error {
+				calls = append(calls, name)
+				return next(ctx)
+			}
+		}
+	}
+	handler := func(ctx http.Context) error {
+		calls = append(calls, "handler:"+ctx.Param("id"))
+		ctx.Status(200)
+		return nil
+	}
+
+	router := newRouter()
+	router.Use(record("global"))
+	router.GET("/public", handler)
+
+	api := router.Group("/api")
+	api.Use(record("api"))
+	users := api.Group("/users/:id")
+	users.Use(record("users"))
+	users.GET("/profile", handler)
+
+	// o middleware do grupo filho não vaza para o pai
+	api.GET("/status", handler)
+
+	tests := []struct {
+		url       string
+		wantCalls []string
+	}{
+		{"/public", []string{"global", "handler:"}},
+		{"/api/users/7/profile", []string{"global", "api", "users", "handler:7"}},
+		{"/api/status", []string{"global", "api", "handler:"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			calls = nil
+			w := serve(router, "GET", tt.url, "")
+			if w.Code != 200 {
+				t.Fatalf("expected status 200, got %d", w.Code)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("expected calls %v, got %v", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func testShortCircuit(t *testing.T, newRouter func() http.Router) {
+	tests := []struct {
+		name       string
+		middleware http.MiddlewareFunc
+		wantStatus int
+	}{
+		{
+			name: "responds without calling next",
+			middleware: func(next http.HandlerFunc) http.HandlerFunc {
+				return func(ctx http.Context) error { return ctx.JSON(401, map[string]string{"error": "unauthorized"}) }
+			},
+			wantStatus: 401,
+		},
+		{
+			name: "returns an error",
+			middleware: func(next http.HandlerFunc) http.HandlerFunc {
+				return func(ctx http.Context) error { return errs.Forbidden("auth.forbidden") }
+			},
+			wantStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handlerCalled := false
+			router := newRouter()
+			router.Use(tt.middleware)
+			router.GET("/test", func(ctx http.Context) error {
+				handlerCalled = true
+				return ctx.JSON(200, "ok")
+			})
+
+			w := serve(router, "GET", "/test", "")
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if handlerCalled {
+				t.Error("expected handler not to be called")
+			}
+		})
+	}
+}
+
+func testErrors(t *testing.T, newRouter func() http.Router) {
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		language   string
+		wantStatus int
+		wantCode   string
+		wantDetail string
+		wantAuth   bool
+	}{
+		{
+			name:       "domain error",
+			handler:    func(ctx http.Context) error { return errs.NotFound("user.not_found") },
+			wantStatus: 404,
+			wantCode:   "user.not_found",
+			wantDetail: "usuário não encontrado",
+		},
+		{
+			name:       "localized domain error",
+			handler:    func(ctx http.Context) error { return errs.Validation("user.name_required") },
+			language:   "en",
+			wantStatus: 400,
+			wantCode:   "user.name_required",
+			wantDetail: "name is required",
+		},
+		{
+			name:       "unauthorized error",
+			handler:    func(ctx http.Context) error { return errs.Unauthorized("auth.invalid_token") },
+			wantStatus: 401,
+			wantCode:   "auth.invalid_token",
+			wantAuth:   true,
+		},
+		{
+			name:       "unclassified error",
+			handler:    func(ctx http.Context) error { return errors.New("dial tcp: connection refused") },
+			language:   "en",
+			wantStatus: 500,
+			wantCode:   "internal_server_error",
+			wantDetail: "internal error",
+		},
+		{
+			name:       "explicit status",
+			handler:    func(ctx http.Context) error { return ctx.Problem(502, errors.New("provider down")) },
+			wantStatus: 502,
+			wantCode:   "bad_gateway",
+		},
+		{
+			name: "response already written",
+			handler: func(ctx http.Context) error {
+				_ = ctx.JSON(202, "accepted")
+				return errs.Validation("user.name_required")
+			},
+			wantStatus: 202,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newRouter()
+			router.Use(func(next http.HandlerFunc) http.HandlerFunc {
+				return func(ctx http.Context) error {
+					ctx.Set(http.TraceIDKey, "trace-123")
+					return next(ctx)
+				}
+			})
+			router.GET("/test", tt.handler)
+
+			req := httptest.NewRequest("GET", "/test", nil)
+			if tt.language != "" {
+				req.Header.Set("Accept-Language", tt.language)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+			if tt.wantCode == "" {
+				return
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != http.ProblemContentType {
+				t.Errorf("expected content type %s, got %s", http.ProblemContentType, contentType)
+			}
+
+			var problem http.Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+			}
+			if problem.Status != tt.wantStatus || problem.Code != tt.wantCode || problem.Instance != "trace-123" {
+				t.Errorf("unexpected problem: %+v", problem)
+			}
+			if tt.wantDetail != "" && problem.Detail != tt.wantDetail {
+				t.Errorf("expected detail %q, got %q", tt.wantDetail, problem.Detail)
+			}
+			if got := w.Header().Get("WWW-Authenticate") != ""; got != tt.wantAuth {
+				t.Errorf("expected WWW-Authenticate present = %v, got %v", tt.wantAuth, got)
+			}
+		})
+	}
+}
+
+func testBind(t *testing.T, newRouter func() http.Router) {
+	type Input struct {
+		Name  string `json:"name" validate:"required"`
+		Email string `json:"email" validate:"omitempty,email"`
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		strict     bool
+		wantStatus int
+		wantFields []http.FieldError
+	}{
+		{
+			name:       "valid body",
+			body:       `{"name":"Ana","extra":true}`,
+			wantStatus: 200,
+		},
+		{
+			name:       "rule violations",
+			body:       `{"email":"invalid"}`,
+			wantStatus: 400,
+			wantFields: []http.FieldError{
+				{Field: "name", Code: "validation.required", Message: "is required"},
+				{Field: "email", Code: "validation.email", Message: "must be a valid email"},
+			},
+		},
+		{
+			name:       "strict mode",
+			body:       `{"name":"Ana","extra":true}`,
+			strict:     true,
+			wantStatus: 400,
+			wantFields: []http.FieldError{{Field: "extra", Code: "validation.unknown_field", Message: "unknown field"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newRouter()
+			router.POST("/test", func(ctx http.Context) error {
+				var input Input
+				bind := ctx.Bind
+				if tt.strict {
+					bind = ctx.BindStrict
+				}
+				if err := bind(&input); err != nil {
+					return err
+				}
+				return ctx.JSON(200, input)
+			})
+
+			req := httptest.NewRequest("POST", "/test", strings.NewReader(tt.body))
+			req.Header.Set("Accept-Language", "en")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantStatus == 200 {
+				return
+			}
+
+			var problem http.Problem
+			if err := json.Unmarshal(w.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal response %q: %v", w.Body.String(), err)
+			}
+			if !reflect.DeepEqual(problem.Errors, tt.wantFields) {
+				t.Errorf("expected field errors %+v, got %+v", tt.wantFields, problem.Errors)
+			}
+		})
+	}
+}
+
+func testResponse(t *testing.T, newRouter func() http.Router) {
+	router := newRouter()
+	router.GET("/json", func(ctx http.Context) error {
+		ctx.Header("X-Custom", "value")
+		return ctx.JSON(201, map[string]int{"id": 1})
+	})
+	router.GET("/redirect", func(ctx http.Context) error {
+		ctx.Status(302)
+		ctx.Header("Location", "https://example.com")
+		return nil
+	})
+	router.DELETE("/empty", func(ctx http.Context) error {
+		ctx.Status(204)
+		return nil
+	})
+
+	w := serve(router, "GET", "/json", "")
+	if w.Code != 201 || w.Body.String() != `{"id":1}` {
+		t.Errorf("expected 201 with JSON body, got %d %s", w.Code, w.Body.String())
+	}
+	if !strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		t.Errorf("expected JSON content type, got %s", w.Header().Get("Content-Type"))
+	}
+	if w.Header().Get("X-Custom") != "value" {
+		t.Errorf("expected X-Custom header, got %q", w.Header().Get("X-Custom"))
+	}
+
+	w = serve(router, "GET", "/redirect", "")
+	if w.Code != 302 || w.Header().Get("Location") != "https://example.com" {
+		t.Errorf("expected redirect, got %d with Location %q", w.Code, w.Header().Get("Location"))
+	}
+
+	w = serve(router, "DELETE", "/empty", "")
+	if w.Code != 204 || w.Body.Len() != 0 {
+		t.Errorf("expected empty 204, got %d %s", w.Code, w.Body.String())
+	}
+}
+
+func testRequest(t *testing.T, newRouter func() http.Router) {
+	type request struct {
+		method, path, host, header, cookie, clientIP, body, bound string
+		hasCookie                                                 bool
+	}
+
+	var got request
+	router := newRouter()
+	router.POST("/users/:id", func(ctx http.Context) error {
+		got.method = ctx.Method()
+		got.path = ctx.Path()
+		got.host = ctx.Host()
+		got.header = ctx.GetHeader("X-Request-ID")
+		got.cookie, got.hasCookie = ctx.Cookie("session")
+		got.clientIP = ctx.ClientIP()
+
+		raw, err := ctx.Body()
+		if err != nil {
+			return err
+		}
+		got.body = string(raw)
+
+		var input struct {
+			Name string `json:"name"`
+		}
+		if err := ctx.Bind(&input); err != nil {
+			return err
+		}
+		got.bound = input.Name
+
+		ctx.Status(204)
+		return nil
+	})
+
+	req := httptest.NewRequest("POST", "http://acme.example.com/users/42?expand=roles", bytes.NewBufferString(`{"name":"Ana"}`))
+	req.RemoteAddr = "203.0.113.7:52114"
+	req.Header.Set("X-Request-ID", "abc")
+	// sem proxies confiáveis o X-Forwarded-For é ignorado
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	req.Header.Set("Cookie", "session=s3cr3t")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	want := request{
+		method:    "POST",
+		path:      "/users/42",
+		host:      "acme.example.com",
+		header:    "abc",
+		cookie:    "s3cr3t",
+		hasCookie: true,
+		clientIP:  "203.0.113.7",
+		body:      `{"name":"Ana"}`,
+		bound:     "Ana",
+	}
+	if w.Code != 204 {
+		t.Fatalf("expected status 204, got %d: %s", w.Code, w.Body.String())
+	}
+	if got != want {
+		t.Errorf("expected request %+v, got %+v", want, got)
+	}
+}
+
+func testValues(t *testing.T, newRouter func() http.Router) {
+	setValues := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			ctx.Set("request_id", "abc")
+			ctx.SetContext(context.WithValue(ctx.Context(), ctxKey{}, "value"))
+			return next(ctx)
+		}
+	}
+
+	var value, contextValue any
+	var found, foundMissing bool
+	router := newRouter()
+	router.Use(setValues)
+	router.GET("/test", func(ctx http.Context) error {
+		value, found = ctx.Get("request_id")
+		_, foundMissing = ctx.Get("missing")
+		contextValue = ctx.Context().Value(ctxKey{})
+		ctx.Status(200)
+		return nil
+	})
+
+	serve(router, "GET", "/test", "")
+
+	if !found || value != "abc" {
+		t.Errorf("expected value abc, got %v (found %v)", value, found)
+	}
+	if foundMissing {
+		t.Error("expected missing key not to be found")
+	}
+	if contextValue != "value" {
+		t.Errorf("expected context value, got %v", contextValue)
+	}
+}
+
+func testMoreMethods(t *testing.T, newRouter func() http.Router) {
+	router := newRouter()
+	respond := func(name string) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			ctx.Header("X-Handler", name)
+			ctx.Status(204)
+			return nil
+		}
+	}
+	router.PATCH("/items/:id", respond("patch"))
+	router.HEAD("/items/:id", respond("head"))
+	router.OPTIONS("/items/:id", respond("options"))
+	router.Any("/webhook", respond("any"))
+
+	tests := []struct {
+		method      string
+		url         string
+		wantHandler string
+	}{
+		{"PATCH", "/items/1", "patch"},
+		{"HEAD", "/items/1", "head"},
+		{"OPTIONS", "/items/1", "options"},
+		{"GET", "/webhook", "any"},
+		{"POST", "/webhook", "any"},
+		{"DELETE", "/webhook", "any"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.url, func(t *testing.T) {
+			w := serve(router, tt.method, tt.url, "")
+			if w.Code != 204 {
+				t.Fatalf("expected status 204, got %d", w.Code)
+			}
+			if got := w.Header().Get("X-Handler"); got != tt.wantHandler {
+				t.Errorf("expected handler %s, got %s", tt.wantHandler, got)
+			}
+		})
+	}
+}
+
+func testGroupMiddleware(t *testing.T, newRouter func() http.Router) {
+	var calls []string
+	record := func(name string) http.MiddlewareFunc {
+		return func(next http.HandlerFunc) http.HandlerFunc {
+			return func(ctx http.Context) error {
+				calls = append(calls, name)
+				return next(ctx)
+			}
+		}
+	}
+
+	router := newRouter()
+	admin := router.Group("/admin", record("auth"), record("admin"))
+	admin.Use(record("audit"))
+	admin.GET("/users", func(ctx http.Context) error { return ctx.JSON(200, "ok") })
+	router.GET("/public", func(ctx http.Context) error { return ctx.JSON(200, "ok") })
+
+	serve(router, "GET", "/admin/users", "")
+	if want := []string{"auth", "admin", "audit"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("expected calls %v, got %v", want, calls)
+	}
+
+	calls = nil
+	serve(router, "GET", "/public", "")
+	if len(calls) != 0 {
+		t.Errorf("expected group middleware not to run outside the group, got %v", calls)
+	}
+}
+
+func testStatic(t *testing.T, newRouter func() http.Router) {
+	files := fstest.MapFS{
+		"index.html":     {Data: []byte("<h1>home</h1>")},
+		"css/site.css":   {Data: []byte("body{}")},
+		"docs/guide.txt": {Data: []byte("guide")},
+	}
+
+	router := newRouter()
+	router.Static("/assets", files)
+
+	tests := []struct {
+		method     string
+		url        string
+		wantStatus int
+		wantBody   string
+	}{
+		{"GET", "/assets/css/site.css", 200, "body{}"},
+		{"GET", "/assets/", 200, "<h1>home</h1>"},
+		{"HEAD", "/assets/css/site.css", 200, ""},
+		{"GET", "/assets/missing.js", 404, ""},
+		// sem index.html o diretório não é listado
+		{"GET", "/assets/docs/", 404, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.url, func(t *testing.T) {
+			w := serve(router, tt.method, tt.url, "")
+			if w.Code != tt.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantStatus, w.Code, w.Body.String())
+			}
+			if tt.wantBody != "" && w.Body.String() != tt.wantBody {
+				t.Errorf("expected body %q, got %q", tt.wantBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func testNoRoute(t *testing.T, newRouter func() http.Router) {
+	traceID := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			ctx.Header("X-Trace-ID", "trace-123")
+			return next(ctx)
+		}
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		router := newRouter()
+		router.Use(traceID)
+		router.GET("/items", func(ctx http.Context) error { return ctx.JSON(200, "ok") })
+		router.POST("/items", func(ctx http.Context) error { return ctx.JSON(201, "ok") })
+
+		w := serve(router, "GET", "/missing", "")
+		if w.Code != 404 || w.Body.String() != "404 page not found" {
+			t.Errorf("expected default 404, got %d %q", w.Code, w.Body.String())
+		}
+		if w.Header().Get("X-Trace-ID") != "trace-123" {
+			t.Error("expected global middleware to run on 404")
+		}
+
+		w = serve(router, "DELETE", "/items", "")
+		if w.Code != 405 || w.Body.String() != "405 method not allowed" {
+			t.Errorf("expected default 405, got %d %q", w.Code, w.Body.String())
+		}
+		allow := w.Header().Get("Allow")
+		if !strings.Contains(allow, "GET") || !strings.Contains(allow, "POST") || strings.Contains(allow, "DELETE") {
+			t.Errorf("expected Allow with GET and POST, got %q", allow)
+		}
+	})
+
+	t.Run("custom handlers", func(t *testing.T) {
+		router := newRouter()
+		router.Use(traceID)
+		router.GET("/items", func(ctx http.Context) error { return ctx.JSON(200, "ok") })
+		router.NoRoute(func(ctx http.Context) error {
+			return ctx.JSON(404, map[string]string{"path": ctx.Path()})
+		})
+		router.NoMethod(func(ctx http.Context) error {
+			return ctx.JSON(405, map[string]string{"method": ctx.Method()})
+		})
+
+		w := serve(router, "GET", "/missing", "")
+		if w.Code != 404 || w.Body.String() != `{"path":"/missing"}` {
+			t.Errorf("expected custom 404, got %d %s", w.Code, w.Body.String())
+		}
+		if w.Header().Get("X-Trace-ID") != "trace-123" {
+			t.Error("expected global middleware to run on custom 404")
+		}
+
+		w = serve(router, "PUT", "/items", "")
+		if w.Code != 405 || w.Body.String() != `{"method":"PUT"}` {
+			t.Errorf("expected custom 405, got %d %s", w.Code, w.Body.String())
+		}
+		if !strings.Contains(w.Header().Get("Allow"), "GET") {
+			t.Errorf("expected Allow with GET, got %q", w.Header().Get("Allow"))
+		}
+	})
+}
+
+func listItems(ctx http.Context) error {
+	return ctx.JSON(200, "ok")
+}
+
+func requireHeader(name string) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			if ctx.GetHeader(name) == "" {
+				return errs.Unauthorized("auth.unauthenticated")
+			}
+			return next(ctx)
+		}
+	}
+}
+
+func testRoutesTable(t *testing.T, newRouter func() http.Router) {
+	const pkg = "scheduling/internal/infra/gin/routertest."
+
+	router := newRouter()
+	router.GET("/items", listItems)
+	api := router.Group("/api/:tenant", requireHeader("Authorization"))
+	api.PATCH("/items/:id", listItems)
+	api.Any("/hooks", listItems)
+	router.Static("/assets", fstest.MapFS{})
+
+	routes := api.Routes()
+	want := []http.RouteInfo{
+		{Method: "GET", Path: "/items", Handler: pkg + "listItems", Middlewares: []string{}},
+		{Method: "PATCH", Path: "/api/:tenant/items/:id", Handler: pkg + "listItems", Middlewares: []string{pkg + "requireHeader"}},
+		{Method: http.MethodAny, Path: "/api/:tenant/hooks", Handler: pkg + "listItems", Middlewares: []string{pkg + "requireHeader"}},
+		{Method: "GET", Path: "/assets/*filepath"},
+		{Method: "HEAD", Path: "/assets/*filepath"},
+	}
+
+	if len(routes) != len(want) {
+		t.Fatalf("expected %d routes, got %+v", len(want), routes)
+	}
+	for i, route := range routes {
+		if route.Method != want[i].Method || route.Path != want[i].Path {
+			t.Errorf("expected route %s %s, got %s %s", want[i].Method, want[i].Path, route.Method, route.Path)
+		}
+		if want[i].Handler != "" && (route.Handler != want[i].Handler || !reflect.DeepEqual(route.Middlewares, want[i].Middlewares)) {
+			t.Errorf("expected handler %s with middlewares %v, got %s with %v", want[i].Handler, want[i].Middlewares, route.Handler, route.Middlewares)
+		}
+	}
+}
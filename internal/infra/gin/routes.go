@@ -0,0 +1,94 @@
+package http
+
+import (
+	"io/fs"
+	"net/http"
+	"path"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// MethodAny identifica nas RouteInfo as rotas registradas com Any.
+const MethodAny = "ANY"
+
+// RouteInfo descreve uma rota registrada, para documentação e auditoria de
+// permissões. Handler e Middlewares trazem os nomes das funções, ex.:
+// scheduling/internal/infra/middleware.RequireAdmin.
+type RouteInfo struct {
+	Method      string   `json:"method"`
+	Path        string   `json:"path"`
+	Handler     string   `json:"handler"`
+	Middlewares []string `json:"middlewares"`
+}
+
+func NewRouteInfo(method, path string, handler HandlerFunc, middlewares []MiddlewareFunc) RouteInfo {
+	names := make([]string, 0, len(middlewares))
+	for _, middleware := range middlewares {
+		names = append(names, funcName(middleware))
+	}
+	return RouteInfo{Method: method, Path: path, Handler: funcName(handler), Middlewares: names}
+}
+
+// closureSuffix remove o sufixo das funções anônimas, de modo que o
+// middleware devolvido por RequireAdmin() apareça como RequireAdmin.
+var closureSuffix = regexp.MustCompile(`(\.func\d+)+(\.\d+)*$`)
+
+func funcName(f any) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	if fn == nil {
+		return ""
+	}
+	name := strings.TrimSuffix(fn.Name(), "-fm")
+	return closureSuffix.ReplaceAllString(name, "")
+}
+
+// JoinPaths junta o prefixo do grupo e o caminho da rota como o Gin faz,
+// mantendo a barra final do caminho.
+func JoinPaths(prefix, relativePath string) string {
+	if relativePath == "" {
+		if prefix == "" {
+			return "/"
+		}
+		return prefix
+	}
+
+	joined := path.Join("/", prefix, relativePath)
+	if strings.HasSuffix(relativePath, "/") && !strings.HasSuffix(joined, "/") {
+		joined += "/"
+	}
+	return joined
+}
+
+// StaticFiles serve os arquivos de fsys pelo caminho de r.URL.Path. Os
+// adaptadores reescrevem o caminho com o curinga da rota de Static.
+func StaticFiles(fsys fs.FS) http.Handler {
+	return http.FileServerFS(noListingFS{fsys})
+}
+
+// noListingFS esconde os diretórios sem index.html, que o FileServer
+// listaria.
+type noListingFS struct {
+	fs.FS
+}
+
+func (n noListingFS) Open(name string) (fs.File, error) {
+	file, err := n.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if info.IsDir() {
+		if _, err := fs.Stat(n.FS, path.Join(name, "index.html")); err != nil {
+			file.Close()
+			return nil, fs.ErrNotExist
+		}
+	}
+	return file, nil
+}
@@ -0,0 +1,177 @@
+package stdlib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/netip"
+	"strings"
+
+	http "scheduling/internal/infra/gin"
+)
+
+type stdContext struct {
+	w        *responseWriter
+	r        *nethttp.Request
+	route    string
+	catchAll string
+	values   map[string]any
+	proxies  []netip.Prefix
+}
+
+func (s *stdContext) Context() context.Context {
+	return s.r.Context()
+}
+func (s *stdContext) SetContext(ctx context.Context) {
+	s.r = s.r.WithContext(ctx)
+}
+func (s *stdContext) GetHeader(key string) string {
+	return s.r.Header.Get(key)
+}
+func (s *stdContext) Cookie(name string) (string, bool) {
+	cookie, err := s.r.Cookie(name)
+	if err != nil {
+		return "", false
+	}
+	return cookie.Value, true
+}
+func (s *stdContext) Host() string {
+	return s.r.Host
+}
+func (s *stdContext) ClientIP() string {
+	return clientIP(s.r, s.proxies)
+}
+func (s *stdContext) Method() string {
+	return s.r.Method
+}
+func (s *stdContext) Path() string {
+	return s.r.URL.Path
+}
+func (s *stdContext) Param(name string) string {
+	value := s.r.PathValue(name)
+	// no Gin o curinga inclui a barra inicial
+	if name == s.catchAll {
+		return "/" + value
+	}
+	return value
+}
+func (s *stdContext) Query(name string) string {
+	return s.r.URL.Query().Get(name)
+}
+func (s *stdContext) Body() ([]byte, error) {
+	if s.r.Body == nil {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(s.r.Body)
+	if err != nil {
+		return nil, err
+	}
+	s.r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+func (s *stdContext) Bind(obj any) error {
+	return http.DecodeJSON(s.r.Body, obj, false)
+}
+func (s *stdContext) BindStrict(obj any) error {
+	return http.DecodeJSON(s.r.Body, obj, true)
+}
+func (s *stdContext) JSON(status int, obj any) error {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	s.w.write(status, "application/json; charset=utf-8", body)
+	return nil
+}
+func (s *stdContext) Problem(status int, err error) error {
+	writeProblem(s, status, err)
+	return nil
+}
+func (s *stdContext) Status(code int) {
+	s.w.status = code
+}
+func (s *stdContext) Header(key, value string) {
+	s.w.Header().Set(key, value)
+}
+func (s *stdContext) Set(key string, value any) {
+	s.values[key] = value
+}
+func (s *stdContext) Get(key string) (any, bool) {
+	value, ok := s.values[key]
+	return value, ok
+}
+
+// finish envia o status definido por Status quando nada foi escrito.
+func (s *stdContext) finish() {
+	if !s.w.written {
+		s.w.write(s.w.status, "", nil)
+	}
+}
+
+// responseWriter adia o status até a escrita, para que Status e Header
+// possam ser chamados em qualquer ordem, como no Gin.
+type responseWriter struct {
+	nethttp.ResponseWriter
+	status  int
+	written bool
+}
+
+func (w *responseWriter) write(status int, contentType string, body []byte) {
+	if status == 0 {
+		status = nethttp.StatusOK
+	}
+	if contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+
+	w.written = true
+	w.ResponseWriter.WriteHeader(status)
+	if len(body) > 0 {
+		_, _ = w.ResponseWriter.Write(body)
+	}
+}
+
+// clientIP segue a regra do Gin: X-Forwarded-For e X-Real-IP só valem
+// quando a conexão vem de um proxy confiável, e no X-Forwarded-For vale o
+// primeiro endereço não confiável da direita para a esquerda.
+func clientIP(r *nethttp.Request, proxies []netip.Prefix) string {
+	remote, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		host, _, _ := strings.Cut(r.RemoteAddr, ":")
+		return host
+	}
+	remoteIP := remote.Addr().Unmap()
+	if !trusted(remoteIP, proxies) {
+		return remoteIP.String()
+	}
+
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		hops := strings.Split(forwarded, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+			if err != nil {
+				break
+			}
+			if i == 0 || !trusted(ip.Unmap(), proxies) {
+				return ip.Unmap().String()
+			}
+		}
+	}
+
+	if realIP, err := netip.ParseAddr(strings.TrimSpace(r.Header.Get("X-Real-IP"))); err == nil {
+		return realIP.Unmap().String()
+	}
+	return remoteIP.String()
+}
+
+func trusted(ip netip.Addr, proxies []netip.Prefix) bool {
+	for _, proxy := range proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package stdlib
+
+import (
+	"encoding/json"
+	"log/slog"
+	nethttp "net/http"
+
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/i18n"
+	http "scheduling/internal/infra/gin"
+)
+
+// handleError é o tratamento central dos erros devolvidos por handlers e
+// middlewares. Se a resposta já foi escrita o erro é só registrado.
+func handleError(ctx *stdContext, err error) {
+	if ctx.w.written {
+		slog.ErrorContext(
+			ctx.r.Context(),
+			"Erro depois da resposta enviada",
+			"error", err.Error(),
+			"method", ctx.r.Method,
+			"path", ctx.route,
+			"operation", "http.handle_error",
+		)
+		return
+	}
+
+	if errs.KindOf(err) == errs.KindInternal {
+		slog.ErrorContext(
+			ctx.r.Context(),
+			"Erro não tratado na requisição",
+			"error", err.Error(),
+			"method", ctx.r.Method,
+			"path", ctx.route,
+			"operation", "http.handle_error",
+		)
+	}
+
+	writeProblem(ctx, 0, err)
+}
+
+func writeProblem(ctx *stdContext, status int, err error) {
+	traceID, _ := ctx.values[http.TraceIDKey].(string)
+	problem := http.NewProblem(status, err, traceID, requestLocale(ctx))
+	if problem.Status == nethttp.StatusUnauthorized {
+		ctx.w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+
+	body, marshalErr := json.Marshal(problem)
+	if marshalErr != nil {
+		ctx.w.write(problem.Status, "", nil)
+		return
+	}
+	ctx.w.write(problem.Status, http.ProblemContentType, body)
+}
+
+// requestLocale é o idioma definido pelo middleware de idioma ou, nas rotas
+// sem ele, o negociado pelo Accept-Language.
+func requestLocale(ctx *stdContext) i18n.Locale {
+	if locale, ok := i18n.LocaleFrom(ctx.r.Context()); ok {
+		return locale
+	}
+	return i18n.Negotiate(ctx.r.Header.Get("Accept-Language"))
+}
@@ -0,0 +1,303 @@
+// Package stdlib implementa as portas de internal/infra/gin sobre o ServeMux
+// de net/http, sem dependências externas.
+package stdlib
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"log/slog"
+	nethttp "net/http"
+	"net/netip"
+	"strings"
+
+	http "scheduling/internal/infra/gin"
+)
+
+type mux struct {
+	serveMux *nethttp.ServeMux
+	proxies  []netip.Prefix
+	// router é o roteador raiz, cujos middlewares valem para NoRoute e
+	// NoMethod
+	router   *stdRouter
+	noRoute  http.HandlerFunc
+	noMethod http.HandlerFunc
+	routes   []http.RouteInfo
+}
+
+type stdRouter struct {
+	root        *mux
+	prefix      string
+	middlewares []http.MiddlewareFunc
+}
+
+func NewRouter() http.Router {
+
+	// sem TRUSTED_PROXIES o IP do cliente é o da conexão, como no adaptador
+	// do Gin
+	proxies, err := parseProxies(http.TrustedProxies())
+	if err != nil {
+		log.Fatalf("TRUSTED_PROXIES inválido: %v", err)
+	}
+
+	root := &mux{serveMux: nethttp.NewServeMux(), proxies: proxies}
+	root.router = &stdRouter{root: root}
+	return root.router
+}
+
+func (r *stdRouter) GET(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodGet, path, handler)
+}
+
+func (r *stdRouter) POST(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodPost, path, handler)
+}
+
+func (r *stdRouter) PUT(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodPut, path, handler)
+}
+
+func (r *stdRouter) DELETE(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodDelete, path, handler)
+}
+
+func (r *stdRouter) PATCH(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodPatch, path, handler)
+}
+
+func (r *stdRouter) HEAD(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodHead, path, handler)
+}
+
+func (r *stdRouter) OPTIONS(path string, handler http.HandlerFunc) {
+	r.handle(nethttp.MethodOptions, path, handler)
+}
+
+// Any usa o padrão sem método, que no ServeMux casa com todos.
+func (r *stdRouter) Any(path string, handler http.HandlerFunc) {
+	r.handle("", path, handler)
+}
+
+func (r *stdRouter) Static(path string, fsys fs.FS) {
+	handler := staticHandler(fsys)
+	r.GET(path+"/*filepath", handler)
+	r.HEAD(path+"/*filepath", handler)
+}
+
+// Use vale para as rotas registradas depois dele, como no Gin.
+func (r *stdRouter) Use(middlewares ...http.MiddlewareFunc) {
+	r.middlewares = append(r.middlewares, middlewares...)
+}
+
+func (r *stdRouter) NoRoute(handler http.HandlerFunc) {
+	r.root.noRoute = handler
+}
+
+func (r *stdRouter) NoMethod(handler http.HandlerFunc) {
+	r.root.noMethod = handler
+}
+
+func (r *stdRouter) Routes() []http.RouteInfo {
+	return append([]http.RouteInfo(nil), r.root.routes...)
+}
+
+func (r *stdRouter) Group(path string, middlewares ...http.MiddlewareFunc) http.Router {
+	group := &stdRouter{
+		root:   r.root,
+		prefix: http.JoinPaths(r.prefix, path),
+		// cópia para que o Use do grupo não altere o pai
+		middlewares: append([]http.MiddlewareFunc(nil), r.middlewares...),
+	}
+	group.Use(middlewares...)
+	return group
+}
+
+func (r *stdRouter) ServeHTTP(w nethttp.ResponseWriter, req *nethttp.Request) {
+	if _, pattern := r.root.serveMux.Handler(req); pattern != "" {
+		r.root.serveMux.ServeHTTP(w, req)
+		return
+	}
+
+	// sem rota: 405 se o caminho existe em outro método, senão 404
+	if allowed := r.root.allowedMethods(req); len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		r.root.serveError(w, req, nethttp.StatusMethodNotAllowed, r.root.noMethod)
+		return
+	}
+	r.root.serveError(w, req, nethttp.StatusNotFound, r.root.noRoute)
+}
+
+func (r *stdRouter) Run(addr string) error {
+	if r.root == nil {
+		return fmt.Errorf("no root mux to run")
+	}
+	return nethttp.ListenAndServe(addr, r)
+}
+
+func (r *stdRouter) handle(method, relativePath string, handler http.HandlerFunc) {
+	route := http.JoinPaths(r.prefix, relativePath)
+	pattern, catchAll := muxPattern(route)
+	if method != "" {
+		pattern = method + " " + pattern
+	}
+
+	chain := chainOf(handler, r.middlewares)
+	r.root.serveMux.HandleFunc(pattern, func(w nethttp.ResponseWriter, req *nethttp.Request) {
+		ctx := r.root.newContext(w, req, route, catchAll)
+		defer ctx.finish()
+		defer recoverPanic(ctx)
+
+		_ = chain(ctx)
+	})
+
+	routeMethod := method
+	if routeMethod == "" {
+		routeMethod = http.MethodAny
+	}
+	r.root.routes = append(r.root.routes, http.NewRouteInfo(routeMethod, route, handler, r.middlewares))
+}
+
+func (m *mux) newContext(w nethttp.ResponseWriter, req *nethttp.Request, route, catchAll string) *stdContext {
+	return &stdContext{
+		w:        &responseWriter{ResponseWriter: w},
+		r:        req,
+		route:    route,
+		catchAll: catchAll,
+		values:   map[string]any{},
+		proxies:  m.proxies,
+	}
+}
+
+// defaultErrorBodies são os mesmos textos do Gin.
+var defaultErrorBodies = map[int][]byte{
+	nethttp.StatusNotFound:         []byte("404 page not found"),
+	nethttp.StatusMethodNotAllowed: []byte("405 method not allowed"),
+}
+
+// serveError responde 404 e 405 como o Gin: passa pelos middlewares do
+// roteador raiz e, se o handler não responder, envia o texto padrão.
+func (m *mux) serveError(w nethttp.ResponseWriter, req *nethttp.Request, status int, handler http.HandlerFunc) {
+	if handler == nil {
+		handler = func(ctx http.Context) error { return nil }
+	}
+
+	ctx := m.newContext(w, req, "", "")
+	ctx.w.status = status
+	defer func() {
+		if !ctx.w.written && ctx.w.status == status {
+			ctx.w.write(status, "text/plain", defaultErrorBodies[status])
+		}
+		ctx.finish()
+	}()
+	defer recoverPanic(ctx)
+
+	_ = chainOf(handler, m.router.middlewares)(ctx)
+}
+
+// allowedMethods são os métodos com rota para o caminho da requisição.
+func (m *mux) allowedMethods(req *nethttp.Request) []string {
+	var allowed []string
+	for _, method := range []string{
+		nethttp.MethodGet, nethttp.MethodHead, nethttp.MethodPost, nethttp.MethodPut, nethttp.MethodPatch,
+		nethttp.MethodDelete, nethttp.MethodOptions, nethttp.MethodConnect, nethttp.MethodTrace,
+	} {
+		probe := req.Clone(req.Context())
+		probe.Method = method
+		if _, pattern := m.serveMux.Handler(probe); pattern != "" {
+			allowed = append(allowed, method)
+		}
+	}
+	return allowed
+}
+
+func chainOf(handler http.HandlerFunc, middlewares []http.MiddlewareFunc) http.HandlerFunc {
+	chain := handled(handler)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		chain = handled(middlewares[i](chain))
+	}
+	return chain
+}
+
+// handled trata o erro de h na própria etapa, como o adaptador do Gin faz
+// com cada handler e middleware: para quem chamou next, a cadeia seguinte
+// sempre termina sem erro.
+func handled(h http.HandlerFunc) http.HandlerFunc {
+	return func(ctx http.Context) error {
+		if err := h(ctx); err != nil {
+			handleError(ctx.(*stdContext), err)
+		}
+		return nil
+	}
+}
+
+func recoverPanic(ctx *stdContext) {
+	if recovered := recover(); recovered != nil {
+		slog.ErrorContext(
+			ctx.r.Context(),
+			"Pânico na requisição",
+			"error", fmt.Sprint(recovered),
+			"method", ctx.r.Method,
+			"path", ctx.route,
+			"operation", "http.recover",
+		)
+		if !ctx.w.written {
+			writeProblem(ctx, nethttp.StatusInternalServerError, fmt.Errorf("panic: %v", recovered))
+		}
+	}
+}
+
+// muxPattern converte a rota no formato do Gin para o do ServeMux:
+// :nome vira {nome} e *nome vira {nome...}. Rotas terminadas em barra
+// recebem {$}, já que no ServeMux elas casariam com toda a subárvore.
+func muxPattern(route string) (pattern, catchAll string) {
+	segments := strings.Split(route, "/")
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			segments[i] = "{" + segment[1:] + "}"
+		case strings.HasPrefix(segment, "*"):
+			catchAll = segment[1:]
+			segments[i] = "{" + catchAll + "...}"
+		}
+	}
+
+	pattern = strings.Join(segments, "/")
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "{$}"
+	}
+	return pattern, catchAll
+}
+
+func parseProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		if strings.Contains(proxy, "/") {
+			prefix, err := netip.ParsePrefix(proxy)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+
+		addr, err := netip.ParseAddr(proxy)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return prefixes, nil
+}
+
+// staticHandler serve o arquivo do curinga filepath da rota de Static.
+func staticHandler(fsys fs.FS) http.HandlerFunc {
+	files := http.StaticFiles(fsys)
+	return func(ctx http.Context) error {
+		s := ctx.(*stdContext)
+		req := s.r.Clone(s.r.Context())
+		req.URL.Path = ctx.Param("filepath")
+		s.w.written = true
+		files.ServeHTTP(s.w.ResponseWriter, req)
+		return nil
+	}
+}
@@ -0,0 +1,11 @@
+package stdlib
+
+import (
+	"testing"
+
+	"scheduling/internal/infra/gin/routertest"
+)
+
+func TestStdRouter_Conformance(t *testing.T) {
+	routertest.Run(t, NewRouter)
+}
@@ -0,0 +1,108 @@
+package hashing
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+	argon2Prefix     = "$argon2id$"
+)
+
+var errInvalidArgon2Hash = errors.New("hash argon2id inválido")
+
+type Argon2id struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// NewArgon2id recebe a memória em KiB. Os valores recomendados pela OWASP são
+// 19456 KiB, 2 iterações e paralelismo 1 como mínimo.
+func NewArgon2id(memory, iterations uint32, parallelism uint8) (*Argon2id, error) {
+	if memory < 8*uint32(parallelism) || iterations < 1 || parallelism < 1 {
+		return nil, errors.New("parâmetros do argon2id inválidos")
+	}
+	return &Argon2id{memory: memory, iterations: iterations, parallelism: parallelism}, nil
+}
+
+func (a *Argon2id) Hash(plain string) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(plain), salt, a.iterations, a.memory, a.parallelism, argon2KeyLength)
+
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2Prefix,
+		argon2.Version,
+		a.memory,
+		a.iterations,
+		a.parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a *Argon2id) Verify(hash, plain string) (bool, error) {
+	params, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return false, err
+	}
+
+	other := argon2.IDKey([]byte(plain), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (a *Argon2id) NeedsRehash(hash string) bool {
+	params, _, _, err := decodeArgon2id(hash)
+	if err != nil {
+		return true
+	}
+	return params.memory < a.memory || params.iterations < a.iterations || params.parallelism < a.parallelism
+}
+
+func (a *Argon2id) Recognizes(hash string) bool {
+	return strings.HasPrefix(hash, argon2Prefix)
+}
+
+func decodeArgon2id(hash string) (*Argon2id, []byte, []byte, error) {
+	// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, errInvalidArgon2Hash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, errInvalidArgon2Hash
+	}
+
+	params := &Argon2id{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return nil, nil, nil, errInvalidArgon2Hash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, errInvalidArgon2Hash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return nil, nil, nil, errInvalidArgon2Hash
+	}
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,108 @@
+package hashing
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"os"
+	"strconv"
+
+	"scheduling/internal/domain/password"
+)
+
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+
+	defaultBcryptCost        = 12
+	defaultArgon2Memory      = 64 * 1024
+	defaultArgon2Iterations  = 3
+	defaultArgon2Parallelism = 2
+)
+
+type algorithm interface {
+	password.Hasher
+	Recognizes(hash string) bool
+}
+
+// Hasher gera hashes com o algoritmo principal e ainda verifica hashes dos
+// demais algoritmos conhecidos. Senhas antigas gravadas em texto puro também
+// são aceitas e sempre marcadas para rehash, permitindo migrar a base à medida
+// que os usuários fazem login.
+type Hasher struct {
+	primary algorithm
+	others  []algorithm
+}
+
+var _ password.Hasher = (*Hasher)(nil)
+
+func NewHasher(primary algorithm, others ...algorithm) *Hasher {
+	return &Hasher{primary: primary, others: others}
+}
+
+// NewHasherFromEnv monta o Hasher a partir de PASSWORD_HASH_ALGORITHM
+// (argon2id ou bcrypt), BCRYPT_COST, ARGON2_MEMORY_KB, ARGON2_ITERATIONS e
+// ARGON2_PARALLELISM.
+func NewHasherFromEnv() (*Hasher, error) {
+	bcryptHasher, err := NewBcrypt(envInt("BCRYPT_COST", defaultBcryptCost))
+	if err != nil {
+		return nil, err
+	}
+
+	argon2Hasher, err := NewArgon2id(
+		uint32(envInt("ARGON2_MEMORY_KB", defaultArgon2Memory)),
+		uint32(envInt("ARGON2_ITERATIONS", defaultArgon2Iterations)),
+		uint8(envInt("ARGON2_PARALLELISM", defaultArgon2Parallelism)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	switch algo := os.Getenv("PASSWORD_HASH_ALGORITHM"); algo {
+	case "", AlgorithmArgon2id:
+		return NewHasher(argon2Hasher, bcryptHasher), nil
+	case AlgorithmBcrypt:
+		return NewHasher(bcryptHasher, argon2Hasher), nil
+	default:
+		return nil, fmt.Errorf("algoritmo de hash desconhecido: %s", algo)
+	}
+}
+
+func (h *Hasher) Hash(plain string) (string, error) {
+	return h.primary.Hash(plain)
+}
+
+func (h *Hasher) Verify(hash, plain string) (bool, error) {
+	if algo := h.find(hash); algo != nil {
+		return algo.Verify(hash, plain)
+	}
+
+	// senha legada em texto puro
+	return hash != "" && subtle.ConstantTimeCompare([]byte(hash), []byte(plain)) == 1, nil
+}
+
+func (h *Hasher) NeedsRehash(hash string) bool {
+	if !h.primary.Recognizes(hash) {
+		return true
+	}
+	return h.primary.NeedsRehash(hash)
+}
+
+func (h *Hasher) find(hash string) algorithm {
+	if h.primary.Recognizes(hash) {
+		return h.primary
+	}
+	for _, algo := range h.others {
+		if algo.Recognizes(hash) {
+			return algo
+		}
+	}
+	return nil
+}
+
+func envInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,150 @@
+package hashing
+
+import (
+	"strings"
+	"testing"
+)
+
+func newTestBcrypt(t *testing.T, cost int) *Bcrypt {
+	b, err := NewBcrypt(cost)
+	if err != nil {
+		t.Fatalf("falha ao criar bcrypt: %v", err)
+	}
+	return b
+}
+
+func newTestArgon2id(t *testing.T, memory, iterations uint32) *Argon2id {
+	a, err := NewArgon2id(memory, iterations, 1)
+	if err != nil {
+		t.Fatalf("falha ao criar argon2id: %v", err)
+	}
+	return a
+}
+
+func TestAlgorithms_HashAndVerify(t *testing.T) {
+	algorithms := map[string]algorithm{
+		"bcrypt":   newTestBcrypt(t, 4),
+		"argon2id": newTestArgon2id(t, 64, 1),
+	}
+
+	for name, algo := range algorithms {
+		t.Run(name, func(t *testing.T) {
+			hash, err := algo.Hash("senha123")
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if hash == "senha123" || !algo.Recognizes(hash) {
+				t.Fatalf("hash em formato inesperado: %s", hash)
+			}
+
+			if ok, err := algo.Verify(hash, "senha123"); err != nil || !ok {
+				t.Errorf("senha correta não verificada: ok=%v err=%v", ok, err)
+			}
+			if ok, _ := algo.Verify(hash, "senha124"); ok {
+				t.Error("senha errada verificada")
+			}
+
+			other, _ := algo.Hash("senha123")
+			if other == hash {
+				t.Error("hashes da mesma senha deveriam usar salts diferentes")
+			}
+
+			if algo.NeedsRehash(hash) {
+				t.Error("hash com os parâmetros atuais não deveria precisar de rehash")
+			}
+		})
+	}
+}
+
+func TestAlgorithms_NeedsRehashAfterCostIncrease(t *testing.T) {
+	t.Run("bcrypt", func(t *testing.T) {
+		hash, _ := newTestBcrypt(t, 4).Hash("senha123")
+		if !newTestBcrypt(t, 5).NeedsRehash(hash) {
+			t.Error("aumento de custo deveria exigir rehash")
+		}
+	})
+
+	t.Run("argon2id", func(t *testing.T) {
+		hash, _ := newTestArgon2id(t, 64, 1).Hash("senha123")
+		if !newTestArgon2id(t, 64, 2).NeedsRehash(hash) {
+			t.Error("aumento de iterações deveria exigir rehash")
+		}
+		if !newTestArgon2id(t, 128, 1).NeedsRehash(hash) {
+			t.Error("aumento de memória deveria exigir rehash")
+		}
+	})
+}
+
+func TestArgon2id_VerifyInvalidHash(t *testing.T) {
+	algo := newTestArgon2id(t, 64, 1)
+
+	for _, hash := range []string{"$argon2id$v=19$m=64", "$argon2id$v=1$m=64,t=1,p=1$c2FsdA$aGFzaA", "$argon2id$v=19$m=64,t=1,p=1$!!$aGFzaA"} {
+		if _, err := algo.Verify(hash, "senha123"); err == nil {
+			t.Errorf("hash inválido aceito: %s", hash)
+		}
+	}
+}
+
+func TestHasher(t *testing.T) {
+	argon := newTestArgon2id(t, 64, 1)
+	bcryptHasher := newTestBcrypt(t, 4)
+	hasher := NewHasher(argon, bcryptHasher)
+
+	t.Run("gera hashes com o algoritmo principal", func(t *testing.T) {
+		hash, err := hasher.Hash("senha123")
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if !strings.HasPrefix(hash, "$argon2id$") {
+			t.Errorf("hash argon2id esperado, obtido %s", hash)
+		}
+	})
+
+	t.Run("verifica hash de outro algoritmo e pede rehash", func(t *testing.T) {
+		hash, _ := bcryptHasher.Hash("senha123")
+
+		if ok, err := hasher.Verify(hash, "senha123"); err != nil || !ok {
+			t.Errorf("hash bcrypt não verificado: ok=%v err=%v", ok, err)
+		}
+		if !hasher.NeedsRehash(hash) {
+			t.Error("hash de outro algoritmo deveria precisar de rehash")
+		}
+	})
+
+	t.Run("senha legada em texto puro", func(t *testing.T) {
+		if ok, _ := hasher.Verify("senha123", "senha123"); !ok {
+			t.Error("senha legada correta não verificada")
+		}
+		if ok, _ := hasher.Verify("senha123", "outra"); ok {
+			t.Error("senha legada errada verificada")
+		}
+		if ok, _ := hasher.Verify("", ""); ok {
+			t.Error("hash vazio não pode ser aceito")
+		}
+		if !hasher.NeedsRehash("senha123") {
+			t.Error("senha legada deveria precisar de rehash")
+		}
+	})
+}
+
+func TestNewHasherFromEnv(t *testing.T) {
+	t.Setenv("BCRYPT_COST", "4")
+	t.Setenv("ARGON2_MEMORY_KB", "64")
+	t.Setenv("ARGON2_ITERATIONS", "1")
+	t.Setenv("ARGON2_PARALLELISM", "1")
+
+	t.Setenv("PASSWORD_HASH_ALGORITHM", "bcrypt")
+	hasher, err := NewHasherFromEnv()
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	hash, _ := hasher.Hash("senha123")
+	if !strings.HasPrefix(hash, "$2a$04$") {
+		t.Errorf("hash bcrypt com custo 4 esperado, obtido %s", hash)
+	}
+
+	t.Setenv("PASSWORD_HASH_ALGORITHM", "md5")
+	if _, err := NewHasherFromEnv(); err == nil {
+		t.Error("algoritmo desconhecido deveria retornar erro")
+	}
+}
@@ -0,0 +1,53 @@
+package health
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// Commit e BuildTime podem ser definidos na compilação:
+//
+//	go build -ldflags "-X scheduling/internal/infra/health.Commit=$(git rev-parse HEAD) -X scheduling/internal/infra/health.BuildTime=$(date -u +%FT%TZ)"
+//
+// Sem eles valem os dados de VCS que o go build grava no binário; nesse caso
+// BuildTime é a data do commit.
+var (
+	Commit    string
+	BuildTime string
+)
+
+type BuildInfo struct {
+	Commit    string `json:"commit"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+	Modified  bool   `json:"modified,omitempty"`
+}
+
+func Build() BuildInfo {
+	info := BuildInfo{Commit: Commit, BuildTime: BuildTime, GoVersion: runtime.Version()}
+
+	if build, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range build.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.Commit == "" {
+					info.Commit = setting.Value
+				}
+			case "vcs.time":
+				if info.BuildTime == "" {
+					info.BuildTime = setting.Value
+				}
+			case "vcs.modified":
+				info.Modified = setting.Value == "true"
+			}
+		}
+	}
+
+	if info.Commit == "" {
+		info.Commit = "unknown"
+	}
+	if info.BuildTime == "" {
+		info.BuildTime = "unknown"
+	}
+	return info
+}
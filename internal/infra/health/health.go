@@ -0,0 +1,115 @@
+// Package health verifica as dependências da aplicação para as sondas de
+// prontidão e informa a versão em execução.
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+
+	DefaultTimeout = 2 * time.Second
+)
+
+// CheckFunc devolve nil quando a dependência está disponível.
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name    string
+	timeout time.Duration
+	run     CheckFunc
+}
+
+type CheckResult struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	DurationMS int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+func (r Report) OK() bool {
+	return r.Status == StatusOK
+}
+
+// Checker roda as verificações em paralelo, cada uma com o próprio prazo.
+type Checker struct {
+	checks []check
+	now    func() time.Time
+}
+
+func NewChecker() *Checker {
+	return &Checker{now: time.Now}
+}
+
+// Add registra uma verificação. Com timeout zero vale DefaultTimeout.
+func (c *Checker) Add(name string, timeout time.Duration, run CheckFunc) {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	c.checks = append(c.checks, check{name: name, timeout: timeout, run: run})
+}
+
+// Run devolve o resultado de cada verificação, na ordem de registro. O
+// relatório só fica ok se todas passarem.
+func (c *Checker) Run(ctx context.Context) Report {
+	results := make([]CheckResult, len(c.checks))
+
+	var wg sync.WaitGroup
+	for i, chk := range c.checks {
+		wg.Add(1)
+		go func(i int, chk check) {
+			defer wg.Done()
+			results[i] = c.runCheck(ctx, chk)
+		}(i, chk)
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: results}
+	for _, result := range results {
+		if result.Status != StatusOK {
+			report.Status = StatusUnavailable
+		}
+	}
+	return report
+}
+
+func (c *Checker) runCheck(ctx context.Context, chk check) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, chk.timeout)
+	defer cancel()
+
+	start := c.now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				done <- fmt.Errorf("pânico: %v", recovered)
+			}
+		}()
+		done <- chk.run(ctx)
+	}()
+
+	// verificações que ignoram o contexto não seguram a resposta além do prazo
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("sem resposta em %s", chk.timeout)
+	}
+
+	result := CheckResult{Name: chk.name, Status: StatusOK, DurationMS: c.now().Sub(start).Milliseconds()}
+	if err != nil {
+		result.Status = StatusUnavailable
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,122 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChecker_Run(t *testing.T) {
+	tests := []struct {
+		name       string
+		checks     map[string]CheckFunc
+		order      []string
+		wantStatus string
+		wantChecks map[string]string
+	}{
+		{
+			name: "todas disponíveis",
+			checks: map[string]CheckFunc{
+				"database": func(ctx context.Context) error { return nil },
+				"workers":  func(ctx context.Context) error { return nil },
+			},
+			order:      []string{"database", "workers"},
+			wantStatus: StatusOK,
+			wantChecks: map[string]string{"database": StatusOK, "workers": StatusOK},
+		},
+		{
+			name: "uma indisponível",
+			checks: map[string]CheckFunc{
+				"database":   func(ctx context.Context) error { return errors.New("connection refused") },
+				"migrations": func(ctx context.Context) error { return nil },
+			},
+			order:      []string{"database", "migrations"},
+			wantStatus: StatusUnavailable,
+			wantChecks: map[string]string{"database": StatusUnavailable, "migrations": StatusOK},
+		},
+		{
+			name: "pânico na verificação",
+			checks: map[string]CheckFunc{
+				"workers": func(ctx context.Context) error { panic("nil map") },
+			},
+			order:      []string{"workers"},
+			wantStatus: StatusUnavailable,
+			wantChecks: map[string]string{"workers": StatusUnavailable},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker()
+			for _, name := range tt.order {
+				checker.Add(name, 0, tt.checks[name])
+			}
+
+			report := checker.Run(context.Background())
+			if report.Status != tt.wantStatus || report.OK() != (tt.wantStatus == StatusOK) {
+				t.Errorf("status esperado %s, obtido %s", tt.wantStatus, report.Status)
+			}
+			if len(report.Checks) != len(tt.order) {
+				t.Fatalf("esperava %d verificações, obtidas %+v", len(tt.order), report.Checks)
+			}
+			for i, result := range report.Checks {
+				if result.Name != tt.order[i] {
+					t.Errorf("verificação %d deveria ser %s, obtida %s", i, tt.order[i], result.Name)
+				}
+				if result.Status != tt.wantChecks[result.Name] {
+					t.Errorf("status de %s esperado %s, obtido %s", result.Name, tt.wantChecks[result.Name], result.Status)
+				}
+				if (result.Status == StatusOK) != (result.Error == "") {
+					t.Errorf("erro de %s inconsistente com o status: %+v", result.Name, result)
+				}
+			}
+		})
+	}
+}
+
+func TestChecker_Timeout(t *testing.T) {
+	checker := NewChecker()
+	checker.Add("database", 50*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	// verificação que ignora o contexto
+	checker.Add("fila", 50*time.Millisecond, func(ctx context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+
+	start := time.Now()
+	report := checker.Run(context.Background())
+
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("verificações deveriam respeitar o prazo, levaram %s", elapsed)
+	}
+	if report.OK() {
+		t.Fatal("verificações esgotadas deveriam deixar o relatório indisponível")
+	}
+	for _, result := range report.Checks {
+		if result.Status != StatusUnavailable {
+			t.Errorf("%s deveria estar indisponível: %+v", result.Name, result)
+		}
+	}
+	if !strings.Contains(report.Checks[1].Error, "sem resposta") {
+		t.Errorf("erro de prazo esperado, obtido %q", report.Checks[1].Error)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	Commit, BuildTime = "abc123", "2026-10-18T12:00:00Z"
+	defer func() { Commit, BuildTime = "", "" }()
+
+	info := Build()
+	if info.Commit != "abc123" || info.BuildTime != "2026-10-18T12:00:00Z" {
+		t.Errorf("dados da compilação deveriam prevalecer: %+v", info)
+	}
+	if info.GoVersion != runtime.Version() {
+		t.Errorf("versão do Go esperada %s, obtida %s", runtime.Version(), info.GoVersion)
+	}
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net/http"
+
+	user "scheduling/internal/app/user"
+	infra "scheduling/internal/infra/gin"
+)
+
+type AccountHandler struct {
+	RequestResetUseCase        *user.RequestPasswordResetUseCase
+	ResetUseCase               *user.ResetPasswordUseCase
+	RequestVerificationUseCase *user.RequestEmailVerificationUseCase
+	VerifyUseCase              *user.VerifyEmailUseCase
+}
+
+func NewAccountHandler(
+	requestReset *user.RequestPasswordResetUseCase,
+	reset *user.ResetPasswordUseCase,
+	requestVerification *user.RequestEmailVerificationUseCase,
+	verify *user.VerifyEmailUseCase,
+) *AccountHandler {
+	return &AccountHandler{
+		RequestResetUseCase:        requestReset,
+		ResetUseCase:               reset,
+		RequestVerificationUseCase: requestVerification,
+		VerifyUseCase:              verify,
+	}
+}
+
+// RequestPasswordReset responde 202 mesmo para emails não cadastrados.
+func (handler *AccountHandler) RequestPasswordReset(ctx infra.Context) error {
+
+	var input user.PasswordResetRequestInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	if err := handler.RequestResetUseCase.Execute(ctx.Context(), input); err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusAccepted)
+	return nil
+}
+
+func (handler *AccountHandler) ResetPassword(ctx infra.Context) error {
+
+	var input user.PasswordResetInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	if err := handler.ResetUseCase.Execute(ctx.Context(), input); err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusNoContent)
+	return nil
+}
+
+func (handler *AccountHandler) RequestEmailVerification(ctx infra.Context) error {
+
+	if err := handler.RequestVerificationUseCase.Execute(ctx.Context()); err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusAccepted)
+	return nil
+}
+
+func (handler *AccountHandler) VerifyEmail(ctx infra.Context) error {
+
+	var input user.VerifyEmailInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	if err := handler.VerifyUseCase.Execute(ctx.Context(), input); err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"scheduling/internal/app/apikey"
+	"scheduling/internal/domain/errs"
+	infra "scheduling/internal/infra/gin"
+)
+
+type APIKeyHandler struct {
+	CreateUseCase *apikey.CreateAPIKeyUseCase
+	ListUseCase   *apikey.ListAPIKeysUseCase
+	RotateUseCase *apikey.RotateAPIKeyUseCase
+	RevokeUseCase *apikey.RevokeAPIKeyUseCase
+}
+
+func NewAPIKeyHandler(
+	create *apikey.CreateAPIKeyUseCase,
+	list *apikey.ListAPIKeysUseCase,
+	rotate *apikey.RotateAPIKeyUseCase,
+	revoke *apikey.RevokeAPIKeyUseCase,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		CreateUseCase: create,
+		ListUseCase:   list,
+		RotateUseCase: rotate,
+		RevokeUseCase: revoke,
+	}
+}
+
+func (handler *APIKeyHandler) Create(ctx infra.Context) error {
+
+	var input apikey.APIKeyInput
+	if err := ctx.BindStrict(&input); err != nil {
+		return err
+	}
+	input.IP = ctx.ClientIP()
+
+	output, err := handler.CreateUseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
+
+func (handler *APIKeyHandler) List(ctx infra.Context) error {
+
+	output, err := handler.ListUseCase.Execute(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+func (handler *APIKeyHandler) Rotate(ctx infra.Context) error {
+
+	id, err := strconv.Atoi(ctx.Param("key_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "key_id")
+	}
+
+	output, err := handler.RotateUseCase.Execute(ctx.Context(), apikey.APIKeyActionInput{ID: id, IP: ctx.ClientIP()})
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
+
+func (handler *APIKeyHandler) Revoke(ctx infra.Context) error {
+
+	id, err := strconv.Atoi(ctx.Param("key_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "key_id")
+	}
+
+	err = handler.RevokeUseCase.Execute(ctx.Context(), apikey.APIKeyActionInput{ID: id, IP: ctx.ClientIP()})
+	if err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusNoContent)
+	return nil
+}
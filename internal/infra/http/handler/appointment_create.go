@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	"scheduling/internal/app/appointment"
+	infra "scheduling/internal/infra/gin"
+)
+
+type AppointmentCreateHandler struct {
+	UseCase *appointment.CreateAppointmentUseCase
+}
+
+func NewAppointmentCreateHandler(usecase *appointment.CreateAppointmentUseCase) *AppointmentCreateHandler {
+	return &AppointmentCreateHandler{UseCase: usecase}
+}
+
+func (handler *AppointmentCreateHandler) Create(ctx infra.Context) error {
+
+	var input appointment.AppointmentInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	output, err := handler.UseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"scheduling/internal/app/appointment"
+	"scheduling/internal/domain/errs"
+	infra "scheduling/internal/infra/gin"
+)
+
+type AppointmentListHandler struct {
+	UseCase *appointment.ListClientAppointmentsUseCase
+}
+
+func NewAppointmentListHandler(usecase *appointment.ListClientAppointmentsUseCase) *AppointmentListHandler {
+	return &AppointmentListHandler{UseCase: usecase}
+}
+
+func (handler *AppointmentListHandler) ListByClient(ctx infra.Context) error {
+
+	clientID, err := strconv.Atoi(ctx.Param("client_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "client_id")
+	}
+
+	appointments, err := handler.UseCase.Execute(ctx.Context(), clientID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, appointments)
+}
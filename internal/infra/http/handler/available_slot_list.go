@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	availableslot "scheduling/internal/app/available_slot"
+	"scheduling/internal/domain/errs"
+	infra "scheduling/internal/infra/gin"
+)
+
+type AvailableSlotListHandler struct {
+	UseCase *availableslot.ListAvailableSlotsUseCase
+}
+
+func NewAvailableSlotListHandler(usecase *availableslot.ListAvailableSlotsUseCase) *AvailableSlotListHandler {
+	return &AvailableSlotListHandler{UseCase: usecase}
+}
+
+func (handler *AvailableSlotListHandler) List(ctx infra.Context) error {
+
+	staffID, err := strconv.Atoi(ctx.Param("staff_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "staff_id")
+	}
+
+	serviceID, err := strconv.Atoi(ctx.Query("service_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "service_id")
+	}
+
+	date, err := time.Parse("2006-01-02", ctx.Query("date"))
+	if err != nil {
+		return errs.Validation("availability.invalid_date")
+	}
+
+	var locationID int
+	if raw := ctx.Query("location_id"); raw != "" {
+		locationID, err = strconv.Atoi(raw)
+		if err != nil {
+			return errs.Validation("request.invalid_param").With("param", "location_id")
+		}
+	}
+
+	input := availableslot.AvailableSlotsInput{
+		StaffID:    staffID,
+		ServiceID:  serviceID,
+		LocationID: locationID,
+		Date:       date,
+	}
+
+	slots, err := handler.UseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, slots)
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	availableslot "scheduling/internal/app/available_slot"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/services"
+	"scheduling/internal/infra/gin/adapter"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestAvailableSlotListHandler_UnknownService(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	serviceRepo := mocks.NewMockServiceRepository()
+	serviceRepo.FindByIDFunc = func(ctx context.Context, id int) (*entities.Service, error) {
+		return nil, repositories.ErrServiceNotFound
+	}
+
+	availability := services.NewAvailabilityService(
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+		mocks.NewMockAvailableSlotRepository(),
+		mocks.NewMockStaffBreakRepository(),
+		mocks.NewMockAppointmentRepository(),
+		serviceRepo,
+		mocks.NewMockResourceRepository(),
+		mocks.NewMockLocationRepository(),
+		nil,
+	)
+	handler := NewAvailableSlotListHandler(availableslot.NewListAvailableSlotsUseCase(availability))
+
+	router := adapter.NewRouter()
+	router.GET("/staff/:staff_id/availability", handler.List)
+
+	req := httptest.NewRequest(nethttp.MethodGet, "/staff/1/availability?service_id=999&date=2025-12-01", nil)
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != nethttp.StatusNotFound {
+		t.Fatalf("status esperado 404, obtido %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var body struct {
+		Code string `json:"code"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("erro ao decodificar a resposta: %v", err)
+	}
+	if body.Code != "service.not_found" {
+		t.Errorf("código esperado 'service.not_found', obtido '%s'", body.Code)
+	}
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+
+	infra "scheduling/internal/infra/gin"
+	"scheduling/internal/infra/health"
+)
+
+type HealthHandler struct {
+	Readiness *health.Checker
+}
+
+func NewHealthHandler(readiness *health.Checker) *HealthHandler {
+	return &HealthHandler{Readiness: readiness}
+}
+
+// Live só confirma que o processo responde; não consulta dependências, para
+// que uma falha no banco não faça o orquestrador reiniciar o processo.
+func (handler *HealthHandler) Live(ctx infra.Context) error {
+
+	ctx.Header("Cache-Control", "no-store")
+	return ctx.JSON(http.StatusOK, health.Report{Status: health.StatusOK, Checks: []health.CheckResult{}})
+}
+
+// Ready responde 503 enquanto alguma dependência estiver indisponível,
+// listando o estado de cada uma.
+func (handler *HealthHandler) Ready(ctx infra.Context) error {
+
+	report := handler.Readiness.Run(ctx.Context())
+
+	status := http.StatusOK
+	if !report.OK() {
+		status = http.StatusServiceUnavailable
+	}
+
+	ctx.Header("Cache-Control", "no-store")
+	return ctx.JSON(status, report)
+}
+
+func (handler *HealthHandler) Version(ctx infra.Context) error {
+
+	return ctx.JSON(http.StatusOK, health.Build())
+}
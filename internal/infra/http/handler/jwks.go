@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"net/http"
+
+	infra "scheduling/internal/infra/gin"
+	"scheduling/internal/infra/jwt"
+)
+
+// JWKSHandler publica as chaves públicas usadas para assinar os tokens de
+// acesso, para que outros serviços os validem sem compartilhar segredo.
+type JWKSHandler struct {
+	Keys *jwt.Manager
+}
+
+func NewJWKSHandler(keys *jwt.Manager) *JWKSHandler {
+	return &JWKSHandler{Keys: keys}
+}
+
+func (handler *JWKSHandler) Get(ctx infra.Context) error {
+	ctx.Header("Cache-Control", "public, max-age=300")
+	return ctx.JSON(http.StatusOK, handler.Keys.JWKS())
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"scheduling/internal/app/location"
+	"scheduling/internal/domain/errs"
+	infra "scheduling/internal/infra/gin"
+)
+
+type LocationCreateHandler struct {
+	CreateUseCase     *location.CreateLocationUseCase
+	AddServiceUseCase *location.AddLocationServiceUseCase
+	TravelTimeUseCase *location.SetTravelTimeUseCase
+}
+
+func NewLocationCreateHandler(
+	createUseCase *location.CreateLocationUseCase,
+	addServiceUseCase *location.AddLocationServiceUseCase,
+	travelTimeUseCase *location.SetTravelTimeUseCase,
+) *LocationCreateHandler {
+	return &LocationCreateHandler{
+		CreateUseCase:     createUseCase,
+		AddServiceUseCase: addServiceUseCase,
+		TravelTimeUseCase: travelTimeUseCase,
+	}
+}
+
+func (handler *LocationCreateHandler) Create(ctx infra.Context) error {
+
+	var input location.LocationInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	output, err := handler.CreateUseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
+
+func (handler *LocationCreateHandler) AddService(ctx infra.Context) error {
+
+	locationID, err := strconv.Atoi(ctx.Param("location_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "location_id")
+	}
+
+	var input location.LocationServiceInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+	input.LocationID = locationID
+
+	if err := handler.AddServiceUseCase.Execute(ctx.Context(), input); err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, input)
+}
+
+func (handler *LocationCreateHandler) SetTravelTime(ctx infra.Context) error {
+
+	locationID, err := strconv.Atoi(ctx.Param("location_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "location_id")
+	}
+
+	var input location.TravelTimeInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+	input.FromLocationID = locationID
+
+	if err := handler.TravelTimeUseCase.Execute(ctx.Context(), input); err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, input)
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+
+	user "scheduling/internal/app/user"
+	"scheduling/internal/domain/errs"
+	infra "scheduling/internal/infra/gin"
+)
+
+type LockoutHandler struct {
+	UnlockAccountUseCase *user.UnlockAccountUseCase
+	UnlockIPUseCase      *user.UnlockIPUseCase
+}
+
+func NewLockoutHandler(unlockAccount *user.UnlockAccountUseCase, unlockIP *user.UnlockIPUseCase) *LockoutHandler {
+	return &LockoutHandler{UnlockAccountUseCase: unlockAccount, UnlockIPUseCase: unlockIP}
+}
+
+func (handler *LockoutHandler) UnlockAccount(ctx infra.Context) error {
+
+	userID, err := strconv.Atoi(ctx.Param("user_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "user_id")
+	}
+
+	err = handler.UnlockAccountUseCase.Execute(ctx.Context(), user.UnlockAccountInput{UserID: userID, IP: ctx.ClientIP()})
+	if err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusNoContent)
+	return nil
+}
+
+func (handler *LockoutHandler) UnlockIP(ctx infra.Context) error {
+
+	ip := net.ParseIP(ctx.Param("ip"))
+	if ip == nil {
+		return errs.Validation("request.invalid_param").With("param", "ip")
+	}
+
+	// o formato canônico é o mesmo devolvido por ClientIP no login
+	err := handler.UnlockIPUseCase.Execute(ctx.Context(), user.UnlockIPInput{Address: ip.String(), IP: ctx.ClientIP()})
+	if err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusNoContent)
+	return nil
+}
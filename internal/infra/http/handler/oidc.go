@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	user "scheduling/internal/app/user"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/services"
+	"scheduling/internal/domain/tenant"
+	infra "scheduling/internal/infra/gin"
+	"scheduling/internal/infra/oidc"
+)
+
+type OIDCHandler struct {
+	RelyingParty *oidc.RelyingParty
+	UseCase      *user.ExternalLoginUseCase
+}
+
+func NewOIDCHandler(relyingParty *oidc.RelyingParty, usecase *user.ExternalLoginUseCase) *OIDCHandler {
+	return &OIDCHandler{RelyingParty: relyingParty, UseCase: usecase}
+}
+
+// Start redireciona para o provedor. Roda dentro do TenantMiddleware: o
+// tenant fica guardado junto com o state e é restaurado no callback.
+func (handler *OIDCHandler) Start(ctx infra.Context) error {
+
+	tenantID, err := tenant.Require(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	authURL, err := handler.RelyingParty.AuthorizationURL(ctx.Context(), ctx.Param("provider"), tenantID)
+	if errors.Is(err, oidc.ErrUnknownProvider) {
+		return ctx.Problem(http.StatusNotFound, err)
+	}
+	if err != nil {
+		return ctx.Problem(http.StatusBadGateway, err)
+	}
+
+	ctx.Header("Location", authURL)
+	ctx.Status(http.StatusFound)
+	return nil
+}
+
+// Callback recebe o retorno do provedor. Fica fora do TenantMiddleware, já
+// que o navegador volta do provedor sem o header de tenant.
+func (handler *OIDCHandler) Callback(ctx infra.Context) error {
+
+	if reason := ctx.Query("error"); reason != "" {
+		return unauthorized(ctx, errs.Unauthorized("auth.provider_refused").With("reason", reason))
+	}
+
+	identity, err := handler.RelyingParty.Callback(ctx.Context(), ctx.Param("provider"), ctx.Query("state"), ctx.Query("code"))
+	if errors.Is(err, oidc.ErrInvalidState) {
+		return ctx.Problem(http.StatusBadRequest, err)
+	}
+	if err != nil {
+		return unauthorized(ctx, err)
+	}
+
+	ctx.SetContext(tenant.WithID(ctx.Context(), identity.TenantID))
+
+	output, err := handler.UseCase.Execute(ctx.Context(), user.ExternalLoginInput{
+		Provider:      identity.Provider,
+		Subject:       identity.Subject,
+		Email:         identity.Email,
+		EmailVerified: identity.EmailVerified,
+		Name:          identity.Name,
+	})
+	if errors.Is(err, services.ErrExternalEmailMissing) {
+		return ctx.Problem(http.StatusUnprocessableEntity, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
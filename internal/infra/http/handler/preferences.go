@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"net/http"
+
+	user "scheduling/internal/app/user"
+	infra "scheduling/internal/infra/gin"
+)
+
+type PreferencesHandler struct {
+	UseCase *user.UpdatePreferencesUseCase
+}
+
+func NewPreferencesHandler(usecase *user.UpdatePreferencesUseCase) *PreferencesHandler {
+	return &PreferencesHandler{UseCase: usecase}
+}
+
+func (handler *PreferencesHandler) Update(ctx infra.Context) error {
+
+	var input user.PreferencesInput
+	if err := ctx.BindStrict(&input); err != nil {
+		return err
+	}
+
+	if err := handler.UseCase.Execute(ctx.Context(), input); err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusNoContent)
+	return nil
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"scheduling/internal/app/resource"
+	"scheduling/internal/domain/errs"
+	infra "scheduling/internal/infra/gin"
+)
+
+type ResourceCreateHandler struct {
+	CreateUseCase  *resource.CreateResourceUseCase
+	RequireUseCase *resource.RequireResourceUseCase
+}
+
+func NewResourceCreateHandler(
+	createUseCase *resource.CreateResourceUseCase,
+	requireUseCase *resource.RequireResourceUseCase,
+) *ResourceCreateHandler {
+	return &ResourceCreateHandler{CreateUseCase: createUseCase, RequireUseCase: requireUseCase}
+}
+
+func (handler *ResourceCreateHandler) Create(ctx infra.Context) error {
+
+	var input resource.ResourceInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	output, err := handler.CreateUseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
+
+func (handler *ResourceCreateHandler) Require(ctx infra.Context) error {
+
+	serviceID, err := strconv.Atoi(ctx.Param("service_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "service_id")
+	}
+
+	var input resource.RequirementInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+	input.ServiceID = serviceID
+
+	output, err := handler.RequireUseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"net/http"
+
+	"scheduling/internal/app/settings"
+	infra "scheduling/internal/infra/gin"
+)
+
+type SettingsHandler struct {
+	GetUseCase    *settings.GetSettingsUseCase
+	UpdateUseCase *settings.UpdateSettingsUseCase
+}
+
+func NewSettingsHandler(
+	getUseCase *settings.GetSettingsUseCase,
+	updateUseCase *settings.UpdateSettingsUseCase,
+) *SettingsHandler {
+	return &SettingsHandler{
+		GetUseCase:    getUseCase,
+		UpdateUseCase: updateUseCase,
+	}
+}
+
+func (handler *SettingsHandler) Get(ctx infra.Context) error {
+
+	output, err := handler.GetUseCase.Execute(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+func (handler *SettingsHandler) Update(ctx infra.Context) error {
+
+	var input settings.SettingsInput
+	if err := ctx.BindStrict(&input); err != nil {
+		return err
+	}
+
+	output, err := handler.UpdateUseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	staffbreak "scheduling/internal/app/staff_break"
+	"scheduling/internal/domain/errs"
+	infra "scheduling/internal/infra/gin"
+)
+
+type StaffBreakCreateHandler struct {
+	UseCase *staffbreak.CreateStaffBreakUseCase
+}
+
+func NewStaffBreakCreateHandler(usecase *staffbreak.CreateStaffBreakUseCase) *StaffBreakCreateHandler {
+	return &StaffBreakCreateHandler{UseCase: usecase}
+}
+
+func (handler *StaffBreakCreateHandler) Create(ctx infra.Context) error {
+
+	staffID, err := strconv.Atoi(ctx.Param("staff_id"))
+	if err != nil {
+		return errs.Validation("request.invalid_param").With("param", "staff_id")
+	}
+
+	var input staffbreak.StaffBreakInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+	input.StaffID = staffID
+
+	output, err := handler.UseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, output)
+}
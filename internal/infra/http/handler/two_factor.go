@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	user "scheduling/internal/app/user"
+	"scheduling/internal/domain/services"
+	infra "scheduling/internal/infra/gin"
+)
+
+type TwoFactorHandler struct {
+	VerifyUseCase     *user.VerifyMFAUseCase
+	EnrollUseCase     *user.EnrollTOTPUseCase
+	ConfirmUseCase    *user.ConfirmTOTPUseCase
+	DisableUseCase    *user.DisableTOTPUseCase
+	RegenerateUseCase *user.RegenerateRecoveryCodesUseCase
+}
+
+func NewTwoFactorHandler(
+	verify *user.VerifyMFAUseCase,
+	enroll *user.EnrollTOTPUseCase,
+	confirm *user.ConfirmTOTPUseCase,
+	disable *user.DisableTOTPUseCase,
+	regenerate *user.RegenerateRecoveryCodesUseCase,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		VerifyUseCase:     verify,
+		EnrollUseCase:     enroll,
+		ConfirmUseCase:    confirm,
+		DisableUseCase:    disable,
+		RegenerateUseCase: regenerate,
+	}
+}
+
+func (handler *TwoFactorHandler) Verify(ctx infra.Context) error {
+
+	var input user.MFAVerifyInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+	input.IP = ctx.ClientIP()
+
+	output, err := handler.VerifyUseCase.Execute(ctx.Context(), input)
+	var locked *services.LoginLockedError
+	if errors.As(err, &locked) {
+		ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+		return ctx.Problem(http.StatusTooManyRequests, err)
+	}
+	// no login um código errado é falha de autenticação, não de validação
+	if errors.Is(err, services.ErrMFAChallengeExpired) || errors.Is(err, services.ErrInvalidMFACode) {
+		return unauthorized(ctx, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+func (handler *TwoFactorHandler) Enroll(ctx infra.Context) error {
+
+	output, err := handler.EnrollUseCase.Execute(ctx.Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+func (handler *TwoFactorHandler) Confirm(ctx infra.Context) error {
+
+	var input user.TOTPCodeInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	output, err := handler.ConfirmUseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+func (handler *TwoFactorHandler) Disable(ctx infra.Context) error {
+
+	var input user.TOTPCodeInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	if err := handler.DisableUseCase.Execute(ctx.Context(), input); err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusNoContent)
+	return nil
+}
+
+func (handler *TwoFactorHandler) RegenerateRecoveryCodes(ctx infra.Context) error {
+
+	var input user.TOTPCodeInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	output, err := handler.RegenerateUseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
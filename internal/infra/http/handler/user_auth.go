@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+
+	user "scheduling/internal/app/user"
+	"scheduling/internal/domain/services"
+	infra "scheduling/internal/infra/gin"
+)
+
+type UserAuthHandler struct {
+	UseCase        *user.AuthUseCase
+	RefreshUseCase *user.RefreshTokenUseCase
+	LogoutUseCase  *user.LogoutUseCase
+}
+
+func NewUserAuthHandler(usecase *user.AuthUseCase, refresh *user.RefreshTokenUseCase, logout *user.LogoutUseCase) *UserAuthHandler {
+	return &UserAuthHandler{UseCase: usecase, RefreshUseCase: refresh, LogoutUseCase: logout}
+}
+
+func (handler *UserAuthHandler) Login(ctx infra.Context) error {
+
+	var input user.UserAuthInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+	input.IP = ctx.ClientIP()
+
+	output, err := handler.UseCase.Execute(ctx.Context(), input)
+	var locked *services.LoginLockedError
+	if errors.As(err, &locked) {
+		ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+		return ctx.Problem(http.StatusTooManyRequests, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+func (handler *UserAuthHandler) Refresh(ctx infra.Context) error {
+
+	var input user.RefreshTokenInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	output, err := handler.RefreshUseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, output)
+}
+
+func (handler *UserAuthHandler) Logout(ctx infra.Context) error {
+
+	var input user.RefreshTokenInput
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	if err := handler.LogoutUseCase.Execute(ctx.Context(), input); err != nil {
+		return err
+	}
+
+	ctx.Status(http.StatusNoContent)
+	return nil
+}
+
+func unauthorized(ctx infra.Context, err error) error {
+	return ctx.Problem(http.StatusUnauthorized, err)
+}
@@ -1,7 +1,6 @@
 package handler
 
 import (
-	"context"
 	"net/http"
 
 	user "scheduling/internal/app/user"
@@ -9,41 +8,42 @@ import (
 )
 
 type UserCreateHandler struct {
-	UseCase *user.CreateUserUseCase
+	UseCase      *user.CreateUserUseCase
+	AdminUseCase *user.CreateUserByAdminUseCase
 }
 
-func NewUserCreateHandler(usecase *user.CreateUserUseCase) *UserCreateHandler {
-	return &UserCreateHandler{UseCase: usecase}
+func NewUserCreateHandler(usecase *user.CreateUserUseCase, adminUseCase *user.CreateUserByAdminUseCase) *UserCreateHandler {
+	return &UserCreateHandler{UseCase: usecase, AdminUseCase: adminUseCase}
 }
 
 func (handler *UserCreateHandler) Create(ctx infra.Context) error {
 
-	name := ctx.Query("name");
-	email := ctx.Query("email");
-	password := ctx.Query("password")
-	role := ctx.Query("role")
+	var input user.UserInput
+	if err := ctx.BindStrict(&input); err != nil {
+		return err
+	}
 
-	ctxb := context.Background()
+	output, err := handler.UseCase.Execute(ctx.Context(), input)
+	if err != nil {
+		return err
+	}
 
-	input := user.UserInput{
-		Name: name,
-		Email: email,
-		Password: password,
-		Role: role,
+	return ctx.JSON(http.StatusCreated, output)
+}
+
+// CreateByAdmin cadastra profissionais e administradores; o cadastro público
+// (Create) só cria clientes.
+func (handler *UserCreateHandler) CreateByAdmin(ctx infra.Context) error {
+
+	var input user.AdminUserInput
+	if err := ctx.BindStrict(&input); err != nil {
+		return err
 	}
-	users, err := handler.UseCase.Execute(ctxb, input)
+
+	output, err := handler.AdminUseCase.Execute(ctx.Context(), input)
 	if err != nil {
-		return ctx.JSON(
-			http.StatusInternalServerError, 
-			map[string]string{
-				"error": err.Error(),
-			},
-		)
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, 
-		map[string]interface{}{
-			"usuario criado com success ": users,
-		},
-	)
+	return ctx.JSON(http.StatusCreated, output)
 }
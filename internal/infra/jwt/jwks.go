@@ -0,0 +1,61 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"sort"
+)
+
+// JWK segue a RFC 7517. Só chaves públicas são publicadas; o segredo HS256
+// nunca aparece no JWKS.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS retorna as chaves públicas aceitas por VerifyToken, incluindo as que
+// já não assinam mas ainda validam tokens emitidos antes da rotação.
+func (m *Manager) JWKS() JWKS {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	jwks := JWKS{Keys: []JWK{}}
+	for _, k := range m.keys.byKID {
+		switch public := k.public.(type) {
+		case *rsa.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "RSA",
+				Kid: k.kid,
+				Use: "sig",
+				Alg: k.method.Alg(),
+				N:   base64.RawURLEncoding.EncodeToString(public.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes()),
+			})
+		case ed25519.PublicKey:
+			jwks.Keys = append(jwks.Keys, JWK{
+				Kty: "OKP",
+				Kid: k.kid,
+				Use: "sig",
+				Alg: k.method.Alg(),
+				Crv: "Ed25519",
+				X:   base64.RawURLEncoding.EncodeToString(public),
+			})
+		}
+	}
+
+	sort.Slice(jwks.Keys, func(i, j int) bool { return jwks.Keys[i].Kid < jwks.Keys[j].Kid })
+
+	return jwks
+}
@@ -1,42 +1,208 @@
 package jwt
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"scheduling/internal/domain/token"
 )
 
-var secretKey = os.Getenv("JWT_SECRET_KEY")
+const defaultAccessTTL = 15 * time.Minute
+
+type accessClaims struct {
+	Role          string `json:"role"`
+	TenantID      int    `json:"tenant_id"`
+	EmailVerified bool   `json:"email_verified"`
+	MFA           bool   `json:"mfa"`
+	Locale        string `json:"locale,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Manager emite tokens de acesso de vida curta. A renovação da sessão é feita
+// pelos refresh tokens guardados no banco.
+//
+// Com NewManager os tokens são HS256 e só esta API consegue validá-los. Com
+// NewManagerFromDir são RS256 ou EdDSA, levam o kid da chave no cabeçalho e
+// outros serviços validam pelas chaves públicas publicadas em JWKS.
+type Manager struct {
+	mu         sync.RWMutex
+	keys       *keySet
+	keysDir    string
+	signingKID string
+
+	accessTTL time.Duration
+	now       func() time.Time
+}
+
+func NewManager(secret string, accessTTL time.Duration) (*Manager, error) {
+	if secret == "" {
+		return nil, errors.New("a chave de assinatura do JWT não pode ser vazia")
+	}
 
-func CreateToken(username string) (string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256,
-		jwt.MapClaims{
-			"username": username,
-			"exp":      time.Now().Add(time.Hour * 24).Unix(),
-		})
+	hmac := &key{method: jwt.SigningMethodHS256, private: []byte(secret), public: []byte(secret)}
+	return newManager(newKeySet(hmac, hmac), accessTTL)
+}
+
+// NewManagerFromDir carrega as chaves PEM de dir (veja loadKeyDir). Reload
+// relê o diretório para rotacionar as chaves sem reiniciar a API.
+func NewManagerFromDir(dir, signingKID string, accessTTL time.Duration) (*Manager, error) {
+	keys, err := loadKeyDir(dir, signingKID)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := newManager(keys, accessTTL)
+	if err != nil {
+		return nil, err
+	}
+	m.keysDir = dir
+	m.signingKID = signingKID
+
+	return m, nil
+}
+
+func newManager(keys *keySet, accessTTL time.Duration) (*Manager, error) {
+	if accessTTL <= 0 {
+		return nil, errors.New("a validade do token de acesso deve ser positiva")
+	}
+
+	return &Manager{
+		keys:      keys,
+		accessTTL: accessTTL,
+		now:       time.Now,
+	}, nil
+}
+
+// NewManagerFromEnv lê a configuração no momento da chamada, não na
+// inicialização do pacote. Com JWT_KEYS_DIR definido usa as chaves PEM do
+// diretório (JWT_SIGNING_KEY_ID escolhe a chave de assinatura); caso
+// contrário usa JWT_SECRET_KEY com HS256. JWT_ACCESS_TTL é uma duração no
+// formato do Go, ex.: "15m".
+func NewManagerFromEnv() (*Manager, error) {
+	accessTTL := defaultAccessTTL
+	if value := os.Getenv("JWT_ACCESS_TTL"); value != "" {
+		ttl, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("JWT_ACCESS_TTL inválido: %w", err)
+		}
+		accessTTL = ttl
+	}
+
+	if dir := os.Getenv("JWT_KEYS_DIR"); dir != "" {
+		return NewManagerFromDir(dir, os.Getenv("JWT_SIGNING_KEY_ID"), accessTTL)
+	}
+
+	return NewManager(os.Getenv("JWT_SECRET_KEY"), accessTTL)
+}
+
+// Reload relê o diretório de chaves. Em caso de erro as chaves atuais são
+// mantidas.
+func (m *Manager) Reload() error {
+	if m.keysDir == "" {
+		return nil
+	}
 
-	tokenString, err := token.SignedString(secretKey)
+	keys, err := loadKeyDir(m.keysDir, m.signingKID)
 	if err != nil {
-		return "", err
-	}
-
-	return tokenString, nil
-}
-
-func VerifyToken(tokenString string) error {
-   token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-      return secretKey, nil
-   })
-  
-   if err != nil {
-      return err
-   }
-  
-   if !token.Valid {
-      return fmt.Errorf("invalid token")
-   }
-  
-   return nil
+		return err
+	}
+
+	m.mu.Lock()
+	m.keys = keys
+	m.mu.Unlock()
+
+	return nil
+}
+
+func (m *Manager) CreateToken(claims token.Claims) (string, time.Time, error) {
+	now := m.now()
+	expiresAt := now.Add(m.accessTTL)
+
+	m.mu.RLock()
+	signing := m.keys.signing
+	m.mu.RUnlock()
+
+	t := jwt.NewWithClaims(signing.method, accessClaims{
+		Role:          claims.Role,
+		TenantID:      claims.TenantID,
+		EmailVerified: claims.EmailVerified,
+		MFA:           claims.MFA,
+		Locale:        claims.Locale,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   strconv.Itoa(claims.UserID),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+
+	if signing.kid != "" {
+		t.Header["kid"] = signing.kid
+	}
+
+	tokenString, err := t.SignedString(signing.private)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return tokenString, expiresAt, nil
+}
+
+func (m *Manager) VerifyToken(tokenString string) (*token.Claims, error) {
+	var parsed accessClaims
+	_, err := jwt.ParseWithClaims(tokenString, &parsed, m.verificationKey,
+		jwt.WithValidMethods([]string{
+			jwt.SigningMethodHS256.Alg(),
+			jwt.SigningMethodRS256.Alg(),
+			jwt.SigningMethodEdDSA.Alg(),
+		}),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(m.now),
+	)
+	if err != nil {
+		return nil, token.ErrInvalidToken
+	}
+
+	userID, err := strconv.Atoi(parsed.Subject)
+	if err != nil {
+		return nil, token.ErrInvalidToken
+	}
+
+	return &token.Claims{
+		ID:            parsed.ID,
+		UserID:        userID,
+		Role:          parsed.Role,
+		TenantID:      parsed.TenantID,
+		EmailVerified: parsed.EmailVerified,
+		MFA:           parsed.MFA,
+		Locale:        parsed.Locale,
+		ExpiresAt:     parsed.ExpiresAt.Time,
+	}, nil
+}
+
+// verificationKey escolhe a chave pelo kid do cabeçalho e exige que o alg do
+// token seja o da chave, impedindo por exemplo um token HS256 assinado com a
+// chave pública RSA.
+func (m *Manager) verificationKey(t *jwt.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+
+	m.mu.RLock()
+	k, ok := m.keys.byKID[kid]
+	m.mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("kid desconhecido: %q", kid)
+	}
+	if t.Method.Alg() != k.method.Alg() {
+		return nil, fmt.Errorf("algoritmo %s não corresponde à chave %q", t.Method.Alg(), kid)
+	}
+
+	return k.public, nil
 }
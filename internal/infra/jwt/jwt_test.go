@@ -0,0 +1,80 @@
+package jwt
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"scheduling/internal/domain/token"
+)
+
+func newTestManager(t *testing.T, secret string) *Manager {
+	m, err := NewManager(secret, 15*time.Minute)
+	if err != nil {
+		t.Fatalf("falha ao criar manager: %v", err)
+	}
+	return m
+}
+
+func TestManager_CreateAndVerify(t *testing.T) {
+	m := newTestManager(t, "segredo")
+
+	tokenString, expiresAt, err := m.CreateToken(token.Claims{UserID: 7, Role: "admin", TenantID: 3})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	claims, err := m.VerifyToken(tokenString)
+	if err != nil {
+		t.Fatalf("erro inesperado ao verificar: %v", err)
+	}
+	if claims.UserID != 7 || claims.Role != "admin" || claims.TenantID != 3 {
+		t.Errorf("claims incorretos: %+v", claims)
+	}
+	if claims.ID == "" {
+		t.Error("o token deveria ter um identificador (jti)")
+	}
+	if !claims.ExpiresAt.Equal(expiresAt.Truncate(time.Second)) {
+		t.Errorf("expiração esperada %v, obtida %v", expiresAt, claims.ExpiresAt)
+	}
+}
+
+func TestManager_VerifyRejects(t *testing.T) {
+	m := newTestManager(t, "segredo")
+	valid, _, _ := m.CreateToken(token.Claims{UserID: 7, Role: "client", TenantID: 3})
+
+	expiredManager := newTestManager(t, "segredo")
+	expiredManager.now = func() time.Time { return time.Now().Add(-time.Hour) }
+	expired, _, _ := expiredManager.CreateToken(token.Claims{UserID: 7, Role: "client", TenantID: 3})
+
+	otherSecret, _, _ := newTestManager(t, "outro-segredo").CreateToken(token.Claims{UserID: 7, Role: "client", TenantID: 3})
+
+	unsigned, _ := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+		"sub": "7",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+
+	tests := map[string]string{
+		"token expirado":              expired,
+		"assinatura de outro segredo": otherSecret,
+		"token sem assinatura":        unsigned,
+		"token adulterado":            valid + "x",
+		"token malformado":            "invalido",
+	}
+
+	for name, tokenString := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := m.VerifyToken(tokenString); !errors.Is(err, token.ErrInvalidToken) {
+				t.Errorf("esperado ErrInvalidToken, obtido %v", err)
+			}
+		})
+	}
+}
+
+func TestNewManager_EmptySecret(t *testing.T) {
+	if _, err := NewManager("", time.Minute); err == nil {
+		t.Error("segredo vazio deveria retornar erro")
+	}
+}
@@ -0,0 +1,126 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minRSABits = 2048
+
+// key é uma chave identificada por kid. Chaves só com a parte pública servem
+// apenas para validar tokens emitidos antes de uma rotação.
+type key struct {
+	kid     string
+	method  jwt.SigningMethod
+	private any
+	public  any
+}
+
+func (k *key) canSign() bool { return k.private != nil }
+
+type keySet struct {
+	signing *key
+	byKID   map[string]*key
+}
+
+func newKeySet(signing *key, keys ...*key) *keySet {
+	set := &keySet{signing: signing, byKID: map[string]*key{}}
+	for _, k := range keys {
+		set.byKID[k.kid] = k
+	}
+	return set
+}
+
+// loadKeyDir lê todos os arquivos .pem de dir; o nome do arquivo sem extensão
+// é o kid. A chave de assinatura é signingKID ou, se vazio, a última chave
+// privada em ordem alfabética, o que permite rotacionar adicionando arquivos
+// nomeados por data (ex.: 2026-01.pem).
+func loadKeyDir(dir, signingKID string) (*keySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	var keys []*key
+	var signing *key
+	for _, path := range paths {
+		kid := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		k, err := parseKey(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("chave %s: %w", filepath.Base(path), err)
+		}
+		keys = append(keys, k)
+
+		if k.canSign() && (signingKID == "" || signingKID == kid) {
+			signing = k
+		}
+	}
+
+	if signing == nil {
+		if signingKID != "" {
+			return nil, fmt.Errorf("chave privada %q não encontrada em %s", signingKID, dir)
+		}
+		return nil, fmt.Errorf("nenhuma chave privada encontrada em %s", dir)
+	}
+
+	return newKeySet(signing, keys...), nil
+}
+
+func parseKey(kid string, data []byte) (*key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("arquivo PEM inválido")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("tipo PEM não suportado: %s", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSABits {
+			return nil, errors.New("chaves RSA devem ter ao menos 2048 bits")
+		}
+		return &key{kid: kid, method: jwt.SigningMethodRS256, private: k, public: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSABits {
+			return nil, errors.New("chaves RSA devem ter ao menos 2048 bits")
+		}
+		return &key{kid: kid, method: jwt.SigningMethodRS256, public: k}, nil
+	case ed25519.PrivateKey:
+		return &key{kid: kid, method: jwt.SigningMethodEdDSA, private: k, public: k.Public()}, nil
+	case ed25519.PublicKey:
+		return &key{kid: kid, method: jwt.SigningMethodEdDSA, public: k}, nil
+	default:
+		return nil, errors.New("algoritmo de chave não suportado: use RSA ou Ed25519")
+	}
+}
@@ -0,0 +1,218 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"scheduling/internal/domain/token"
+)
+
+func writePEM(t *testing.T, dir, name, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+		t.Fatalf("falha ao gravar chave: %v", err)
+	}
+}
+
+func writeEd25519Key(t *testing.T, dir, name string) ed25519.PrivateKey {
+	t.Helper()
+	_, private, _ := ed25519.GenerateKey(rand.Reader)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatalf("falha ao serializar chave: %v", err)
+	}
+	writePEM(t, dir, name, "PRIVATE KEY", der)
+	return private
+}
+
+func writeRSAKey(t *testing.T, dir, name string) *rsa.PrivateKey {
+	t.Helper()
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("falha ao gerar chave RSA: %v", err)
+	}
+	writePEM(t, dir, name, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(private))
+	return private
+}
+
+func kidOf(t *testing.T, tokenString string) string {
+	t.Helper()
+	parsed, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		t.Fatalf("token malformado: %v", err)
+	}
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
+
+func TestManagerFromDir_SignsWithKeyID(t *testing.T) {
+	tests := map[string]struct {
+		write func(t *testing.T, dir string)
+		alg   string
+	}{
+		"EdDSA": {write: func(t *testing.T, dir string) { writeEd25519Key(t, dir, "2026-01.pem") }, alg: "EdDSA"},
+		"RS256": {write: func(t *testing.T, dir string) { writeRSAKey(t, dir, "2026-01.pem") }, alg: "RS256"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			tt.write(t, dir)
+
+			m, err := NewManagerFromDir(dir, "", time.Minute)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			tokenString, _, err := m.CreateToken(token.Claims{UserID: 7, Role: "admin", TenantID: 3})
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			parsed, _, _ := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+			if parsed.Method.Alg() != tt.alg || kidOf(t, tokenString) != "2026-01" {
+				t.Errorf("cabeçalho incorreto: alg=%s kid=%s", parsed.Method.Alg(), kidOf(t, tokenString))
+			}
+
+			claims, err := m.VerifyToken(tokenString)
+			if err != nil {
+				t.Fatalf("erro inesperado ao verificar: %v", err)
+			}
+			if claims.UserID != 7 || claims.TenantID != 3 {
+				t.Errorf("claims incorretos: %+v", claims)
+			}
+		})
+	}
+}
+
+func TestManagerFromDir_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	oldKey := writeEd25519Key(t, dir, "2026-01.pem")
+
+	m, err := NewManagerFromDir(dir, "", time.Minute)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	oldToken, _, _ := m.CreateToken(token.Claims{UserID: 7, Role: "client", TenantID: 3})
+
+	// nova chave entra; a antiga fica só com a parte pública
+	writeEd25519Key(t, dir, "2026-02.pem")
+	publicDER, _ := x509.MarshalPKIXPublicKey(oldKey.Public())
+	writePEM(t, dir, "2026-01.pem", "PUBLIC KEY", publicDER)
+
+	if err := m.Reload(); err != nil {
+		t.Fatalf("erro inesperado ao recarregar: %v", err)
+	}
+
+	newToken, _, _ := m.CreateToken(token.Claims{UserID: 7, Role: "client", TenantID: 3})
+	if kidOf(t, newToken) != "2026-02" {
+		t.Errorf("novos tokens deveriam usar a chave 2026-02, obtido %s", kidOf(t, newToken))
+	}
+	if _, err := m.VerifyToken(oldToken); err != nil {
+		t.Errorf("token emitido antes da rotação deveria continuar válido: %v", err)
+	}
+
+	// remover a chave antiga invalida os tokens assinados por ela
+	os.Remove(filepath.Join(dir, "2026-01.pem"))
+	if err := m.Reload(); err != nil {
+		t.Fatalf("erro inesperado ao recarregar: %v", err)
+	}
+	if _, err := m.VerifyToken(oldToken); !errors.Is(err, token.ErrInvalidToken) {
+		t.Errorf("token de chave removida deveria ser recusado, obtido %v", err)
+	}
+	if _, err := m.VerifyToken(newToken); err != nil {
+		t.Errorf("token da chave atual deveria ser válido: %v", err)
+	}
+}
+
+func TestManagerFromDir_SigningKeyID(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "a.pem")
+	writeEd25519Key(t, dir, "b.pem")
+
+	m, err := NewManagerFromDir(dir, "a", time.Minute)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	tokenString, _, _ := m.CreateToken(token.Claims{UserID: 1})
+	if kidOf(t, tokenString) != "a" {
+		t.Errorf("kid esperado 'a', obtido %s", kidOf(t, tokenString))
+	}
+
+	if _, err := NewManagerFromDir(dir, "c", time.Minute); err == nil {
+		t.Error("kid de assinatura inexistente deveria retornar erro")
+	}
+	if _, err := NewManagerFromDir(t.TempDir(), "", time.Minute); err == nil {
+		t.Error("diretório sem chaves privadas deveria retornar erro")
+	}
+}
+
+func TestManagerFromDir_RejectsForeignTokens(t *testing.T) {
+	dir := t.TempDir()
+	writeEd25519Key(t, dir, "atual.pem")
+	m, _ := NewManagerFromDir(dir, "", time.Minute)
+
+	// token HS256 de outro manager e token com kid desconhecido
+	hmacToken, _, _ := newTestManager(t, "segredo").CreateToken(token.Claims{UserID: 1})
+
+	otherDir := t.TempDir()
+	writeEd25519Key(t, otherDir, "outra.pem")
+	other, _ := NewManagerFromDir(otherDir, "", time.Minute)
+	otherToken, _, _ := other.CreateToken(token.Claims{UserID: 1})
+
+	for name, tokenString := range map[string]string{"HS256 sem kid": hmacToken, "kid desconhecido": otherToken} {
+		if _, err := m.VerifyToken(tokenString); !errors.Is(err, token.ErrInvalidToken) {
+			t.Errorf("%s: esperado ErrInvalidToken, obtido %v", name, err)
+		}
+	}
+}
+
+func TestManager_JWKS(t *testing.T) {
+	dir := t.TempDir()
+	rsaKey := writeRSAKey(t, dir, "rsa.pem")
+	edKey := writeEd25519Key(t, dir, "ed.pem")
+
+	m, err := NewManagerFromDir(dir, "", time.Minute)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	jwks := m.JWKS()
+	if len(jwks.Keys) != 2 {
+		t.Fatalf("esperadas 2 chaves, obtidas %d", len(jwks.Keys))
+	}
+
+	ed, rsaJWK := jwks.Keys[0], jwks.Keys[1]
+	if ed.Kid != "ed" || ed.Kty != "OKP" || ed.Crv != "Ed25519" || ed.Alg != "EdDSA" || ed.Use != "sig" {
+		t.Errorf("JWK Ed25519 incorreta: %+v", ed)
+	}
+	if x, _ := base64.RawURLEncoding.DecodeString(ed.X); string(x) != string(edKey.Public().(ed25519.PublicKey)) {
+		t.Error("x da JWK Ed25519 não corresponde à chave pública")
+	}
+
+	if rsaJWK.Kid != "rsa" || rsaJWK.Kty != "RSA" || rsaJWK.Alg != "RS256" {
+		t.Errorf("JWK RSA incorreta: %+v", rsaJWK)
+	}
+	n, _ := base64.RawURLEncoding.DecodeString(rsaJWK.N)
+	e, _ := base64.RawURLEncoding.DecodeString(rsaJWK.E)
+	if new(big.Int).SetBytes(n).Cmp(rsaKey.N) != 0 || int(new(big.Int).SetBytes(e).Int64()) != rsaKey.E {
+		t.Error("n/e da JWK RSA não correspondem à chave pública")
+	}
+
+	if keys := newTestManager(t, "segredo").JWKS().Keys; len(keys) != 0 {
+		t.Errorf("o segredo HS256 não pode ser publicado, obtidas %d chaves", len(keys))
+	}
+}
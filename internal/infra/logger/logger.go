@@ -12,7 +12,7 @@ func SetupLogger() *slog.Logger {
 		AddSource: true,
 	})
 
-	logger := slog.New(handler)
+	logger := slog.New(traceHandler{handler})
     
     slog.SetDefault(logger)
     
@@ -0,0 +1,35 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceHandler(t *testing.T) {
+	var out bytes.Buffer
+	logger := slog.New(traceHandler{slog.NewTextHandler(&out, nil)}).With("operation", "booking_service.book")
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:  trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+	})
+	logger.ErrorContext(trace.ContextWithSpanContext(context.Background(), spanContext), "Erro ao gravar agendamento")
+
+	line := out.String()
+	for _, want := range []string{"trace_id=4bf92f3577b34da6a3ce929d0e0e4736", "span_id=00f067aa0ba902b7", "operation=booking_service.book"} {
+		if !strings.Contains(line, want) {
+			t.Errorf("log deveria conter %s: %s", want, line)
+		}
+	}
+
+	out.Reset()
+	logger.Error("Erro fora de requisição")
+	if strings.Contains(out.String(), "trace_id") {
+		t.Errorf("log sem span não deveria ter trace_id: %s", out.String())
+	}
+}
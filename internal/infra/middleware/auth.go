@@ -0,0 +1,271 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/i18n"
+	"scheduling/internal/domain/services"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/token"
+	http "scheduling/internal/infra/gin"
+)
+
+const (
+	principalKey string = "principal"
+	apiKeyHeader string = "X-API-Key"
+)
+
+// APIKeyVerifier autentica as chaves de API enviadas pelas integrações.
+// Implementado por services.APIKeyService.
+type APIKeyVerifier interface {
+	Verify(ctx context.Context, plainKey string) (auth.Principal, error)
+}
+
+// Authenticate exige um token de acesso válido ou uma chave de API no header
+// X-API-Key e guarda o principal no contexto da requisição. Deve rodar depois
+// do TenantMiddleware, que já garante que o tenant do token é o tenant da
+// requisição; chaves de API são buscadas no tenant resolvido por ele.
+func Authenticate(tokens token.Issuer, apiKeys APIKeyVerifier) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			principal, found, err := principalFromRequest(ctx, tokens, apiKeys)
+			if !found {
+				return unauthenticated(ctx)
+			}
+			if err != nil {
+				return rejectCredential(ctx, err)
+			}
+
+			setPrincipal(ctx, principal)
+			return next(ctx)
+		}
+	}
+}
+
+// OptionalAuthenticate autentica a requisição quando ela traz credenciais e
+// deixa passar as anônimas. Credenciais inválidas são recusadas, para que uma
+// integração mal configurada perceba o erro.
+func OptionalAuthenticate(tokens token.Issuer, apiKeys APIKeyVerifier) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			principal, found, err := principalFromRequest(ctx, tokens, apiKeys)
+			if !found {
+				return next(ctx)
+			}
+			if err != nil {
+				return rejectCredential(ctx, err)
+			}
+
+			setPrincipal(ctx, principal)
+			return next(ctx)
+		}
+	}
+}
+
+// principalFromRequest lê a chave de API ou, na falta dela, o token de
+// acesso. found é false quando a requisição não traz nenhum dos dois; err é o
+// motivo da recusa da credencial.
+func principalFromRequest(ctx http.Context, tokens token.Issuer, apiKeys APIKeyVerifier) (auth.Principal, bool, error) {
+	if key := ctx.GetHeader(apiKeyHeader); key != "" {
+		if apiKeys == nil {
+			return auth.Principal{}, true, auth.ErrUnauthenticated
+		}
+		principal, err := apiKeys.Verify(ctx.Context(), key)
+		if errors.Is(err, services.ErrInvalidAPIKey) {
+			return auth.Principal{}, true, auth.ErrUnauthenticated
+		}
+		if err != nil {
+			return auth.Principal{}, true, errs.Wrap(errs.KindInternal, "internal", err)
+		}
+		return principal, true, nil
+	}
+
+	header := ctx.GetHeader("Authorization")
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return auth.Principal{}, false, nil
+	}
+
+	claims, err := tokens.VerifyToken(strings.TrimPrefix(header, bearerPrefix))
+	if err != nil {
+		return auth.Principal{}, true, auth.ErrUnauthenticated
+	}
+
+	if tenantID, ok := tenant.FromContext(ctx.Context()); ok && claims.TenantID != tenantID {
+		return auth.Principal{}, true, auth.ErrForbidden
+	}
+
+	return auth.Principal{
+		UserID:        claims.UserID,
+		Role:          claims.Role,
+		TenantID:      claims.TenantID,
+		EmailVerified: claims.EmailVerified,
+		MFA:           claims.MFA,
+		Locale:        claims.Locale,
+	}, true, nil
+}
+
+func setPrincipal(ctx http.Context, principal auth.Principal) {
+	ctx.Set(principalKey, principal)
+	ctx.SetContext(auth.WithPrincipal(ctx.Context(), principal))
+
+	if locale, ok := i18n.Parse(principal.Locale); ok {
+		setLocale(ctx, locale)
+	}
+}
+
+// rejectCredential responde à credencial recusada; falhas ao verificá-la
+// seguem para o tratamento central de erros como erro interno.
+func rejectCredential(ctx http.Context, err error) error {
+	switch {
+	case errors.Is(err, auth.ErrUnauthenticated):
+		return unauthenticated(ctx)
+	case errors.Is(err, auth.ErrForbidden):
+		return forbidden(ctx)
+	default:
+		return err
+	}
+}
+
+// RequireRole libera a rota apenas para os papéis informados. Responde 401
+// quando a requisição não passou por Authenticate.
+func RequireRole(roles ...string) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			principal, ok := auth.FromContext(ctx.Context())
+			if !ok {
+				return unauthenticated(ctx)
+			}
+			if !principal.HasRole(roles...) {
+				return forbidden(ctx)
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// RequireRoleOrScope libera usuários com um dos papéis e chaves de API com o
+// escopo informado.
+func RequireRoleOrScope(scope string, roles ...string) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			principal, ok := auth.FromContext(ctx.Context())
+			if !ok {
+				return unauthenticated(ctx)
+			}
+			if principal.IsAPIKey() && !principal.HasScope(scope) {
+				return forbidden(ctx)
+			}
+			if !principal.IsAPIKey() && !principal.HasRole(roles...) {
+				return forbidden(ctx)
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// RequireScope recusa chaves de API sem o escopo informado. Usuários e
+// requisições anônimas seguem para o restante da cadeia.
+func RequireScope(scope string) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			if principal, ok := auth.FromContext(ctx.Context()); ok && principal.IsAPIKey() && !principal.HasScope(scope) {
+				return forbidden(ctx)
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// RequireClient libera qualquer usuário autenticado: profissionais e
+// administradores também podem agir como clientes.
+func RequireClient() http.MiddlewareFunc {
+	return RequireRole(entities.RoleClient, entities.RoleStaff, entities.RoleAdmin)
+}
+
+func RequireStaff() http.MiddlewareFunc {
+	return RequireRole(entities.RoleStaff, entities.RoleAdmin)
+}
+
+func RequireAdmin() http.MiddlewareFunc {
+	return RequireRole(entities.RoleAdmin)
+}
+
+// RequireOwnerOrRole libera a rota quando o parâmetro param da URL é o ID do
+// próprio usuário ou quando ele tem um dos papéis informados. Ex.: um cliente
+// só vê os próprios agendamentos em /clients/:client_id/appointments.
+func RequireOwnerOrRole(param string, roles ...string) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			principal, ok := auth.FromContext(ctx.Context())
+			if !ok {
+				return unauthenticated(ctx)
+			}
+
+			ownerID, err := strconv.Atoi(ctx.Param(param))
+			if err != nil {
+				return ctx.Problem(400, errs.Validation("request.invalid_param").With("param", param))
+			}
+
+			if !principal.CanActFor(ownerID, roles...) {
+				return forbidden(ctx)
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// RequireVerifiedEmail bloqueia contas com email não verificado quando a
+// política exige a verificação para a rota. Chaves de API não têm email e
+// passam direto.
+func RequireVerifiedEmail(policy auth.UnverifiedPolicy) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			principal, ok := auth.FromContext(ctx.Context())
+			if !ok {
+				return unauthenticated(ctx)
+			}
+			if !principal.IsAPIKey() && !policy.AllowsRestricted(principal.EmailVerified) {
+				return ctx.Problem(403, auth.ErrEmailNotVerified)
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+// RequireMFA bloqueia os papéis que a política obriga a usar a verificação
+// em duas etapas enquanto a sessão não tiver passado por ela. O usuário ainda
+// consegue entrar com a senha para cadastrar o autenticador.
+func RequireMFA(policy auth.MFAPolicy) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			principal, ok := auth.FromContext(ctx.Context())
+			if !ok {
+				return unauthenticated(ctx)
+			}
+			if !policy.Allows(principal) {
+				return ctx.Problem(403, auth.ErrMFARequired)
+			}
+
+			return next(ctx)
+		}
+	}
+}
+
+func unauthenticated(ctx http.Context) error {
+	return ctx.Problem(401, auth.ErrUnauthenticated)
+}
+
+func forbidden(ctx http.Context) error {
+	return ctx.Problem(403, auth.ErrForbidden)
+}
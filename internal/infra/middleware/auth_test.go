@@ -0,0 +1,274 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/auth"
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/services"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/token"
+	http "scheduling/internal/infra/gin"
+	"scheduling/internal/infra/jwt"
+)
+
+type fakeAPIKeyVerifier struct{}
+
+func (fakeAPIKeyVerifier) Verify(ctx context.Context, plainKey string) (auth.Principal, error) {
+	switch plainKey {
+	case "sk_valida":
+		return auth.Principal{TenantID: 7, APIKeyID: 9, Scopes: []string{"availability:read"}}, nil
+	case "sk_falha":
+		return auth.Principal{}, errors.New("connection refused")
+	}
+	return auth.Principal{}, services.ErrInvalidAPIKey
+}
+
+func TestAuthenticate(t *testing.T) {
+	tokens, err := jwt.NewManager("segredo-de-teste", time.Minute)
+	if err != nil {
+		t.Fatalf("falha ao criar emissor de tokens: %v", err)
+	}
+	bearer := func(tenantID int) string {
+		tokenString, _, _ := tokens.CreateToken(token.Claims{UserID: 5, Role: "client", TenantID: tenantID})
+		return "Bearer " + tokenString
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		apiKey        string
+		verifier      APIKeyVerifier
+		wantStatus    int
+		wantPrincipal bool
+		wantAPIKey    bool
+	}{
+		{name: "token válido", authorization: bearer(7), wantPrincipal: true},
+		{name: "chave de API válida", apiKey: "sk_valida", verifier: fakeAPIKeyVerifier{}, wantPrincipal: true, wantAPIKey: true},
+		{name: "chave de API tem prioridade sobre o token", authorization: bearer(7), apiKey: "sk_valida", verifier: fakeAPIKeyVerifier{}, wantPrincipal: true, wantAPIKey: true},
+		{name: "chave de API inválida", apiKey: "sk_invalida", verifier: fakeAPIKeyVerifier{}, wantStatus: 401},
+		{name: "chave de API sem verificador", apiKey: "sk_valida", wantStatus: 401},
+		{name: "erro ao verificar chave de API", apiKey: "sk_falha", verifier: fakeAPIKeyVerifier{}, wantStatus: 500},
+		{name: "sem token", wantStatus: 401},
+		{name: "esquema diferente de Bearer", authorization: "Basic dXNlcjpzZW5oYQ==", wantStatus: 401},
+		{name: "token inválido", authorization: "Bearer invalido", wantStatus: 401},
+		{name: "token de outro tenant", authorization: bearer(3), wantStatus: 403},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newFakeContext("", map[string]string{"Authorization": tt.authorization, "X-API-Key": tt.apiKey})
+			ctx.SetContext(tenant.WithID(ctx.Context(), 7))
+
+			called := false
+			next := func(ctx http.Context) error {
+				called = true
+				return nil
+			}
+
+			err := Authenticate(tokens, tt.verifier)(next)(ctx)
+
+			// erros devolvidos sem resposta seguem para o tratamento central,
+			// que usa o tipo do erro
+			status := ctx.status
+			if err != nil && status == 0 {
+				status = http.StatusFor(errs.KindOf(err))
+			}
+
+			if status != tt.wantStatus {
+				t.Errorf("status esperado %d, obtido %d", tt.wantStatus, status)
+			}
+			if called != tt.wantPrincipal {
+				t.Errorf("handler chamado = %v, esperado %v", called, tt.wantPrincipal)
+			}
+
+			principal, ok := auth.FromContext(ctx.Context())
+			if ok != tt.wantPrincipal {
+				t.Fatalf("principal no contexto = %v, esperado %v", ok, tt.wantPrincipal)
+			}
+			if ok && tt.wantAPIKey && (principal.APIKeyID != 9 || !principal.HasScope("availability:read")) {
+				t.Errorf("principal incorreto: %+v", principal)
+			}
+			if ok && !tt.wantAPIKey && (principal.UserID != 5 || principal.Role != "client" || principal.TenantID != 7) {
+				t.Errorf("principal incorreto: %+v", principal)
+			}
+		})
+	}
+}
+
+func TestOptionalAuthenticate(t *testing.T) {
+	tests := []struct {
+		name          string
+		apiKey        string
+		wantStatus    int
+		wantPrincipal bool
+	}{
+		{name: "requisição anônima"},
+		{name: "chave de API válida", apiKey: "sk_valida", wantPrincipal: true},
+		{name: "chave de API inválida", apiKey: "sk_invalida", wantStatus: 401},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newFakeContext("", map[string]string{"X-API-Key": tt.apiKey})
+
+			called := false
+			next := func(ctx http.Context) error {
+				called = true
+				return nil
+			}
+
+			_ = OptionalAuthenticate(nil, fakeAPIKeyVerifier{})(next)(ctx)
+
+			if ctx.status != tt.wantStatus {
+				t.Errorf("status esperado %d, obtido %d", tt.wantStatus, ctx.status)
+			}
+			if called != (tt.wantStatus == 0) {
+				t.Errorf("handler chamado = %v com status %d", called, ctx.status)
+			}
+			if _, ok := auth.FromContext(ctx.Context()); ok != tt.wantPrincipal {
+				t.Errorf("principal no contexto = %v, esperado %v", ok, tt.wantPrincipal)
+			}
+		})
+	}
+}
+
+func TestRequireRoles(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  *auth.Principal
+		middleware http.MiddlewareFunc
+		params     map[string]string
+		wantStatus int
+	}{
+		{name: "sem autenticação", middleware: RequireClient(), wantStatus: 401},
+		{name: "cliente em rota de cliente", principal: &auth.Principal{UserID: 5, Role: "client"}, middleware: RequireClient()},
+		{name: "cliente em rota de profissional", principal: &auth.Principal{UserID: 5, Role: "client"}, middleware: RequireStaff(), wantStatus: 403},
+		{name: "profissional em rota de profissional", principal: &auth.Principal{UserID: 2, Role: "staff"}, middleware: RequireStaff()},
+		{name: "profissional em rota de administrador", principal: &auth.Principal{UserID: 2, Role: "staff"}, middleware: RequireAdmin(), wantStatus: 403},
+		{name: "administrador em rota de profissional", principal: &auth.Principal{UserID: 1, Role: "admin"}, middleware: RequireStaff()},
+		{
+			name:       "cliente acessando os próprios dados",
+			principal:  &auth.Principal{UserID: 5, Role: "client"},
+			middleware: RequireOwnerOrRole("client_id", "staff", "admin"),
+			params:     map[string]string{"client_id": "5"},
+		},
+		{
+			name:       "cliente acessando dados de outro cliente",
+			principal:  &auth.Principal{UserID: 5, Role: "client"},
+			middleware: RequireOwnerOrRole("client_id", "staff", "admin"),
+			params:     map[string]string{"client_id": "6"},
+			wantStatus: 403,
+		},
+		{
+			name:       "profissional acessando dados de um cliente",
+			principal:  &auth.Principal{UserID: 2, Role: "staff"},
+			middleware: RequireOwnerOrRole("client_id", "staff", "admin"),
+			params:     map[string]string{"client_id": "6"},
+		},
+		{
+			name:       "parâmetro inválido",
+			principal:  &auth.Principal{UserID: 5, Role: "client"},
+			middleware: RequireOwnerOrRole("client_id"),
+			params:     map[string]string{"client_id": "abc"},
+			wantStatus: 400,
+		},
+		{
+			name:       "email não verificado com verificação opcional",
+			principal:  &auth.Principal{UserID: 5, Role: "client"},
+			middleware: RequireVerifiedEmail(auth.UnverifiedAllow),
+		},
+		{
+			name:       "email não verificado com política restritiva",
+			principal:  &auth.Principal{UserID: 5, Role: "client"},
+			middleware: RequireVerifiedEmail(auth.UnverifiedRestrict),
+			wantStatus: 403,
+		},
+		{
+			name:       "email verificado com política restritiva",
+			principal:  &auth.Principal{UserID: 5, Role: "client", EmailVerified: true},
+			middleware: RequireVerifiedEmail(auth.UnverifiedRestrict),
+		},
+		{
+			name:       "administrador sem verificação em duas etapas obrigatória",
+			principal:  &auth.Principal{UserID: 1, Role: "admin"},
+			middleware: RequireMFA(auth.NewMFAPolicy("admin")),
+			wantStatus: 403,
+		},
+		{
+			name:       "administrador com verificação em duas etapas",
+			principal:  &auth.Principal{UserID: 1, Role: "admin", MFA: true},
+			middleware: RequireMFA(auth.NewMFAPolicy("admin")),
+		},
+		{
+			name:       "cliente fora da política de verificação em duas etapas",
+			principal:  &auth.Principal{UserID: 5, Role: "client"},
+			middleware: RequireMFA(auth.NewMFAPolicy("admin")),
+		},
+		{
+			name:       "chave de API com o escopo",
+			principal:  &auth.Principal{APIKeyID: 9, Scopes: []string{"appointments:write"}},
+			middleware: RequireRoleOrScope("appointments:write", "client"),
+		},
+		{
+			name:       "chave de API sem o escopo",
+			principal:  &auth.Principal{APIKeyID: 9, Scopes: []string{"availability:read"}},
+			middleware: RequireRoleOrScope("appointments:write", "client"),
+			wantStatus: 403,
+		},
+		{
+			name:       "usuário com o papel em rota com escopo",
+			principal:  &auth.Principal{UserID: 5, Role: "client"},
+			middleware: RequireRoleOrScope("appointments:write", "client"),
+		},
+		{
+			name:       "usuário sem o papel em rota com escopo",
+			principal:  &auth.Principal{UserID: 5, Role: "client"},
+			middleware: RequireRoleOrScope("services:manage", "admin"),
+			wantStatus: 403,
+		},
+		{
+			name:       "requisição anônima em rota com escopo opcional",
+			middleware: RequireScope("availability:read"),
+		},
+		{
+			name:       "chave de API sem o escopo opcional",
+			principal:  &auth.Principal{APIKeyID: 9, Scopes: []string{"services:manage"}},
+			middleware: RequireScope("availability:read"),
+			wantStatus: 403,
+		},
+		{
+			name:       "chave de API dispensada da verificação de email",
+			principal:  &auth.Principal{APIKeyID: 9},
+			middleware: RequireVerifiedEmail(auth.UnverifiedRestrict),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newFakeContext("", nil)
+			ctx.params = tt.params
+			if tt.principal != nil {
+				ctx.SetContext(auth.WithPrincipal(ctx.Context(), *tt.principal))
+			}
+
+			called := false
+			next := func(ctx http.Context) error {
+				called = true
+				return nil
+			}
+
+			_ = tt.middleware(next)(ctx)
+
+			if ctx.status != tt.wantStatus {
+				t.Errorf("status esperado %d, obtido %d", tt.wantStatus, ctx.status)
+			}
+			if called != (tt.wantStatus == 0) {
+				t.Errorf("handler chamado = %v com status %d", called, ctx.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"scheduling/internal/domain/i18n"
+	http "scheduling/internal/infra/gin"
+)
+
+// LocaleMiddleware escolhe o idioma da resposta pelo Accept-Language. Depois
+// da autenticação, o idioma preferido do usuário tem precedência (ver
+// setPrincipal).
+func LocaleMiddleware() http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			setLocale(ctx, i18n.Negotiate(ctx.GetHeader("Accept-Language")))
+			return next(ctx)
+		}
+	}
+}
+
+func setLocale(ctx http.Context, locale i18n.Locale) {
+	ctx.SetContext(i18n.WithLocale(ctx.Context(), locale))
+	ctx.Header("Content-Language", string(locale))
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/i18n"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/token"
+	http "scheduling/internal/infra/gin"
+	"scheduling/internal/infra/jwt"
+)
+
+func TestLocaleMiddleware(t *testing.T) {
+	tokens, err := jwt.NewManager("segredo-de-teste", time.Minute)
+	if err != nil {
+		t.Fatalf("falha ao criar emissor de tokens: %v", err)
+	}
+	bearer := func(locale string) string {
+		tokenString, _, _ := tokens.CreateToken(token.Claims{UserID: 5, Role: "client", TenantID: 7, Locale: locale})
+		return "Bearer " + tokenString
+	}
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		authorization  string
+		want           i18n.Locale
+	}{
+		{name: "sem preferência", want: i18n.PtBR},
+		{name: "Accept-Language", acceptLanguage: "en-US,en;q=0.9", want: i18n.En},
+		{name: "idioma não atendido", acceptLanguage: "fr-FR", want: i18n.PtBR},
+		{name: "preferência do usuário vence o header", acceptLanguage: "pt-BR", authorization: bearer("en"), want: i18n.En},
+		{name: "usuário sem preferência segue o header", acceptLanguage: "en", authorization: bearer(""), want: i18n.En},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newFakeContext("", map[string]string{"Accept-Language": tt.acceptLanguage, "Authorization": tt.authorization})
+			ctx.SetContext(tenant.WithID(ctx.Context(), 7))
+
+			var got i18n.Locale
+			next := func(ctx http.Context) error {
+				got = i18n.FromContext(ctx.Context())
+				return nil
+			}
+
+			handler := LocaleMiddleware()(next)
+			if tt.authorization != "" {
+				handler = LocaleMiddleware()(Authenticate(tokens, nil)(next))
+			}
+			_ = handler(ctx)
+
+			if got != tt.want {
+				t.Errorf("idioma esperado %s, obtido %s", tt.want, got)
+			}
+			if ctx.written["Content-Language"] != string(tt.want) {
+				t.Errorf("Content-Language esperado %s, obtido %q", tt.want, ctx.written["Content-Language"])
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"scheduling/internal/domain/errs"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/token"
+	http "scheduling/internal/infra/gin"
+)
+
+const (
+	tenantIDKey  string = "tenant_id"
+	tenantHeader string = "X-Tenant-ID"
+	bearerPrefix string = "Bearer "
+)
+
+// TenantMiddleware resolve o tenant da requisição e o coloca no contexto
+// usado pelos repositórios. A ordem de precedência é: claim do JWT, header
+// X-Tenant-ID (id ou slug) e, por fim, o subdomínio de baseDomain.
+func TenantMiddleware(tenants repositories.TenantRepository, tokens token.Issuer, baseDomain string) http.MiddlewareFunc {
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(ctx http.Context) error {
+			claimID, err := tenantFromAuthorization(tokens, ctx.GetHeader("Authorization"))
+			if err != nil {
+				return ctx.Problem(401, token.ErrInvalidToken)
+			}
+
+			headerID, err := tenantFromHeader(ctx, tenants, ctx.GetHeader(tenantHeader))
+			if err != nil {
+				return ctx.Problem(400, err)
+			}
+
+			// um token emitido para um tenant não pode acessar outro
+			if claimID != 0 && headerID != 0 && claimID != headerID {
+				return ctx.Problem(403, errs.Forbidden("tenant.mismatch"))
+			}
+
+			tenantID := claimID
+			if tenantID == 0 {
+				tenantID = headerID
+			}
+
+			if tenantID == 0 {
+				tenantID, err = tenantFromHost(ctx, tenants, ctx.Host(), baseDomain)
+				if err != nil {
+					return ctx.Problem(400, err)
+				}
+			}
+
+			if tenantID == 0 {
+				return ctx.Problem(400, tenant.ErrMissingTenant)
+			}
+
+			ctx.Set(tenantIDKey, tenantID)
+			ctx.SetContext(tenant.WithID(ctx.Context(), tenantID))
+
+			return next(ctx)
+		}
+	}
+}
+
+func tenantFromAuthorization(tokens token.Issuer, header string) (int, error) {
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return 0, nil
+	}
+
+	claims, err := tokens.VerifyToken(strings.TrimPrefix(header, bearerPrefix))
+	if err != nil {
+		return 0, err
+	}
+
+	return claims.TenantID, nil
+}
+
+func tenantFromHeader(ctx http.Context, tenants repositories.TenantRepository, value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+
+	if id, err := strconv.Atoi(value); err == nil {
+		t, err := tenants.FindByID(ctx.Context(), id)
+		if err != nil || t == nil {
+			return 0, tenant.ErrUnknownTenant
+		}
+		return t.ID(), nil
+	}
+
+	return findTenantBySlug(ctx, tenants, value)
+}
+
+func tenantFromHost(ctx http.Context, tenants repositories.TenantRepository, host, baseDomain string) (int, error) {
+	if baseDomain == "" {
+		return 0, nil
+	}
+
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	suffix := "." + baseDomain
+	if !strings.HasSuffix(host, suffix) {
+		return 0, nil
+	}
+
+	slug := strings.TrimSuffix(host, suffix)
+	if slug == "" || strings.Contains(slug, ".") {
+		return 0, nil
+	}
+
+	return findTenantBySlug(ctx, tenants, slug)
+}
+
+func findTenantBySlug(ctx http.Context, tenants repositories.TenantRepository, slug string) (int, error) {
+	t, err := tenants.FindBySlug(ctx.Context(), strings.ToLower(slug))
+	if err != nil || t == nil {
+		return 0, tenant.ErrUnknownTenant
+	}
+
+	return t.ID(), nil
+}
@@ -0,0 +1,169 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/i18n"
+	"scheduling/internal/domain/repositories/mocks"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/domain/token"
+	http "scheduling/internal/infra/gin"
+	"scheduling/internal/infra/jwt"
+)
+
+type fakeContext struct {
+	ctx     context.Context
+	headers map[string]string
+	host    string
+	params  map[string]string
+	values  map[string]any
+	written map[string]string
+	status  int
+	body    any
+}
+
+func newFakeContext(host string, headers map[string]string) *fakeContext {
+	return &fakeContext{ctx: context.Background(), headers: headers, host: host, values: map[string]any{}, written: map[string]string{}}
+}
+
+func (f *fakeContext) Context() context.Context          { return f.ctx }
+func (f *fakeContext) SetContext(ctx context.Context)    { f.ctx = ctx }
+func (f *fakeContext) GetHeader(key string) string       { return f.headers[key] }
+func (f *fakeContext) Cookie(name string) (string, bool) { return "", false }
+func (f *fakeContext) Host() string                      { return f.host }
+func (f *fakeContext) ClientIP() string                  { return "" }
+func (f *fakeContext) Method() string                    { return "GET" }
+func (f *fakeContext) Path() string                      { return "/" }
+func (f *fakeContext) Body() ([]byte, error)             { return nil, nil }
+func (f *fakeContext) Param(name string) string          { return f.params[name] }
+func (f *fakeContext) Query(name string) string          { return "" }
+func (f *fakeContext) Bind(obj any) error                { return nil }
+func (f *fakeContext) BindStrict(obj any) error          { return nil }
+func (f *fakeContext) Status(code int)                   { f.status = code }
+func (f *fakeContext) Header(key, value string)          { f.written[key] = value }
+func (f *fakeContext) Set(key string, value any)         { f.values[key] = value }
+func (f *fakeContext) Get(key string) (any, bool) {
+	value, ok := f.values[key]
+	return value, ok
+}
+func (f *fakeContext) JSON(status int, obj any) error {
+	f.status = status
+	f.body = obj
+	return nil
+}
+func (f *fakeContext) Problem(status int, err error) error {
+	problem := http.NewProblem(status, err, "", i18n.FromContext(f.Context()))
+	return f.JSON(problem.Status, problem)
+}
+
+func TestTenantMiddleware(t *testing.T) {
+	tenants := mocks.NewMockTenantRepository()
+	tenants.FindByIDFunc = func(ctx context.Context, id int) (*entities.Tenant, error) {
+		if id != 7 {
+			return nil, errors.New("not found")
+		}
+		return entities.NewTenant(7, "Acme", "acme")
+	}
+	tenants.FindBySlugFunc = func(ctx context.Context, slug string) (*entities.Tenant, error) {
+		if slug != "acme" {
+			return nil, errors.New("not found")
+		}
+		return entities.NewTenant(7, "Acme", "acme")
+	}
+
+	tokens, err := jwt.NewManager("segredo-de-teste", time.Minute)
+	if err != nil {
+		t.Fatalf("falha ao criar emissor de tokens: %v", err)
+	}
+	tokenFor := func(tenantID int) string {
+		tokenString, _, _ := tokens.CreateToken(token.Claims{UserID: 1, Role: "client", TenantID: tenantID})
+		return "Bearer " + tokenString
+	}
+
+	tests := []struct {
+		name       string
+		host       string
+		headers    map[string]string
+		wantStatus int
+		wantTenant int
+	}{
+		{
+			name:       "tenant pelo id no header",
+			headers:    map[string]string{"X-Tenant-ID": "7"},
+			wantTenant: 7,
+		},
+		{
+			name:       "tenant pelo slug no header",
+			headers:    map[string]string{"X-Tenant-ID": "acme"},
+			wantTenant: 7,
+		},
+		{
+			name:       "tenant pelo subdomínio",
+			host:       "acme.agenda.com:8080",
+			wantTenant: 7,
+		},
+		{
+			name:       "slug desconhecido",
+			headers:    map[string]string{"X-Tenant-ID": "outro"},
+			wantStatus: 400,
+		},
+		{
+			name:       "id desconhecido",
+			headers:    map[string]string{"X-Tenant-ID": "9"},
+			wantStatus: 400,
+		},
+		{
+			name:       "domínio fora da base",
+			host:       "acme.outro.com",
+			wantStatus: 400,
+		},
+		{
+			name:       "sem tenant",
+			host:       "agenda.com",
+			wantStatus: 400,
+		},
+		{
+			name:       "token inválido",
+			headers:    map[string]string{"Authorization": "Bearer invalido", "X-Tenant-ID": "7"},
+			wantStatus: 401,
+		},
+		{
+			name:       "tenant pelo claim do token",
+			headers:    map[string]string{"Authorization": tokenFor(7)},
+			wantTenant: 7,
+		},
+		{
+			name:       "token de outro tenant",
+			headers:    map[string]string{"Authorization": tokenFor(3), "X-Tenant-ID": "7"},
+			wantStatus: 403,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newFakeContext(tt.host, tt.headers)
+
+			gotTenant := 0
+			next := func(ctx http.Context) error {
+				gotTenant, _ = tenant.FromContext(ctx.Context())
+				return nil
+			}
+
+			_ = TenantMiddleware(tenants, tokens, "agenda.com")(next)(ctx)
+
+			if ctx.status != tt.wantStatus {
+				t.Errorf("status esperado %d, obtido %d", tt.wantStatus, ctx.status)
+			}
+			if gotTenant != tt.wantTenant {
+				t.Errorf("tenant esperado %d, obtido %d", tt.wantTenant, gotTenant)
+			}
+			if tt.wantTenant != 0 && ctx.values["tenant_id"] != tt.wantTenant {
+				t.Errorf("tenant_id esperado %d, obtido %v", tt.wantTenant, ctx.values["tenant_id"])
+			}
+		})
+	}
+}
@@ -1,24 +1,82 @@
 package middleware
 
 import (
+	"scheduling/internal/domain/errs"
 	http "scheduling/internal/infra/gin"
+	"scheduling/internal/infra/tracing"
 
-	"github.com/google/uuid"
-)
-
-const (
-	traceIDKey string = "trace_id"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// TraceIDMiddleware continua o trace do cliente, pelo traceparent do W3C
+// Trace Context ou, na falta dele, pelo X-Trace-ID, e abre o span da
+// requisição. O span segue no context.Context para os casos de uso, o banco
+// e os logs, e o ID volta no X-Trace-ID e no instance dos erros.
 func TraceIDMiddleware() http.MiddlewareFunc {
+	tracer := otel.Tracer("scheduling/internal/infra/middleware")
+	propagator := propagation.TraceContext{}
+
 	return func(next http.HandlerFunc) http.HandlerFunc {
 		return func(ctx http.Context) error {
-			traceID := uuid.New().String()
+			requestCtx := propagator.Extract(ctx.Context(), headerCarrier{ctx})
+
+			requested, hasRequested := tracing.ParseTraceID(ctx.GetHeader("X-Trace-ID"))
+			if !trace.SpanContextFromContext(requestCtx).IsValid() && hasRequested {
+				requestCtx = tracing.WithTraceID(requestCtx, requested)
+			}
+
+			requestCtx, span := tracer.Start(
+				requestCtx,
+				ctx.Method(),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", ctx.Method()),
+					attribute.String("url.path", ctx.Path()),
+					attribute.String("client.address", ctx.ClientIP()),
+				),
+			)
+			defer span.End()
+
+			traceID := span.SpanContext().TraceID()
+			// sem tracing.Setup o provedor global não cria spans
+			if !traceID.IsValid() {
+				traceID = requested
+				if !hasRequested {
+					traceID = tracing.NewTraceID()
+				}
+			}
 
-			ctx.Set(traceIDKey, traceID)
-			ctx.Header("X-Trace-ID", traceID)
-			
-			return next(ctx)
+			ctx.SetContext(requestCtx)
+			ctx.Set(http.TraceIDKey, traceID.String())
+			ctx.Header("X-Trace-ID", traceID.String())
+
+			err := next(ctx)
+			// erros do cliente (validação, 404) não marcam o span como falho
+			if err != nil && errs.KindOf(err) == errs.KindInternal {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			return err
 		}
 	}
-}
\ No newline at end of file
+}
+
+// headerCarrier lê os headers da requisição para o propagador; o middleware
+// não injeta headers por ele.
+type headerCarrier struct {
+	ctx http.Context
+}
+
+func (c headerCarrier) Get(key string) string {
+	return c.ctx.GetHeader(key)
+}
+
+func (c headerCarrier) Set(key, value string) {}
+
+func (c headerCarrier) Keys() []string {
+	return []string{"traceparent", "tracestate"}
+}
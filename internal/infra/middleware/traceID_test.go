@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"scheduling/internal/domain/errs"
+	http "scheduling/internal/infra/gin"
+	"scheduling/internal/infra/tracing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestTraceIDMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider, err := tracing.NewTracerProvider(context.Background(), tracing.Config{Exporter: tracing.ExporterNone}, sdktrace.WithSpanProcessor(recorder))
+	if err != nil {
+		t.Fatalf("erro ao criar o provedor: %v", err)
+	}
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	tests := []struct {
+		name       string
+		headers    map[string]string
+		err        error
+		wantTrace  string
+		wantParent string
+		wantStatus codes.Code
+	}{
+		{
+			name:       "traceparent",
+			headers:    map[string]string{"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"},
+			wantTrace:  "4bf92f3577b34da6a3ce929d0e0e4736",
+			wantParent: "00f067aa0ba902b7",
+		},
+		{
+			name:      "X-Trace-ID",
+			headers:   map[string]string{"X-Trace-ID": "4bf92f35-77b3-4da6-a3ce-929d0e0e4736"},
+			wantTrace: "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+		{
+			name: "traceparent tem precedência",
+			headers: map[string]string{
+				"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				"X-Trace-ID":  "0af7651916cd43dd8448eb211c80319c",
+			},
+			wantTrace:  "4bf92f3577b34da6a3ce929d0e0e4736",
+			wantParent: "00f067aa0ba902b7",
+		},
+		{
+			name: "traceparent inválido usa o X-Trace-ID",
+			headers: map[string]string{
+				"traceparent": "00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+				"X-Trace-ID":  "0af7651916cd43dd8448eb211c80319c",
+			},
+			wantTrace: "0af7651916cd43dd8448eb211c80319c",
+		},
+		{name: "sem headers inicia um trace"},
+		{name: "erro interno marca o span", err: errors.New("connection refused"), wantStatus: codes.Error},
+		{name: "erro do cliente não marca o span", err: errs.Validation("appointment.invalid_date")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newFakeContext("", tt.headers)
+
+			var seen trace.SpanContext
+			next := func(ctx http.Context) error {
+				seen = trace.SpanContextFromContext(ctx.Context())
+				return tt.err
+			}
+
+			if err := TraceIDMiddleware()(next)(ctx); err != tt.err {
+				t.Fatalf("o erro do handler deveria ser devolvido, obtido %v", err)
+			}
+
+			spans := recorder.Ended()
+			span := spans[len(spans)-1]
+			traceID := span.SpanContext().TraceID().String()
+
+			if tt.wantTrace != "" && traceID != tt.wantTrace {
+				t.Errorf("trace esperado %s, obtido %s", tt.wantTrace, traceID)
+			}
+			if ctx.written["X-Trace-ID"] != traceID || ctx.values[http.TraceIDKey] != traceID {
+				t.Errorf("X-Trace-ID %q e valor %v deveriam ser o trace do span %s", ctx.written["X-Trace-ID"], ctx.values[http.TraceIDKey], traceID)
+			}
+			if seen.SpanID() != span.SpanContext().SpanID() {
+				t.Error("o span da requisição deveria seguir no contexto")
+			}
+			if span.Parent().SpanID().String() != tt.wantParent && tt.wantParent != "" {
+				t.Errorf("pai esperado %s, obtido %s", tt.wantParent, span.Parent().SpanID())
+			}
+			if tt.wantParent == "" && span.Parent().IsValid() {
+				t.Errorf("span sem traceparent não deveria ter pai, obtido %s", span.Parent().SpanID())
+			}
+			if span.SpanKind() != trace.SpanKindServer || span.Name() != "GET" {
+				t.Errorf("span inesperado: %s %s", span.SpanKind(), span.Name())
+			}
+			if span.Status().Code != tt.wantStatus {
+				t.Errorf("status do span esperado %s, obtido %s", tt.wantStatus, span.Status().Code)
+			}
+		})
+	}
+}
+
+func TestTraceIDMiddleware_WithoutTracing(t *testing.T) {
+	tests := []struct {
+		name      string
+		headers   map[string]string
+		wantTrace string
+	}{
+		{name: "X-Trace-ID", headers: map[string]string{"X-Trace-ID": "0af7651916cd43dd8448eb211c80319c"}, wantTrace: "0af7651916cd43dd8448eb211c80319c"},
+		{name: "X-Trace-ID inválido", headers: map[string]string{"X-Trace-ID": "pedido-123"}},
+		{name: "sem headers"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newFakeContext("", tt.headers)
+			_ = TraceIDMiddleware()(func(ctx http.Context) error { return nil })(ctx)
+
+			got := ctx.written["X-Trace-ID"]
+			if _, ok := tracing.ParseTraceID(got); !ok {
+				t.Fatalf("X-Trace-ID deveria ser um trace válido, obtido %q", got)
+			}
+			if tt.wantTrace != "" && got != tt.wantTrace {
+				t.Errorf("trace esperado %s, obtido %s", tt.wantTrace, got)
+			}
+		})
+	}
+}
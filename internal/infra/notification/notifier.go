@@ -0,0 +1,125 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"scheduling/internal/domain/notification"
+)
+
+const signatureHeader = "X-Signature-SHA256"
+
+// LogNotifier apenas registra a mensagem no log. Serve para desenvolvimento,
+// quando não há um serviço de envio configurado.
+type LogNotifier struct {
+	logger *slog.Logger
+}
+
+func NewLogNotifier(logger *slog.Logger) *LogNotifier {
+	return &LogNotifier{logger: logger}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, message notification.Message) error {
+	n.logger.InfoContext(
+		ctx,
+		"Notificação não enviada: nenhum webhook configurado",
+		"kind", message.Kind,
+		"tenant_id", message.TenantID,
+		"to", message.To,
+		"locale", message.Locale,
+		"subject", message.Subject(),
+		"link", message.Link,
+		"operation", "notifier.log",
+	)
+	return nil
+}
+
+// WebhookNotifier entrega a mensagem com um POST JSON para o serviço
+// responsável pelo envio. Com secret, o corpo é assinado com HMAC-SHA256 em
+// hexadecimal no header X-Signature-SHA256.
+type WebhookNotifier struct {
+	url        string
+	secret     []byte
+	httpClient *http.Client
+}
+
+func NewWebhookNotifier(url, secret string, httpClient *http.Client) *WebhookNotifier {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookNotifier{url: url, secret: []byte(secret), httpClient: httpClient}
+}
+
+// webhookPayload traz o texto já traduzido, além dos dados usados nele, para
+// que o serviço de envio possa usar os próprios modelos se preferir.
+type webhookPayload struct {
+	Kind      string    `json:"kind"`
+	TenantID  int       `json:"tenant_id"`
+	To        string    `json:"to"`
+	Name      string    `json:"name"`
+	Link      string    `json:"link"`
+	ExpiresAt time.Time `json:"expires_at"`
+	Locale    string    `json:"locale"`
+	Subject   string    `json:"subject"`
+	Text      string    `json:"text"`
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, message notification.Message) error {
+	body, err := json.Marshal(webhookPayload{
+		Kind:      message.Kind,
+		TenantID:  message.TenantID,
+		To:        message.To,
+		Name:      message.Name,
+		Link:      message.Link,
+		ExpiresAt: message.ExpiresAt,
+		Locale:    string(message.Locale),
+		Subject:   message.Subject(),
+		Text:      message.Text(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(n.secret) > 0 {
+		mac := hmac.New(sha256.New, n.secret)
+		mac.Write(body)
+		req.Header.Set(signatureHeader, hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook de notificação respondeu %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// NewNotifierFromEnv usa o webhook de NOTIFICATION_WEBHOOK_URL, assinado com
+// NOTIFICATION_WEBHOOK_SECRET quando definido. Sem URL, as mensagens vão
+// apenas para o log.
+func NewNotifierFromEnv(logger *slog.Logger) notification.Notifier {
+	url := os.Getenv("NOTIFICATION_WEBHOOK_URL")
+	if url == "" {
+		return NewLogNotifier(logger)
+	}
+	return NewWebhookNotifier(url, os.Getenv("NOTIFICATION_WEBHOOK_SECRET"), nil)
+}
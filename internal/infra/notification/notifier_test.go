@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"scheduling/internal/domain/i18n"
+	"scheduling/internal/domain/notification"
+)
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	var payload webhookPayload
+	var signature string
+	var body []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &payload)
+		signature = r.Header.Get(signatureHeader)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.URL, "segredo", server.Client())
+	err := notifier.Notify(context.Background(), notification.Message{
+		Kind:     notification.KindPasswordReset,
+		TenantID: 2,
+		To:       "maria@gmail.com",
+		Name:     "Maria",
+		Link:     "https://app.exemplo.com/redefinir-senha?token=abc",
+		Locale:   i18n.En,
+	})
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if payload.Kind != notification.KindPasswordReset || payload.To != "maria@gmail.com" || payload.TenantID != 2 {
+		t.Errorf("payload incorreto: %+v", payload)
+	}
+	if payload.Locale != "en" || payload.Subject != "Password reset" || !strings.Contains(payload.Text, "Hi Maria!") {
+		t.Errorf("texto da notificação deveria estar em inglês: %+v", payload)
+	}
+
+	mac := hmac.New(sha256.New, []byte("segredo"))
+	mac.Write(body)
+	if want := hex.EncodeToString(mac.Sum(nil)); signature != want {
+		t.Errorf("assinatura esperada %s, obtida %s", want, signature)
+	}
+}
+
+func TestWebhookNotifier_NotifyFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.URL, "", server.Client())
+	if err := notifier.Notify(context.Background(), notification.Message{}); err == nil {
+		t.Error("resposta 502 do webhook deveria retornar erro")
+	}
+}
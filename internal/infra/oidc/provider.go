@@ -0,0 +1,246 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jwksRefreshInterval limita com que frequência um kid desconhecido força uma
+// nova busca das chaves do provedor.
+const jwksRefreshInterval = time.Minute
+
+var ErrInvalidIDToken = errors.New("ID token inválido")
+
+type ProviderConfig struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// provider carrega sob demanda o documento de discovery e as chaves do
+// provedor; falhas não ficam em cache, então a API sobe mesmo com o provedor
+// fora do ar.
+type provider struct {
+	config     ProviderConfig
+	httpClient *http.Client
+
+	mu          sync.Mutex
+	metadata    *metadata
+	keys        map[string]any
+	keysFetched time.Time
+}
+
+type idTokenClaims struct {
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+func (p *provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	var m metadata
+	discoveryURL := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJSON(ctx, discoveryURL, &m); err != nil {
+		return nil, fmt.Errorf("discovery do provedor %s: %w", p.config.Name, err)
+	}
+	if m.Issuer != p.config.Issuer {
+		return nil, fmt.Errorf("discovery do provedor %s: issuer %q difere do configurado", p.config.Name, m.Issuer)
+	}
+
+	p.metadata = &m
+	return p.metadata, nil
+}
+
+func (p *provider) authCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	m, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientID},
+		"redirect_uri":          {p.config.RedirectURL},
+		"scope":                 {strings.Join(p.config.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {CodeChallenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+
+	separator := "?"
+	if strings.Contains(m.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return m.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// exchange troca o código de autorização pelo ID token.
+func (p *provider) exchange(ctx context.Context, code, verifier string) (string, error) {
+	m, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"client_id":     {p.config.ClientID},
+		"code_verifier": {verifier},
+	}
+	if p.config.ClientSecret != "" {
+		form.Set("client_secret", p.config.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&body); err != nil {
+		return "", fmt.Errorf("resposta inválida do provedor %s: %w", p.config.Name, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("provedor %s recusou o código: %s %s", p.config.Name, body.Error, body.ErrorDescription)
+	}
+	if body.IDToken == "" {
+		return "", fmt.Errorf("provedor %s não retornou ID token", p.config.Name)
+	}
+
+	return body.IDToken, nil
+}
+
+// verify valida assinatura, issuer, audience, expiração e nonce do ID token.
+func (p *provider) verify(ctx context.Context, rawIDToken, nonce string) (*idTokenClaims, error) {
+	m, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims idTokenClaims
+	_, err = jwt.ParseWithClaims(rawIDToken, &claims,
+		func(t *jwt.Token) (interface{}, error) {
+			kid, _ := t.Header["kid"].(string)
+			return p.key(ctx, m, kid)
+		},
+		jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+		jwt.WithIssuer(p.config.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	if claims.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce não confere", ErrInvalidIDToken)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: sem subject", ErrInvalidIDToken)
+	}
+
+	return &claims, nil
+}
+
+// key busca a chave pelo kid e, se não a conhecer, recarrega o JWKS: o
+// provedor pode ter rotacionado as chaves desde a última busca.
+func (p *provider) key(ctx context.Context, m *metadata, kid string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if k, ok := p.keys[kid]; ok {
+		return k, nil
+	}
+
+	if time.Since(p.keysFetched) < jwksRefreshInterval && p.keys != nil {
+		return nil, fmt.Errorf("kid desconhecido: %q", kid)
+	}
+
+	var set jsonWebKeySet
+	if err := p.getJSON(ctx, m.JWKSURI, &set); err != nil {
+		return nil, err
+	}
+	p.keys = parseKeySet(set)
+	p.keysFetched = time.Now()
+
+	if k, ok := p.keys[kid]; ok {
+		return k, nil
+	}
+	return nil, fmt.Errorf("kid desconhecido: %q", kid)
+}
+
+func (p *provider) getJSON(ctx context.Context, target string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", target, resp.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(out)
+}
+
+// emailVerified aceita booleano ou texto: alguns provedores enviam "true".
+func (c *idTokenClaims) emailVerified() bool {
+	switch v := c.EmailVerified.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	default:
+		return false
+	}
+}
@@ -0,0 +1,185 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"scheduling/internal/domain/errs"
+)
+
+// pendingTTL é o tempo que o usuário tem para concluir o login no provedor.
+const pendingTTL = 10 * time.Minute
+
+var (
+	ErrUnknownProvider = errs.NotFound("external_login.unknown_provider")
+	ErrInvalidState    = errs.Validation("external_login.invalid_state")
+)
+
+// Identity é o usuário autenticado pelo provedor, junto com o tenant em que o
+// login foi iniciado.
+type Identity struct {
+	TenantID      int
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+type pendingLogin struct {
+	provider  string
+	tenantID  int
+	nonce     string
+	verifier  string
+	expiresAt time.Time
+}
+
+// RelyingParty conduz o fluxo authorization code + PKCE com os provedores
+// configurados. O state, o nonce e o code_verifier de cada login em
+// andamento ficam em memória, então o callback precisa chegar à mesma
+// instância que iniciou o login.
+type RelyingParty struct {
+	providers map[string]*provider
+	now       func() time.Time
+
+	mu      sync.Mutex
+	pending map[string]pendingLogin
+}
+
+func NewRelyingParty(httpClient *http.Client, configs ...ProviderConfig) (*RelyingParty, error) {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	rp := &RelyingParty{
+		providers: map[string]*provider{},
+		now:       time.Now,
+		pending:   map[string]pendingLogin{},
+	}
+
+	for _, config := range configs {
+		if config.Name == "" || config.Issuer == "" || config.ClientID == "" || config.RedirectURL == "" {
+			return nil, fmt.Errorf("provedor %q: issuer, client_id e redirect_url são obrigatórios", config.Name)
+		}
+		if len(config.Scopes) == 0 {
+			config.Scopes = []string{"openid", "email", "profile"}
+		}
+		rp.providers[config.Name] = &provider{config: config, httpClient: httpClient}
+	}
+
+	return rp, nil
+}
+
+// NewRelyingPartyFromEnv lê OIDC_PROVIDERS (ex.: "google,microsoft") e, para
+// cada provedor, OIDC_<NOME>_ISSUER, OIDC_<NOME>_CLIENT_ID,
+// OIDC_<NOME>_CLIENT_SECRET, OIDC_<NOME>_REDIRECT_URL e, opcionalmente,
+// OIDC_<NOME>_SCOPES separados por espaço.
+func NewRelyingPartyFromEnv() (*RelyingParty, error) {
+	var configs []ProviderConfig
+	for _, name := range strings.Split(os.Getenv("OIDC_PROVIDERS"), ",") {
+		name = strings.TrimSpace(strings.ToLower(name))
+		if name == "" {
+			continue
+		}
+
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		configs = append(configs, ProviderConfig{
+			Name:         name,
+			Issuer:       os.Getenv(prefix + "ISSUER"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+			Scopes:       strings.Fields(os.Getenv(prefix + "SCOPES")),
+		})
+	}
+
+	return NewRelyingParty(nil, configs...)
+}
+
+// AuthorizationURL inicia o login no provedor e retorna a URL para onde o
+// usuário deve ser redirecionado.
+func (rp *RelyingParty) AuthorizationURL(ctx context.Context, providerName string, tenantID int) (string, error) {
+	p, ok := rp.providers[providerName]
+	if !ok {
+		return "", ErrUnknownProvider
+	}
+
+	state, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	verifier, err := randomString()
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := p.authCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return "", err
+	}
+
+	rp.mu.Lock()
+	rp.removeExpired()
+	rp.pending[state] = pendingLogin{
+		provider:  providerName,
+		tenantID:  tenantID,
+		nonce:     nonce,
+		verifier:  verifier,
+		expiresAt: rp.now().Add(pendingTTL),
+	}
+	rp.mu.Unlock()
+
+	return authURL, nil
+}
+
+// Callback conclui o login: confere o state, troca o código pelo ID token e
+// valida o token. Cada state só pode ser usado uma vez.
+func (rp *RelyingParty) Callback(ctx context.Context, providerName, state, code string) (*Identity, error) {
+	rp.mu.Lock()
+	login, ok := rp.pending[state]
+	delete(rp.pending, state)
+	rp.mu.Unlock()
+
+	if !ok || login.provider != providerName || !rp.now().Before(login.expiresAt) {
+		return nil, ErrInvalidState
+	}
+
+	p := rp.providers[providerName]
+
+	rawIDToken, err := p.exchange(ctx, code, login.verifier)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := p.verify(ctx, rawIDToken, login.nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Identity{
+		TenantID:      login.tenantID,
+		Provider:      providerName,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.emailVerified(),
+		Name:          claims.Name,
+	}, nil
+}
+
+func (rp *RelyingParty) removeExpired() {
+	now := rp.now()
+	for state, login := range rp.pending {
+		if !now.Before(login.expiresAt) {
+			delete(rp.pending, state)
+		}
+	}
+}
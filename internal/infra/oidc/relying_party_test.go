@@ -0,0 +1,197 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"scheduling/internal/infra/oidc/oidctest"
+)
+
+func newTestRelyingParty(t *testing.T, server *oidctest.Server, secret string) *RelyingParty {
+	t.Helper()
+	rp, err := NewRelyingParty(server.Client(), ProviderConfig{
+		Name:         "mock",
+		Issuer:       server.URL,
+		ClientID:     server.ClientID,
+		ClientSecret: secret,
+		RedirectURL:  "https://agenda.example.com/auth/oidc/mock/callback",
+	})
+	if err != nil {
+		t.Fatalf("falha ao criar relying party: %v", err)
+	}
+	return rp
+}
+
+// authorize segue a URL de autorização até o redirecionamento para o
+// callback e devolve o code e o state recebidos.
+func authorize(t *testing.T, server *oidctest.Server, authURL string) (code, state string) {
+	t.Helper()
+	client := server.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+
+	resp, err := client.Get(authURL)
+	if err != nil {
+		t.Fatalf("falha ao autorizar: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("redirecionamento esperado, obtido status %d", resp.StatusCode)
+	}
+
+	callback, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("Location inválido: %v", err)
+	}
+	if callback.Host != "agenda.example.com" {
+		t.Errorf("redirect_uri incorreto: %s", callback)
+	}
+	return callback.Query().Get("code"), callback.Query().Get("state")
+}
+
+func TestRelyingParty_Login(t *testing.T) {
+	server := oidctest.NewServer("agenda", "segredo")
+	defer server.Close()
+	server.SetUser(oidctest.User{Subject: "abc-123", Email: "maria@gmail.com", EmailVerified: true, Name: "Maria"})
+
+	rp := newTestRelyingParty(t, server, "segredo")
+	ctx := context.Background()
+
+	authURL, err := rp.AuthorizationURL(ctx, "mock", 7)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	parsed, _ := url.Parse(authURL)
+	if parsed.Query().Get("code_challenge_method") != "S256" || parsed.Query().Get("nonce") == "" {
+		t.Errorf("URL de autorização sem PKCE ou nonce: %s", authURL)
+	}
+
+	code, state := authorize(t, server, authURL)
+	identity, err := rp.Callback(ctx, "mock", state, code)
+	if err != nil {
+		t.Fatalf("erro inesperado no callback: %v", err)
+	}
+
+	want := Identity{TenantID: 7, Provider: "mock", Subject: "abc-123", Email: "maria@gmail.com", EmailVerified: true, Name: "Maria"}
+	if *identity != want {
+		t.Errorf("identidade esperada %+v, obtida %+v", want, *identity)
+	}
+
+	if _, err := rp.Callback(ctx, "mock", state, code); !errors.Is(err, ErrInvalidState) {
+		t.Errorf("state reutilizado deveria retornar ErrInvalidState, obtido %v", err)
+	}
+}
+
+func TestRelyingParty_Rejects(t *testing.T) {
+	server := oidctest.NewServer("agenda", "segredo")
+	defer server.Close()
+	ctx := context.Background()
+
+	t.Run("provedor desconhecido", func(t *testing.T) {
+		rp := newTestRelyingParty(t, server, "segredo")
+		if _, err := rp.AuthorizationURL(ctx, "outro", 7); !errors.Is(err, ErrUnknownProvider) {
+			t.Errorf("esperado ErrUnknownProvider, obtido %v", err)
+		}
+	})
+
+	t.Run("state desconhecido", func(t *testing.T) {
+		rp := newTestRelyingParty(t, server, "segredo")
+		if _, err := rp.Callback(ctx, "mock", "forjado", "codigo"); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("esperado ErrInvalidState, obtido %v", err)
+		}
+	})
+
+	t.Run("state de outro provedor", func(t *testing.T) {
+		rp := newTestRelyingParty(t, server, "segredo")
+		authURL, _ := rp.AuthorizationURL(ctx, "mock", 7)
+		code, state := authorize(t, server, authURL)
+		if _, err := rp.Callback(ctx, "google", state, code); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("esperado ErrInvalidState, obtido %v", err)
+		}
+	})
+
+	t.Run("login expirado", func(t *testing.T) {
+		rp := newTestRelyingParty(t, server, "segredo")
+		authURL, _ := rp.AuthorizationURL(ctx, "mock", 7)
+		code, state := authorize(t, server, authURL)
+
+		rp.now = func() time.Time { return time.Now().Add(pendingTTL + time.Second) }
+		if _, err := rp.Callback(ctx, "mock", state, code); !errors.Is(err, ErrInvalidState) {
+			t.Errorf("esperado ErrInvalidState, obtido %v", err)
+		}
+	})
+
+	t.Run("código de outro login falha no PKCE", func(t *testing.T) {
+		rp := newTestRelyingParty(t, server, "segredo")
+		authURL1, _ := rp.AuthorizationURL(ctx, "mock", 7)
+		authURL2, _ := rp.AuthorizationURL(ctx, "mock", 7)
+		_, state1 := authorize(t, server, authURL1)
+		code2, _ := authorize(t, server, authURL2)
+
+		if _, err := rp.Callback(ctx, "mock", state1, code2); err == nil {
+			t.Error("código emitido para outro code_challenge deveria ser recusado")
+		}
+	})
+
+	t.Run("nonce diferente", func(t *testing.T) {
+		rp := newTestRelyingParty(t, server, "segredo")
+		authURL, _ := rp.AuthorizationURL(ctx, "mock", 7)
+		code, state := authorize(t, server, authURL)
+
+		server.OverrideNonce("nonce-de-outro-login")
+		if _, err := rp.Callback(ctx, "mock", state, code); !errors.Is(err, ErrInvalidIDToken) {
+			t.Errorf("esperado ErrInvalidIDToken, obtido %v", err)
+		}
+	})
+
+	t.Run("client secret incorreto", func(t *testing.T) {
+		rp := newTestRelyingParty(t, server, "errado")
+		authURL, _ := rp.AuthorizationURL(ctx, "mock", 7)
+		code, state := authorize(t, server, authURL)
+		if _, err := rp.Callback(ctx, "mock", state, code); err == nil {
+			t.Error("client secret incorreto deveria ser recusado")
+		}
+	})
+
+	t.Run("issuer diferente do configurado", func(t *testing.T) {
+		rp, _ := NewRelyingParty(server.Client(), ProviderConfig{
+			Name:        "mock",
+			Issuer:      server.URL + "/",
+			ClientID:    "agenda",
+			RedirectURL: "https://agenda.example.com/callback",
+		})
+		if _, err := rp.AuthorizationURL(ctx, "mock", 7); err == nil {
+			t.Error("issuer divergente no discovery deveria retornar erro")
+		}
+	})
+}
+
+func TestNewRelyingPartyFromEnv(t *testing.T) {
+	t.Setenv("OIDC_PROVIDERS", "Google, microsoft")
+	t.Setenv("OIDC_GOOGLE_ISSUER", "https://accounts.google.com")
+	t.Setenv("OIDC_GOOGLE_CLIENT_ID", "id")
+	t.Setenv("OIDC_GOOGLE_REDIRECT_URL", "https://agenda.example.com/auth/oidc/google/callback")
+	t.Setenv("OIDC_MICROSOFT_ISSUER", "https://login.microsoftonline.com/tenant/v2.0")
+	t.Setenv("OIDC_MICROSOFT_CLIENT_ID", "id")
+	t.Setenv("OIDC_MICROSOFT_REDIRECT_URL", "https://agenda.example.com/auth/oidc/microsoft/callback")
+	t.Setenv("OIDC_MICROSOFT_SCOPES", "openid email")
+
+	rp, err := NewRelyingPartyFromEnv()
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(rp.providers) != 2 || rp.providers["google"] == nil {
+		t.Fatalf("provedores carregados incorretamente: %v", rp.providers)
+	}
+	if scopes := rp.providers["microsoft"].config.Scopes; len(scopes) != 2 {
+		t.Errorf("escopos esperados [openid email], obtidos %v", scopes)
+	}
+
+	t.Setenv("OIDC_MICROSOFT_CLIENT_ID", "")
+	if _, err := NewRelyingPartyFromEnv(); err == nil {
+		t.Error("provedor sem client_id deveria retornar erro")
+	}
+}
@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+const apiKeyColumns = `id, name, prefix, key_hash, scopes, created_by, expires_at, last_used_at, revoked_at, created_at`
+
+type APIKeyMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewAPIKeyMySQLRepository(db *sql.DB) *APIKeyMySQLRepository {
+	return &APIKeyMySQLRepository{db: db}
+}
+
+func (r *APIKeyMySQLRepository) Create(ctx context.Context, key *entities.APIKey) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO api_keys (name, prefix, key_hash, scopes, created_by, created_at, tenant_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		key.Name(),
+		key.Prefix(),
+		key.KeyHash(),
+		strings.Join(key.Scopes(), ","),
+		key.CreatedBy(),
+		key.CreatedAt(),
+		tenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	key.SetID(int(id))
+
+	return nil
+}
+
+func (r *APIKeyMySQLRepository) FindByHash(ctx context.Context, keyHash string) (*entities.APIKey, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE key_hash = ? AND tenant_id = ?`
+	return r.findOne(ctx, query, keyHash, tenantID)
+}
+
+func (r *APIKeyMySQLRepository) FindByID(ctx context.Context, id int) (*entities.APIKey, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE id = ? AND tenant_id = ?`
+	return r.findOne(ctx, query, id, tenantID)
+}
+
+func (r *APIKeyMySQLRepository) List(ctx context.Context) ([]*entities.APIKey, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE tenant_id = ? ORDER BY created_at DESC`
+	rows, err := r.db.QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []*entities.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+func (r *APIKeyMySQLRepository) Revoke(ctx context.Context, id int) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := `UPDATE api_keys SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL AND tenant_id = ?`
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *APIKeyMySQLRepository) Expire(ctx context.Context, id int, at time.Time) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE api_keys SET expires_at = ? WHERE id = ? AND tenant_id = ?`
+	_, err = r.db.ExecContext(ctx, query, at, id, tenantID)
+	return err
+}
+
+func (r *APIKeyMySQLRepository) TouchLastUsed(ctx context.Context, id int, at time.Time) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE api_keys SET last_used_at = ? WHERE id = ? AND tenant_id = ?`
+	_, err = r.db.ExecContext(ctx, query, at, id, tenantID)
+	return err
+}
+
+func (r *APIKeyMySQLRepository) findOne(ctx context.Context, query string, args ...any) (*entities.APIKey, error) {
+	key, err := scanAPIKey(r.db.QueryRowContext(ctx, query, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return key, err
+}
+
+func scanAPIKey(row interface{ Scan(dest ...any) error }) (*entities.APIKey, error) {
+	var id, createdBy int
+	var name, prefix, keyHash, scopes string
+	var expiresAt, lastUsedAt, revokedAt sql.NullTime
+	var createdAt time.Time
+
+	err := row.Scan(&id, &name, &prefix, &keyHash, &scopes, &createdBy, &expiresAt, &lastUsedAt, &revokedAt, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.RebuildAPIKey(
+		id, name, prefix, keyHash, strings.Split(scopes, ","), createdBy,
+		nullTime(expiresAt), nullTime(lastUsedAt), nullTime(revokedAt), createdAt,
+	), nil
+}
+
+func nullTime(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
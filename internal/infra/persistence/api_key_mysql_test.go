@@ -0,0 +1,155 @@
+package persistence
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestAPIKeyMySQLRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	key, _ := entities.NewAPIKey("PDV loja 1", "sk_abcdefgh", "hash-da-chave", []string{entities.ScopeAvailabilityRead, entities.ScopeAppointmentsWrite}, 1)
+
+	mock.ExpectExec("INSERT INTO api_keys \\(name, prefix, key_hash, scopes, created_by, created_at, tenant_id\\)").
+		WithArgs("PDV loja 1", "sk_abcdefgh", "hash-da-chave", "availability:read,appointments:write", 1, sqlmock.AnyArg(), testTenantID).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+
+	repo := NewAPIKeyMySQLRepository(db)
+	if err := repo.Create(testCtx, key); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if key.ID() != 5 {
+		t.Errorf("ID esperado 5, obtido %d", key.ID())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestAPIKeyMySQLRepository_FindByHash(t *testing.T) {
+	query := "SELECT id, name, prefix, key_hash, scopes, created_by, expires_at, last_used_at, revoked_at, created_at FROM api_keys WHERE key_hash = \\? AND tenant_id = \\?"
+	columns := []string{"id", "name", "prefix", "key_hash", "scopes", "created_by", "expires_at", "last_used_at", "revoked_at", "created_at"}
+	createdAt := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+	lastUsedAt := createdAt.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    func(*testing.T, *entities.APIKey)
+		wantErr bool
+	}{
+		{
+			name: "chave ativa",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(5, "PDV loja 1", "sk_abcdefgh", "hash", "availability:read,appointments:write", 1, nil, lastUsedAt, nil, createdAt)
+				mock.ExpectQuery(query).WithArgs("hash", testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, key *entities.APIKey) {
+				if key.ID() != 5 || key.Name() != "PDV loja 1" {
+					t.Errorf("chave inesperada: %d %s", key.ID(), key.Name())
+				}
+				if len(key.Scopes()) != 2 || key.Scopes()[1] != entities.ScopeAppointmentsWrite {
+					t.Errorf("escopos inesperados: %v", key.Scopes())
+				}
+				if key.LastUsedAt() == nil || !key.LastUsedAt().Equal(lastUsedAt) {
+					t.Errorf("último uso esperado %v, obtido %v", lastUsedAt, key.LastUsedAt())
+				}
+				if !key.IsActive(lastUsedAt) {
+					t.Error("chave deveria estar ativa")
+				}
+			},
+		},
+		{
+			name: "chave inexistente",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WillReturnError(sql.ErrNoRows)
+			},
+			want: func(t *testing.T, key *entities.APIKey) {
+				if key != nil {
+					t.Error("esperado nil para chave inexistente")
+				}
+			},
+		},
+		{
+			name: "erro no banco",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WillReturnError(errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewAPIKeyMySQLRepository(db)
+			key, err := repo.FindByHash(testCtx, "hash")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("erro = %v, esperava erro = %v", err, tt.wantErr)
+			}
+			if tt.want != nil {
+				tt.want(t, key)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestAPIKeyMySQLRepository_Revoke(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		want     bool
+	}{
+		{"chave ativa", 1, true},
+		{"chave já revogada", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectExec("UPDATE api_keys SET revoked_at = \\? WHERE id = \\? AND revoked_at IS NULL AND tenant_id = \\?").
+				WithArgs(sqlmock.AnyArg(), 5, testTenantID).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			repo := NewAPIKeyMySQLRepository(db)
+			revoked, err := repo.Revoke(testCtx, 5)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if revoked != tt.want {
+				t.Errorf("revogada = %v, esperado %v", revoked, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package persistence
 
 import (
+	"context"
 	"database/sql"
 	"time"
 
 	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
 )
 
 type AppointmentMySQLRepository struct {
@@ -15,33 +17,64 @@ func NewAppointmentMySQLRepository(db *sql.DB) *AppointmentMySQLRepository {
 	return &AppointmentMySQLRepository{db: db}
 }
 
-func (r *AppointmentMySQLRepository) FindByID(id int) (*entities.Appointment, error) {
-	query := "SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE id = ?"
-	row := r.db.QueryRow(query, id)
+func (r *AppointmentMySQLRepository) FindByID(ctx context.Context, id int) (*entities.Appointment, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE id = ? AND tenant_id = ?"
+	row := r.db.QueryRowContext(ctx, query, id, tenantID)
 
 	var scheduledAt, createdAt time.Time
 	var clientID, staffID, serviceID int
 	var status string
+	var locationID sql.NullInt64
 
-	err := row.Scan(&id, &clientID, &staffID, &serviceID, &scheduledAt, &status, &createdAt)
+	err = row.Scan(&id, &clientID, &staffID, &serviceID, &scheduledAt, &status, &createdAt, &locationID)
 	if err != nil {
 		return nil, err
 	}
 
-	appointment, err := entities.NewAppointment(clientID, staffID, serviceID, scheduledAt)
+	appointment, err := entities.RebuildAppointment(id, clientID, staffID, serviceID, scheduledAt, status, createdAt)
 	if err != nil {
 		return nil, err
 	}
-	appointment.SetID(id)
-	appointment.SetStatus(status)
-	appointment.SetCreatedAt(createdAt)
+	appointment.SetLocationID(int(locationID.Int64))
 
 	return appointment, nil
 }
 
-func (r *AppointmentMySQLRepository) FindAllByStaffID(staffID int) ([]*entities.Appointment, error) {
-	query := "SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE staff_id = ?"
-	rows, err := r.db.Query(query, staffID)
+func (r *AppointmentMySQLRepository) FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.Appointment, error) {
+	query := "SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE staff_id = ? AND tenant_id = ?"
+	return r.findAll(ctx, query, staffID)
+}
+
+func (r *AppointmentMySQLRepository) FindAllByClientID(ctx context.Context, clientID int) ([]*entities.Appointment, error) {
+	query := "SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE client_id = ? AND tenant_id = ? ORDER BY scheduled_at"
+	return r.findAll(ctx, query, clientID)
+}
+
+func (r *AppointmentMySQLRepository) FindScheduledByStaffAndDate(ctx context.Context, staffID int, date time.Time) ([]*entities.Appointment, error) {
+	dayStart := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	query := `
+		SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments
+		WHERE staff_id = ? AND status = 'scheduled' AND scheduled_at >= ? AND scheduled_at < ? AND tenant_id = ?
+		ORDER BY scheduled_at
+	`
+	return r.findAll(ctx, query, staffID, dayStart, dayEnd)
+}
+
+// findAll acrescenta o tenant do contexto como último argumento da consulta.
+func (r *AppointmentMySQLRepository) findAll(ctx context.Context, query string, args ...any) ([]*entities.Appointment, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, tenantID)...)
 	if err != nil {
 		return nil, err
 	}
@@ -49,22 +82,21 @@ func (r *AppointmentMySQLRepository) FindAllByStaffID(staffID int) ([]*entities.
 
 	var appointments []*entities.Appointment
 	for rows.Next() {
-		var id, clientID, serviceID int
+		var id, clientID, staffID, serviceID int
 		var scheduledAt, createdAt time.Time
 		var status string
+		var locationID sql.NullInt64
 
-		err := rows.Scan(&id, &clientID, &staffID, &serviceID, &scheduledAt, &status, &createdAt)
+		err := rows.Scan(&id, &clientID, &staffID, &serviceID, &scheduledAt, &status, &createdAt, &locationID)
 		if err != nil {
 			return nil, err
 		}
 
-		appointment, err := entities.NewAppointment(clientID, staffID, serviceID, scheduledAt)
+		appointment, err := entities.RebuildAppointment(id, clientID, staffID, serviceID, scheduledAt, status, createdAt)
 		if err != nil {
 			return nil, err
 		}
-		appointment.SetID(id)
-		appointment.SetStatus(status)
-		appointment.SetCreatedAt(createdAt)
+		appointment.SetLocationID(int(locationID.Int64))
 
 		appointments = append(appointments, appointment)
 	}
@@ -72,7 +104,12 @@ func (r *AppointmentMySQLRepository) FindAllByStaffID(staffID int) ([]*entities.
 	return appointments, nil
 }
 
-func (r *AppointmentMySQLRepository) HasConflict(staffID int, start, end time.Time) (bool, error) {
+func (r *AppointmentMySQLRepository) HasConflict(ctx context.Context, staffID int, start, end time.Time) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
 	query := `
 		SELECT COUNT(*) FROM appointments
 		WHERE staff_id = ? AND status = 'scheduled'
@@ -80,9 +117,10 @@ func (r *AppointmentMySQLRepository) HasConflict(staffID int, start, end time.Ti
 			(scheduled_at BETWEEN ? AND ?)
 			OR (? BETWEEN scheduled_at AND DATE_ADD(scheduled_at, INTERVAL 30 MINUTE))
 		)
+		AND tenant_id = ?
 	`
 	var count int
-	err := r.db.QueryRow(query, staffID, start, end, end).Scan(&count)
+	err = r.db.QueryRowContext(ctx, query, staffID, start, end, end, tenantID).Scan(&count)
 	if err != nil {
 		return false, err
 	}
@@ -90,27 +128,44 @@ func (r *AppointmentMySQLRepository) HasConflict(staffID int, start, end time.Ti
 	return count > 0, nil
 }
 
-func (r *AppointmentMySQLRepository) Save(appointment *entities.Appointment) error {
-	query := "INSERT INTO appointments (client_id, staff_id, service_id, scheduled_at, status, created_at) VALUES (?, ?, ?, ?, ?, ?)"
-	_, err := r.db.Exec(query,
+func (r *AppointmentMySQLRepository) Save(ctx context.Context, appointment *entities.Appointment) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO appointments (client_id, staff_id, service_id, scheduled_at, status, created_at, location_id, tenant_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	_, err = r.db.ExecContext(ctx, query,
 		appointment.ClientID(),
 		appointment.StaffID(),
 		appointment.ServiceID(),
 		appointment.ScheduledAt(),
 		appointment.Status(),
 		appointment.CreatedAt(),
+		nullableID(appointment.LocationID()),
+		tenantID,
 	)
 	return err
 }
 
-func (r *AppointmentMySQLRepository) Update(appointment *entities.Appointment) error {
-	query := "UPDATE appointments SET status = ? WHERE id = ?"
-	_, err := r.db.Exec(query, appointment.Status(), appointment.ID())
+func (r *AppointmentMySQLRepository) Update(ctx context.Context, appointment *entities.Appointment) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE appointments SET status = ? WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query, appointment.Status(), appointment.ID(), tenantID)
 	return err
 }
 
-func (r *AppointmentMySQLRepository) Delete(id int) error {
-	query := "DELETE FROM appointments WHERE id = ?"
-	_, err := r.db.Exec(query, id)
+func (r *AppointmentMySQLRepository) Delete(ctx context.Context, id int) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "DELETE FROM appointments WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query, id, tenantID)
 	return err
 }
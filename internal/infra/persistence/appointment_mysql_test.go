@@ -69,10 +69,10 @@ func TestAppointmentMySQLRepository_FindByID(t *testing.T) {
 			name:          "agendamento encontrado com sucesso",
 			appointmentID: 1,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at"}).
-					AddRow(1, 2, 3, 4, scheduledTime, "scheduled", createdTime)
-				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE id = ?").
-					WithArgs(1).
+				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at", "location_id"}).
+					AddRow(1, 2, 3, 4, scheduledTime, "scheduled", createdTime, 7)
+				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE id = ?").
+					WithArgs(1, testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, appointment *entities.Appointment) {
@@ -97,6 +97,9 @@ func TestAppointmentMySQLRepository_FindByID(t *testing.T) {
 				if !appointment.CreatedAt().Equal(createdTime) {
 					t.Errorf("CreatedAt esperado %v, obtido %v", createdTime, appointment.CreatedAt())
 				}
+				if appointment.LocationID() != 7 {
+					t.Errorf("LocationID esperado 7, obtido %d", appointment.LocationID())
+				}
 			},
 			wantErr: false,
 		},
@@ -104,8 +107,8 @@ func TestAppointmentMySQLRepository_FindByID(t *testing.T) {
 			name:          "agendamento não encontrado",
 			appointmentID: 999,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE id = ?").
-					WithArgs(999).
+				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE id = ?").
+					WithArgs(999, testTenantID).
 					WillReturnError(sql.ErrNoRows)
 			},
 			wantErr: true,
@@ -115,8 +118,8 @@ func TestAppointmentMySQLRepository_FindByID(t *testing.T) {
 			name:          "erro no banco de dados",
 			appointmentID: 2,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE id = ?").
-					WithArgs(2).
+				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE id = ?").
+					WithArgs(2, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			wantErr: true,
@@ -126,10 +129,10 @@ func TestAppointmentMySQLRepository_FindByID(t *testing.T) {
 			name:          "erro ao criar entidade appointment",
 			appointmentID: 3,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at"}).
-					AddRow(3, 0, 3, 4, scheduledTime, "scheduled", createdTime)
-				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE id = ?").
-					WithArgs(3).
+				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at", "location_id"}).
+					AddRow(3, 0, 3, 4, scheduledTime, "scheduled", createdTime, nil)
+				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE id = ?").
+					WithArgs(3, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -148,7 +151,7 @@ func TestAppointmentMySQLRepository_FindByID(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAppointmentMySQLRepository(db)
-			got, err := repo.FindByID(tt.appointmentID)
+			got, err := repo.FindByID(testCtx, tt.appointmentID)
 
 			if tt.wantErr {
 				if err == nil {
@@ -193,11 +196,11 @@ func TestAppointmentMySQLRepository_FindAllByStaffID(t *testing.T) {
 			name:    "agendamentos encontrados com sucesso",
 			staffID: 3,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at"}).
-					AddRow(1, 2, 3, 4, scheduledTime1, "scheduled", createdTime1).
-					AddRow(2, 5, 3, 6, scheduledTime2, "completed", createdTime2)
-				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE staff_id = ?").
-					WithArgs(3).
+				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at", "location_id"}).
+					AddRow(1, 2, 3, 4, scheduledTime1, "scheduled", createdTime1, nil).
+					AddRow(2, 5, 3, 6, scheduledTime2, "completed", createdTime2, nil)
+				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE staff_id = ?").
+					WithArgs(3, testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, appointments []*entities.Appointment) {
@@ -231,9 +234,9 @@ func TestAppointmentMySQLRepository_FindAllByStaffID(t *testing.T) {
 			name:    "nenhum agendamento encontrado",
 			staffID: 999,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at"})
-				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE staff_id = ?").
-					WithArgs(999).
+				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at", "location_id"})
+				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE staff_id = ?").
+					WithArgs(999, testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, appointments []*entities.Appointment) {
@@ -247,8 +250,8 @@ func TestAppointmentMySQLRepository_FindAllByStaffID(t *testing.T) {
 			name:    "erro no banco de dados",
 			staffID: 4,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE staff_id = ?").
-					WithArgs(4).
+				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE staff_id = ?").
+					WithArgs(4, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			wantErr: true,
@@ -258,10 +261,10 @@ func TestAppointmentMySQLRepository_FindAllByStaffID(t *testing.T) {
 			name:    "erro ao fazer scan da linha",
 			staffID: 5,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at"}).
-					AddRow(1, 0, 5, 6, scheduledTime1, "scheduled", createdTime1)
-				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at FROM appointments WHERE staff_id = ?").
-					WithArgs(5).
+				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at", "location_id"}).
+					AddRow(1, 0, 5, 6, scheduledTime1, "scheduled", createdTime1, nil)
+				mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE staff_id = ?").
+					WithArgs(5, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -280,7 +283,121 @@ func TestAppointmentMySQLRepository_FindAllByStaffID(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAppointmentMySQLRepository(db)
-			got, err := repo.FindAllByStaffID(tt.staffID)
+			got, err := repo.FindAllByStaffID(testCtx, tt.staffID)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				if err.Error() != tt.errMsg {
+					t.Errorf("erro esperado '%s', obtido '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if tt.want != nil {
+				tt.want(t, got)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestAppointmentMySQLRepository_FindAllByClientID(t *testing.T) {
+	scheduledTime := time.Date(2025, 12, 25, 14, 30, 0, 0, time.UTC)
+	createdTime := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at", "location_id"}).
+		AddRow(1, 5, 3, 4, scheduledTime, "scheduled", createdTime, 2)
+	mock.ExpectQuery("SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments WHERE client_id = \\? AND tenant_id = \\? ORDER BY scheduled_at").
+		WithArgs(5, testTenantID).
+		WillReturnRows(rows)
+
+	repo := NewAppointmentMySQLRepository(db)
+	got, err := repo.FindAllByClientID(testCtx, 5)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if len(got) != 1 || got[0].ClientID() != 5 || got[0].LocationID() != 2 {
+		t.Errorf("agendamentos incorretos: %+v", got)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestAppointmentMySQLRepository_FindScheduledByStaffAndDate(t *testing.T) {
+	date := time.Date(2025, 12, 25, 15, 0, 0, 0, time.UTC)
+	dayStart := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+	dayEnd := time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC)
+	scheduledTime := time.Date(2025, 12, 25, 9, 0, 0, 0, time.UTC)
+	createdTime := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	query := "SELECT id, client_id, staff_id, service_id, scheduled_at, status, created_at, location_id FROM appointments\\s+WHERE staff_id = \\? AND status = 'scheduled' AND scheduled_at >= \\? AND scheduled_at < \\?"
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    func(*testing.T, []*entities.Appointment)
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "agendamentos do dia encontrados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "client_id", "staff_id", "service_id", "scheduled_at", "status", "created_at", "location_id"}).
+					AddRow(1, 2, 3, 4, scheduledTime, "scheduled", createdTime, nil)
+				mock.ExpectQuery(query).
+					WithArgs(3, dayStart, dayEnd, testTenantID).
+					WillReturnRows(rows)
+			},
+			want: func(t *testing.T, appointments []*entities.Appointment) {
+				if len(appointments) != 1 {
+					t.Fatalf("esperado 1 agendamento, obtido %d", len(appointments))
+				}
+				if !appointments[0].ScheduledAt().Equal(scheduledTime) {
+					t.Errorf("ScheduledAt esperado %v, obtido %v", scheduledTime, appointments[0].ScheduledAt())
+				}
+			},
+		},
+		{
+			name: "erro no banco de dados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(3, dayStart, dayEnd, testTenantID).
+					WillReturnError(errors.New("database connection error"))
+			},
+			wantErr: true,
+			errMsg:  "database connection error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewAppointmentMySQLRepository(db)
+			got, err := repo.FindScheduledByStaffAndDate(testCtx, 3, date)
 
 			if tt.wantErr {
 				if err == nil {
@@ -329,7 +446,7 @@ func TestAppointmentMySQLRepository_HasConflict(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM appointments WHERE staff_id = \? AND status = 'scheduled' AND \(\s*\(\s*scheduled_at BETWEEN \? AND \?\s*\)\s*OR \s*\(\s*\? BETWEEN scheduled_at AND DATE_ADD\s*\(\s*scheduled_at, INTERVAL 30 MINUTE\s*\)\s*\)\s*\)`).
-					WithArgs(1, start, end, end).
+					WithArgs(1, start, end, end, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -343,7 +460,7 @@ func TestAppointmentMySQLRepository_HasConflict(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(0)
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM appointments WHERE staff_id = \? AND status = 'scheduled' AND \(\s*\(\s*scheduled_at BETWEEN \? AND \?\s*\)\s*OR \s*\(\s*\? BETWEEN scheduled_at AND DATE_ADD\s*\(\s*scheduled_at, INTERVAL 30 MINUTE\s*\)\s*\)\s*\)`).
-					WithArgs(2, start, end, end).
+					WithArgs(2, start, end, end, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    false,
@@ -357,7 +474,7 @@ func TestAppointmentMySQLRepository_HasConflict(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(3)
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM appointments WHERE staff_id = \? AND status = 'scheduled' AND \(\s*\(\s*scheduled_at BETWEEN \? AND \?\s*\)\s*OR \s*\(\s*\? BETWEEN scheduled_at AND DATE_ADD\s*\(\s*scheduled_at, INTERVAL 30 MINUTE\s*\)\s*\)\s*\)`).
-					WithArgs(3, start, end, end).
+					WithArgs(3, start, end, end, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -370,7 +487,7 @@ func TestAppointmentMySQLRepository_HasConflict(t *testing.T) {
 			end:     end,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM appointments WHERE staff_id = \? AND status = 'scheduled' AND \(\s*\(\s*scheduled_at BETWEEN \? AND \?\s*\)\s*OR \s*\(\s*\? BETWEEN scheduled_at AND DATE_ADD\s*\(\s*scheduled_at, INTERVAL 30 MINUTE\s*\)\s*\)\s*\)`).
-					WithArgs(4, start, end, end).
+					WithArgs(4, start, end, end, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			want:    false,
@@ -390,7 +507,7 @@ func TestAppointmentMySQLRepository_HasConflict(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAppointmentMySQLRepository(db)
-			got, err := repo.HasConflict(tt.staffID, tt.start, tt.end)
+			got, err := repo.HasConflict(testCtx, tt.staffID, tt.start, tt.end)
 
 			if tt.wantErr {
 				if err == nil {
@@ -418,7 +535,7 @@ func TestAppointmentMySQLRepository_HasConflict(t *testing.T) {
 }
 
 func TestAppointmentMySQLRepository_Save(t *testing.T) {
-	scheduledTime := time.Date(2025, 12, 15, 14, 30, 0, 0, time.UTC)
+	scheduledTime := time.Now().AddDate(1, 0, 0).Truncate(time.Minute)
 	
 	tests := []struct {
 		name        string
@@ -437,8 +554,8 @@ func TestAppointmentMySQLRepository_Save(t *testing.T) {
 				return apt
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("INSERT INTO appointments \\(client_id, staff_id, service_id, scheduled_at, status, created_at\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?\\)").
-					WithArgs(1, 2, 3, scheduledTime, entities.StatusScheduled, sqlmock.AnyArg()).
+				mock.ExpectExec("INSERT INTO appointments \\(client_id, staff_id, service_id, scheduled_at, status, created_at, location_id, tenant_id\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?, \\?, \\?\\)").
+					WithArgs(1, 2, 3, scheduledTime, entities.StatusScheduled, sqlmock.AnyArg(), nil, testTenantID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -453,8 +570,8 @@ func TestAppointmentMySQLRepository_Save(t *testing.T) {
 				return apt
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("INSERT INTO appointments \\(client_id, staff_id, service_id, scheduled_at, status, created_at\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?\\)").
-					WithArgs(4, 5, 6, scheduledTime, entities.StatusScheduled, sqlmock.AnyArg()).
+				mock.ExpectExec("INSERT INTO appointments \\(client_id, staff_id, service_id, scheduled_at, status, created_at, location_id, tenant_id\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?, \\?, \\?\\)").
+					WithArgs(4, 5, 6, scheduledTime, entities.StatusScheduled, sqlmock.AnyArg(), nil, testTenantID).
 					WillReturnError(errors.New("database insert error"))
 			},
 			wantErr: true,
@@ -474,7 +591,7 @@ func TestAppointmentMySQLRepository_Save(t *testing.T) {
 
 			repo := NewAppointmentMySQLRepository(db)
 			appointment := tt.appointment()
-			err = repo.Save(appointment)
+			err = repo.Save(testCtx, appointment)
 
 			if tt.wantErr {
 				if err == nil {
@@ -498,7 +615,7 @@ func TestAppointmentMySQLRepository_Save(t *testing.T) {
 }
 
 func TestAppointmentMySQLRepository_Update(t *testing.T) {
-	scheduledTime := time.Date(2025, 12, 15, 14, 30, 0, 0, time.UTC)
+	scheduledTime := time.Now().AddDate(1, 0, 0).Truncate(time.Minute)
 	
 	tests := []struct {
 		name        string
@@ -520,7 +637,7 @@ func TestAppointmentMySQLRepository_Update(t *testing.T) {
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE appointments SET status = \\? WHERE id = \\?").
-					WithArgs(entities.StatusCompleted, 1).
+					WithArgs(entities.StatusCompleted, 1, testTenantID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -538,7 +655,7 @@ func TestAppointmentMySQLRepository_Update(t *testing.T) {
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE appointments SET status = \\? WHERE id = \\?").
-					WithArgs(entities.StatusCancelled, 2).
+					WithArgs(entities.StatusCancelled, 2, testTenantID).
 					WillReturnError(errors.New("database update error"))
 			},
 			wantErr: true,
@@ -558,7 +675,7 @@ func TestAppointmentMySQLRepository_Update(t *testing.T) {
 
 			repo := NewAppointmentMySQLRepository(db)
 			appointment := tt.appointment()
-			err = repo.Update(appointment)
+			err = repo.Update(testCtx, appointment)
 
 			if tt.wantErr {
 				if err == nil {
@@ -594,7 +711,7 @@ func TestAppointmentMySQLRepository_Delete(t *testing.T) {
 			appointmentID: 1,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("DELETE FROM appointments WHERE id = \\?").
-					WithArgs(1).
+					WithArgs(1, testTenantID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -604,7 +721,7 @@ func TestAppointmentMySQLRepository_Delete(t *testing.T) {
 			appointmentID: 2,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("DELETE FROM appointments WHERE id = \\?").
-					WithArgs(2).
+					WithArgs(2, testTenantID).
 					WillReturnError(errors.New("database delete error"))
 			},
 			wantErr: true,
@@ -615,7 +732,7 @@ func TestAppointmentMySQLRepository_Delete(t *testing.T) {
 			appointmentID: 999,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("DELETE FROM appointments WHERE id = \\?").
-					WithArgs(999).
+					WithArgs(999, testTenantID).
 					WillReturnResult(sqlmock.NewResult(999, 0))
 			},
 			wantErr: false,
@@ -633,7 +750,7 @@ func TestAppointmentMySQLRepository_Delete(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAppointmentMySQLRepository(db)
-			err = repo.Delete(tt.appointmentID)
+			err = repo.Delete(testCtx, tt.appointmentID)
 
 			if tt.wantErr {
 				if err == nil {
@@ -0,0 +1,48 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+type AuditMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewAuditMySQLRepository(db *sql.DB) *AuditMySQLRepository {
+	return &AuditMySQLRepository{db: db}
+}
+
+func (r *AuditMySQLRepository) Record(ctx context.Context, entry *entities.AuditEntry) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO audit_log (actor_id, action, subject, ip, created_at, tenant_id)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		entry.ActorID(),
+		entry.Action(),
+		entry.Subject(),
+		entry.IP(),
+		entry.CreatedAt(),
+		tenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	entry.SetID(int(id))
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package persistence
+
+import (
+	"testing"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestAuditMySQLRepository_Record(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	actorID := 1
+	entry, _ := entities.NewAuditEntry(&actorID, entities.AuditAccountUnlocked, "ana@gmail.com", "10.0.0.1")
+
+	mock.ExpectExec("INSERT INTO audit_log \\(actor_id, action, subject, ip, created_at, tenant_id\\)").
+		WithArgs(1, entities.AuditAccountUnlocked, "ana@gmail.com", "10.0.0.1", sqlmock.AnyArg(), testTenantID).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+
+	repo := NewAuditMySQLRepository(db)
+	if err := repo.Record(testCtx, entry); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if entry.ID() != 12 {
+		t.Errorf("ID esperado 12, obtido %d", entry.ID())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
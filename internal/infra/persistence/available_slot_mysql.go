@@ -1,10 +1,12 @@
 package persistence
 
 import (
+	"context"
 	"database/sql"
 	"time"
 
 	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
 )
 
 type AvailableSlotMySQLRepository struct {
@@ -15,15 +17,21 @@ func NewAvailableSlotMySQLRepository(db *sql.DB) *AvailableSlotMySQLRepository {
 	return &AvailableSlotMySQLRepository{db: db}
 }
 
-func (r *AvailableSlotMySQLRepository) FindByID(id int) (*entities.AvailableSlot, error) {
-	query := "SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE id = ?"
-	row := r.db.QueryRow(query, id)
+func (r *AvailableSlotMySQLRepository) FindByID(ctx context.Context, id int) (*entities.AvailableSlot, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE id = ? AND tenant_id = ?"
+	row := r.db.QueryRowContext(ctx, query, id, tenantID)
 
 	var slotID, staffID int
 	var weekday string
 	var startTime, endTime time.Time
+	var locationID sql.NullInt64
 
-	err := row.Scan(&slotID, &staffID, &weekday, &startTime, &endTime)
+	err = row.Scan(&slotID, &staffID, &weekday, &startTime, &endTime, &locationID)
 	if err != nil {
 		return nil, err
 	}
@@ -33,48 +41,34 @@ func (r *AvailableSlotMySQLRepository) FindByID(id int) (*entities.AvailableSlot
 		return nil, err
 	}
 	slot.SetID(slotID)
+	slot.SetLocationID(int(locationID.Int64))
 
 	return slot, nil
 }
 
-func (r *AvailableSlotMySQLRepository) FindAllByStaffID(staffID int) ([]*entities.AvailableSlot, error) {
-	query := "SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = ?"
-	rows, err := r.db.Query(query, staffID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var slots []*entities.AvailableSlot
-	for rows.Next() {
-		var slotID int
-		var weekday string
-		var startTime, endTime time.Time
-
-		err := rows.Scan(&slotID, &staffID, &weekday, &startTime, &endTime)
-		if err != nil {
-			return nil, err
-		}
-
-		slot, err := entities.NewAvailableSlot(staffID, entities.Weekday(weekday), startTime, endTime)
-		if err != nil {
-			return nil, err
-		}
-		slot.SetID(slotID)
-		slots = append(slots, slot)
-	}
-
-	return slots, nil
+func (r *AvailableSlotMySQLRepository) FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.AvailableSlot, error) {
+	query := "SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = ? AND tenant_id = ?"
+	return r.findAll(ctx, query, staffID)
 }
 
-func (r *AvailableSlotMySQLRepository) FindSlotsByStaffAndDate(staffID int, date time.Time) ([]*entities.AvailableSlot, error) {
+func (r *AvailableSlotMySQLRepository) FindSlotsByStaffAndDate(ctx context.Context, staffID int, date time.Time) ([]*entities.AvailableSlot, error) {
 	weekday := entities.FromTimeWeekday(date.Weekday())
-	return r.FindByWeekday(staffID, weekday)
+	return r.FindByWeekday(ctx, staffID, weekday)
+}
+
+func (r *AvailableSlotMySQLRepository) FindByWeekday(ctx context.Context, staffID int, weekday entities.Weekday) ([]*entities.AvailableSlot, error) {
+	query := "SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = ? AND weekday = ? AND tenant_id = ?"
+	return r.findAll(ctx, query, staffID, string(weekday))
 }
 
-func (r *AvailableSlotMySQLRepository) FindByWeekday(staffID int, weekday entities.Weekday) ([]*entities.AvailableSlot, error) {
-	query := "SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = ? AND weekday = ?"
-	rows, err := r.db.Query(query, staffID, string(weekday))
+// findAll acrescenta o tenant do contexto como último argumento da consulta.
+func (r *AvailableSlotMySQLRepository) findAll(ctx context.Context, query string, args ...any) ([]*entities.AvailableSlot, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, tenantID)...)
 	if err != nil {
 		return nil, err
 	}
@@ -82,36 +76,44 @@ func (r *AvailableSlotMySQLRepository) FindByWeekday(staffID int, weekday entiti
 
 	var slots []*entities.AvailableSlot
 	for rows.Next() {
-		var slotID int
-		var wd string
+		var slotID, staffID int
+		var weekday string
 		var startTime, endTime time.Time
+		var locationID sql.NullInt64
 
-		err := rows.Scan(&slotID, &staffID, &wd, &startTime, &endTime)
+		err := rows.Scan(&slotID, &staffID, &weekday, &startTime, &endTime, &locationID)
 		if err != nil {
 			return nil, err
 		}
 
-		slot, err := entities.NewAvailableSlot(staffID, entities.Weekday(wd), startTime, endTime)
+		slot, err := entities.NewAvailableSlot(staffID, entities.Weekday(weekday), startTime, endTime)
 		if err != nil {
 			return nil, err
 		}
 		slot.SetID(slotID)
+		slot.SetLocationID(int(locationID.Int64))
 		slots = append(slots, slot)
 	}
 
 	return slots, nil
 }
 
-func (r *AvailableSlotMySQLRepository) HasConflict(staffID int, weekday entities.Weekday, start, end time.Time) (bool, error) {
+func (r *AvailableSlotMySQLRepository) HasConflict(ctx context.Context, staffID int, weekday entities.Weekday, start, end time.Time) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
 	query := `
 		SELECT COUNT(*) FROM available_slots
 		WHERE staff_id = ? AND weekday = ? AND (
 			(start_time < ? AND end_time > ?) OR
 			(start_time >= ? AND start_time < ?)
 		)
+		AND tenant_id = ?
 	`
 	var count int
-	err := r.db.QueryRow(query, staffID, string(weekday), end, start, start, end).Scan(&count)
+	err = r.db.QueryRowContext(ctx, query, staffID, string(weekday), end, start, start, end, tenantID).Scan(&count)
 	if err != nil {
 		return false, err
 	}
@@ -119,14 +121,19 @@ func (r *AvailableSlotMySQLRepository) HasConflict(staffID int, weekday entities
 	return count > 0, nil
 }
 
-func (r *AvailableSlotMySQLRepository) IsWithinAvailableSlot(staffID int, start, end time.Time) (bool, error) {
+func (r *AvailableSlotMySQLRepository) IsWithinAvailableSlot(ctx context.Context, staffID int, start, end time.Time) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
 	weekday := entities.FromTimeWeekday(start.Weekday())
 	query := `
 		SELECT COUNT(*) FROM available_slots
-		WHERE staff_id = ? AND weekday = ? AND start_time <= ? AND end_time >= ?
+		WHERE staff_id = ? AND weekday = ? AND start_time <= ? AND end_time >= ? AND tenant_id = ?
 	`
 	var count int
-	err := r.db.QueryRow(query, staffID, string(weekday), start, end).Scan(&count)
+	err = r.db.QueryRowContext(ctx, query, staffID, string(weekday), start, end, tenantID).Scan(&count)
 	if err != nil {
 		return false, err
 	}
@@ -134,30 +141,49 @@ func (r *AvailableSlotMySQLRepository) IsWithinAvailableSlot(staffID int, start,
 	return count > 0, nil
 }
 
-func (r *AvailableSlotMySQLRepository) Save(slot *entities.AvailableSlot) error {
-	query := "INSERT INTO available_slots (staff_id, weekday, start_time, end_time) VALUES (?, ?, ?, ?)"
-	_, err := r.db.Exec(query,
+func (r *AvailableSlotMySQLRepository) Save(ctx context.Context, slot *entities.AvailableSlot) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO available_slots (staff_id, weekday, start_time, end_time, location_id, tenant_id) VALUES (?, ?, ?, ?, ?, ?)"
+	_, err = r.db.ExecContext(ctx, query,
 		slot.StaffID(),
 		string(slot.Weekday()),
 		slot.StartTime(),
 		slot.EndTime(),
+		nullableID(slot.LocationID()),
+		tenantID,
 	)
 	return err
 }
 
-func (r *AvailableSlotMySQLRepository) Update(slot *entities.AvailableSlot) error {
-	query := "UPDATE available_slots SET weekday = ?, start_time = ?, end_time = ? WHERE id = ?"
-	_, err := r.db.Exec(query,
+func (r *AvailableSlotMySQLRepository) Update(ctx context.Context, slot *entities.AvailableSlot) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE available_slots SET weekday = ?, start_time = ?, end_time = ?, location_id = ? WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query,
 		string(slot.Weekday()),
 		slot.StartTime(),
 		slot.EndTime(),
+		nullableID(slot.LocationID()),
 		slot.ID(),
+		tenantID,
 	)
 	return err
 }
 
-func (r *AvailableSlotMySQLRepository) Delete(id int) error {
-	query := "DELETE FROM available_slots WHERE id = ?"
-	_, err := r.db.Exec(query, id)
+func (r *AvailableSlotMySQLRepository) Delete(ctx context.Context, id int) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "DELETE FROM available_slots WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query, id, tenantID)
 	return err
 }
@@ -69,10 +69,10 @@ func TestAvailableSlotMySQLRepository_FindByID(t *testing.T) {
 			name:   "slot encontrado com sucesso",
 			slotID: 1,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time"}).
-					AddRow(1, 2, "monday", startTime, endTime)
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE id = \\?").
-					WithArgs(1).
+				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time", "location_id"}).
+					AddRow(1, 2, "monday", startTime, endTime, 5)
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE id = \\?").
+					WithArgs(1, testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, slot *entities.AvailableSlot) {
@@ -91,6 +91,9 @@ func TestAvailableSlotMySQLRepository_FindByID(t *testing.T) {
 				if !slot.EndTime().Equal(endTime) {
 					t.Errorf("EndTime esperado %v, obtido %v", endTime, slot.EndTime())
 				}
+				if slot.LocationID() != 5 {
+					t.Errorf("LocationID esperado 5, obtido %d", slot.LocationID())
+				}
 			},
 			wantErr: false,
 		},
@@ -98,8 +101,8 @@ func TestAvailableSlotMySQLRepository_FindByID(t *testing.T) {
 			name:   "slot não encontrado",
 			slotID: 999,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE id = \\?").
-					WithArgs(999).
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE id = \\?").
+					WithArgs(999, testTenantID).
 					WillReturnError(sql.ErrNoRows)
 			},
 			wantErr: true,
@@ -109,8 +112,8 @@ func TestAvailableSlotMySQLRepository_FindByID(t *testing.T) {
 			name:   "erro no banco de dados",
 			slotID: 2,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE id = \\?").
-					WithArgs(2).
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE id = \\?").
+					WithArgs(2, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			wantErr: true,
@@ -120,10 +123,10 @@ func TestAvailableSlotMySQLRepository_FindByID(t *testing.T) {
 			name:   "erro ao criar entidade available_slot - staffID inválido",
 			slotID: 3,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time"}).
-					AddRow(3, 0, "monday", startTime, endTime)
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE id = \\?").
-					WithArgs(3).
+				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time", "location_id"}).
+					AddRow(3, 0, "monday", startTime, endTime, nil)
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE id = \\?").
+					WithArgs(3, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -133,10 +136,10 @@ func TestAvailableSlotMySQLRepository_FindByID(t *testing.T) {
 			name:   "erro ao criar entidade available_slot - weekday inválido",
 			slotID: 4,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time"}).
-					AddRow(4, 2, "invalid_day", startTime, endTime)
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE id = \\?").
-					WithArgs(4).
+				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time", "location_id"}).
+					AddRow(4, 2, "invalid_day", startTime, endTime, nil)
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE id = \\?").
+					WithArgs(4, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -155,7 +158,7 @@ func TestAvailableSlotMySQLRepository_FindByID(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAvailableSlotMySQLRepository(db)
-			got, err := repo.FindByID(tt.slotID)
+			got, err := repo.FindByID(testCtx, tt.slotID)
 
 			if tt.wantErr {
 				if err == nil {
@@ -200,11 +203,11 @@ func TestAvailableSlotMySQLRepository_FindAllByStaffID(t *testing.T) {
 			name:    "slots encontrados com sucesso",
 			staffID: 2,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time"}).
-					AddRow(1, 2, "monday", startTime1, endTime1).
-					AddRow(2, 2, "tuesday", startTime2, endTime2)
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = \\?").
-					WithArgs(2).
+				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time", "location_id"}).
+					AddRow(1, 2, "monday", startTime1, endTime1, nil).
+					AddRow(2, 2, "tuesday", startTime2, endTime2, nil)
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = \\?").
+					WithArgs(2, testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, slots []*entities.AvailableSlot) {
@@ -238,9 +241,9 @@ func TestAvailableSlotMySQLRepository_FindAllByStaffID(t *testing.T) {
 			name:    "nenhum slot encontrado",
 			staffID: 999,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time"})
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = \\?").
-					WithArgs(999).
+				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time", "location_id"})
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = \\?").
+					WithArgs(999, testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, slots []*entities.AvailableSlot) {
@@ -254,8 +257,8 @@ func TestAvailableSlotMySQLRepository_FindAllByStaffID(t *testing.T) {
 			name:    "erro no banco de dados",
 			staffID: 3,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = \\?").
-					WithArgs(3).
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = \\?").
+					WithArgs(3, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			wantErr: true,
@@ -265,10 +268,10 @@ func TestAvailableSlotMySQLRepository_FindAllByStaffID(t *testing.T) {
 			name:    "erro ao fazer scan da linha",
 			staffID: 4,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time"}).
-					AddRow(1, 0, "monday", startTime1, endTime1)
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = \\?").
-					WithArgs(4).
+				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time", "location_id"}).
+					AddRow(1, 0, "monday", startTime1, endTime1, nil)
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = \\?").
+					WithArgs(4, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -287,7 +290,7 @@ func TestAvailableSlotMySQLRepository_FindAllByStaffID(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAvailableSlotMySQLRepository(db)
-			got, err := repo.FindAllByStaffID(tt.staffID)
+			got, err := repo.FindAllByStaffID(testCtx, tt.staffID)
 
 			if tt.wantErr {
 				if err == nil {
@@ -332,10 +335,10 @@ func TestAvailableSlotMySQLRepository_FindByWeekday(t *testing.T) {
 			staffID: 2,
 			weekday: entities.Monday,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time"}).
-					AddRow(1, 2, "monday", startTime, endTime)
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
-					WithArgs(2, "monday").
+				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time", "location_id"}).
+					AddRow(1, 2, "monday", startTime, endTime, nil)
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
+					WithArgs(2, "monday", testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, slots []*entities.AvailableSlot) {
@@ -353,9 +356,9 @@ func TestAvailableSlotMySQLRepository_FindByWeekday(t *testing.T) {
 			staffID: 2,
 			weekday: entities.Sunday,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time"})
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
-					WithArgs(2, "sunday").
+				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time", "location_id"})
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
+					WithArgs(2, "sunday", testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, slots []*entities.AvailableSlot) {
@@ -370,8 +373,8 @@ func TestAvailableSlotMySQLRepository_FindByWeekday(t *testing.T) {
 			staffID: 2,
 			weekday: entities.Monday,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
-					WithArgs(2, "monday").
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
+					WithArgs(2, "monday", testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			wantErr: true,
@@ -390,7 +393,7 @@ func TestAvailableSlotMySQLRepository_FindByWeekday(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAvailableSlotMySQLRepository(db)
-			got, err := repo.FindByWeekday(tt.staffID, tt.weekday)
+			got, err := repo.FindByWeekday(testCtx, tt.staffID, tt.weekday)
 
 			if tt.wantErr {
 				if err == nil {
@@ -436,10 +439,10 @@ func TestAvailableSlotMySQLRepository_FindSlotsByStaffAndDate(t *testing.T) {
 			staffID: 2,
 			date:    testDate,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time"}).
-					AddRow(1, 2, "monday", startTime, endTime)
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
-					WithArgs(2, "monday").
+				rows := sqlmock.NewRows([]string{"id", "staff_id", "weekday", "start_time", "end_time", "location_id"}).
+					AddRow(1, 2, "monday", startTime, endTime, nil)
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
+					WithArgs(2, "monday", testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, slots []*entities.AvailableSlot) {
@@ -457,8 +460,8 @@ func TestAvailableSlotMySQLRepository_FindSlotsByStaffAndDate(t *testing.T) {
 			staffID: 2,
 			date:    testDate,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
-					WithArgs(2, "monday").
+				mock.ExpectQuery("SELECT id, staff_id, weekday, start_time, end_time, location_id FROM available_slots WHERE staff_id = \\? AND weekday = \\?").
+					WithArgs(2, "monday", testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			wantErr: true,
@@ -477,7 +480,7 @@ func TestAvailableSlotMySQLRepository_FindSlotsByStaffAndDate(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAvailableSlotMySQLRepository(db)
-			got, err := repo.FindSlotsByStaffAndDate(tt.staffID, tt.date)
+			got, err := repo.FindSlotsByStaffAndDate(testCtx, tt.staffID, tt.date)
 
 			if tt.wantErr {
 				if err == nil {
@@ -528,7 +531,7 @@ func TestAvailableSlotMySQLRepository_HasConflict(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM available_slots WHERE staff_id = \? AND weekday = \? AND \(\s*\(\s*start_time < \? AND end_time > \?\s*\)\s*OR\s*\(\s*start_time >= \? AND start_time < \?\s*\)\s*\)`).
-					WithArgs(1, "monday", end, start, start, end).
+					WithArgs(1, "monday", end, start, start, end, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -543,7 +546,7 @@ func TestAvailableSlotMySQLRepository_HasConflict(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(0)
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM available_slots WHERE staff_id = \? AND weekday = \? AND \(\s*\(\s*start_time < \? AND end_time > \?\s*\)\s*OR\s*\(\s*start_time >= \? AND start_time < \?\s*\)\s*\)`).
-					WithArgs(2, "monday", end, start, start, end).
+					WithArgs(2, "monday", end, start, start, end, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    false,
@@ -558,7 +561,7 @@ func TestAvailableSlotMySQLRepository_HasConflict(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(3)
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM available_slots WHERE staff_id = \? AND weekday = \? AND \(\s*\(\s*start_time < \? AND end_time > \?\s*\)\s*OR\s*\(\s*start_time >= \? AND start_time < \?\s*\)\s*\)`).
-					WithArgs(3, "monday", end, start, start, end).
+					WithArgs(3, "monday", end, start, start, end, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -572,7 +575,7 @@ func TestAvailableSlotMySQLRepository_HasConflict(t *testing.T) {
 			end:     end,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM available_slots WHERE staff_id = \? AND weekday = \? AND \(\s*\(\s*start_time < \? AND end_time > \?\s*\)\s*OR\s*\(\s*start_time >= \? AND start_time < \?\s*\)\s*\)`).
-					WithArgs(4, "monday", end, start, start, end).
+					WithArgs(4, "monday", end, start, start, end, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			want:    false,
@@ -592,7 +595,7 @@ func TestAvailableSlotMySQLRepository_HasConflict(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAvailableSlotMySQLRepository(db)
-			got, err := repo.HasConflict(tt.staffID, tt.weekday, tt.start, tt.end)
+			got, err := repo.HasConflict(testCtx, tt.staffID, tt.weekday, tt.start, tt.end)
 
 			if tt.wantErr {
 				if err == nil {
@@ -641,7 +644,7 @@ func TestAvailableSlotMySQLRepository_IsWithinAvailableSlot(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM available_slots WHERE staff_id = \? AND weekday = \? AND start_time <= \? AND end_time >= \?`).
-					WithArgs(1, "monday", start, end).
+					WithArgs(1, "monday", start, end, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -655,7 +658,7 @@ func TestAvailableSlotMySQLRepository_IsWithinAvailableSlot(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(0)
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM available_slots WHERE staff_id = \? AND weekday = \? AND start_time <= \? AND end_time >= \?`).
-					WithArgs(2, "monday", start, end).
+					WithArgs(2, "monday", start, end, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    false,
@@ -668,7 +671,7 @@ func TestAvailableSlotMySQLRepository_IsWithinAvailableSlot(t *testing.T) {
 			end:     end,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM available_slots WHERE staff_id = \? AND weekday = \? AND start_time <= \? AND end_time >= \?`).
-					WithArgs(3, "monday", start, end).
+					WithArgs(3, "monday", start, end, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			want:    false,
@@ -688,7 +691,7 @@ func TestAvailableSlotMySQLRepository_IsWithinAvailableSlot(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAvailableSlotMySQLRepository(db)
-			got, err := repo.IsWithinAvailableSlot(tt.staffID, tt.start, tt.end)
+			got, err := repo.IsWithinAvailableSlot(testCtx, tt.staffID, tt.start, tt.end)
 
 			if tt.wantErr {
 				if err == nil {
@@ -733,11 +736,12 @@ func TestAvailableSlotMySQLRepository_Save(t *testing.T) {
 				if err != nil {
 					panic("failed to create slot: " + err.Error())
 				}
+				slot.SetLocationID(2)
 				return slot
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("INSERT INTO available_slots \\(staff_id, weekday, start_time, end_time\\) VALUES \\(\\?, \\?, \\?, \\?\\)").
-					WithArgs(1, "monday", startTime, endTime).
+				mock.ExpectExec("INSERT INTO available_slots \\(staff_id, weekday, start_time, end_time, location_id, tenant_id\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?\\)").
+					WithArgs(1, "monday", startTime, endTime, 2, testTenantID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -752,8 +756,8 @@ func TestAvailableSlotMySQLRepository_Save(t *testing.T) {
 				return slot
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("INSERT INTO available_slots \\(staff_id, weekday, start_time, end_time\\) VALUES \\(\\?, \\?, \\?, \\?\\)").
-					WithArgs(2, "tuesday", startTime, endTime).
+				mock.ExpectExec("INSERT INTO available_slots \\(staff_id, weekday, start_time, end_time, location_id, tenant_id\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?\\)").
+					WithArgs(2, "tuesday", startTime, endTime, nil, testTenantID).
 					WillReturnError(errors.New("database insert error"))
 			},
 			wantErr: true,
@@ -773,7 +777,7 @@ func TestAvailableSlotMySQLRepository_Save(t *testing.T) {
 
 			repo := NewAvailableSlotMySQLRepository(db)
 			slot := tt.slot()
-			err = repo.Save(slot)
+			err = repo.Save(testCtx, slot)
 
 			if tt.wantErr {
 				if err == nil {
@@ -818,8 +822,8 @@ func TestAvailableSlotMySQLRepository_Update(t *testing.T) {
 				return slot
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("UPDATE available_slots SET weekday = \\?, start_time = \\?, end_time = \\? WHERE id = \\?").
-					WithArgs("monday", startTime, endTime, 1).
+				mock.ExpectExec("UPDATE available_slots SET weekday = \\?, start_time = \\?, end_time = \\?, location_id = \\? WHERE id = \\?").
+					WithArgs("monday", startTime, endTime, nil, 1, testTenantID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -835,8 +839,8 @@ func TestAvailableSlotMySQLRepository_Update(t *testing.T) {
 				return slot
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("UPDATE available_slots SET weekday = \\?, start_time = \\?, end_time = \\? WHERE id = \\?").
-					WithArgs("tuesday", startTime, endTime, 2).
+				mock.ExpectExec("UPDATE available_slots SET weekday = \\?, start_time = \\?, end_time = \\?, location_id = \\? WHERE id = \\?").
+					WithArgs("tuesday", startTime, endTime, nil, 2, testTenantID).
 					WillReturnError(errors.New("database update error"))
 			},
 			wantErr: true,
@@ -856,7 +860,7 @@ func TestAvailableSlotMySQLRepository_Update(t *testing.T) {
 
 			repo := NewAvailableSlotMySQLRepository(db)
 			slot := tt.slot()
-			err = repo.Update(slot)
+			err = repo.Update(testCtx, slot)
 
 			if tt.wantErr {
 				if err == nil {
@@ -892,7 +896,7 @@ func TestAvailableSlotMySQLRepository_Delete(t *testing.T) {
 			slotID: 1,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("DELETE FROM available_slots WHERE id = \\?").
-					WithArgs(1).
+					WithArgs(1, testTenantID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -902,7 +906,7 @@ func TestAvailableSlotMySQLRepository_Delete(t *testing.T) {
 			slotID: 2,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("DELETE FROM available_slots WHERE id = \\?").
-					WithArgs(2).
+					WithArgs(2, testTenantID).
 					WillReturnError(errors.New("database delete error"))
 			},
 			wantErr: true,
@@ -913,7 +917,7 @@ func TestAvailableSlotMySQLRepository_Delete(t *testing.T) {
 			slotID: 999,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("DELETE FROM available_slots WHERE id = \\?").
-					WithArgs(999).
+					WithArgs(999, testTenantID).
 					WillReturnResult(sqlmock.NewResult(999, 0))
 			},
 			wantErr: false,
@@ -931,7 +935,7 @@ func TestAvailableSlotMySQLRepository_Delete(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewAvailableSlotMySQLRepository(db)
-			err = repo.Delete(tt.slotID)
+			err = repo.Delete(testCtx, tt.slotID)
 
 			if tt.wantErr {
 				if err == nil {
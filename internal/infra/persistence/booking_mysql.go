@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/infra/database"
+)
+
+type BookingMySQLRepository struct {
+	db *sql.DB
+	tm database.TransactionManager
+}
+
+func NewBookingMySQLRepository(db *sql.DB) *BookingMySQLRepository {
+	return &BookingMySQLRepository{db: db, tm: database.NewTransactionManager(db)}
+}
+
+// Book trava a linha do profissional e as linhas dos recursos (em ordem de id,
+// para evitar deadlock) antes de conferir conflitos, de modo que duas reservas
+// concorrentes para o mesmo horário nunca passem juntas pela verificação.
+func (r *BookingMySQLRepository) Book(
+	ctx context.Context,
+	appointment *entities.Appointment,
+	duration time.Duration,
+	requirements []*entities.ResourceRequirement,
+) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	start := appointment.ScheduledAt()
+	end := start.Add(duration)
+
+	sorted := make([]*entities.ResourceRequirement, len(requirements))
+	copy(sorted, requirements)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ResourceID() < sorted[j].ResourceID() })
+
+	return r.tm.WithTransaction(ctx, func(tx *sql.Tx) error {
+		var staffID int
+		err := tx.QueryRowContext(ctx, "SELECT id FROM users WHERE id = ? AND tenant_id = ? FOR UPDATE", appointment.StaffID(), tenantID).Scan(&staffID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return repositories.ErrStaffNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		// o deslocamento entre unidades também ocupa o profissional, como em
+		// AvailabilityService.bookedRanges: antes de um agendamento em outra
+		// unidade, a ida a partir desta; depois dele, a volta. Sem o trecho
+		// cadastrado na direção pedida, vale o da direção oposta.
+		conflictQuery := `
+			SELECT COUNT(*) FROM appointments a
+			INNER JOIN services s ON s.id = a.service_id
+			LEFT JOIN location_travel_times outbound ON outbound.tenant_id = a.tenant_id
+				AND outbound.from_location_id = ? AND outbound.to_location_id = a.location_id
+			LEFT JOIN location_travel_times inbound ON inbound.tenant_id = a.tenant_id
+				AND inbound.from_location_id = a.location_id AND inbound.to_location_id = ?
+			WHERE a.staff_id = ? AND a.status = 'scheduled' AND a.tenant_id = ?
+			AND DATE_SUB(a.scheduled_at, INTERVAL COALESCE(outbound.minutes, inbound.minutes, 0) MINUTE) < ?
+			AND DATE_ADD(a.scheduled_at, INTERVAL s.duration + COALESCE(inbound.minutes, outbound.minutes, 0) MINUTE) > ?
+		`
+		locationID := appointment.LocationID()
+		var conflicts int
+		if err := tx.QueryRowContext(ctx, conflictQuery, locationID, locationID, staffID, tenantID, end, start).Scan(&conflicts); err != nil {
+			return err
+		}
+		if conflicts > 0 {
+			return repositories.ErrStaffUnavailable
+		}
+
+		for _, requirement := range sorted {
+			var capacity int
+			err := tx.QueryRowContext(ctx, "SELECT capacity FROM resources WHERE id = ? AND tenant_id = ? FOR UPDATE", requirement.ResourceID(), tenantID).Scan(&capacity)
+			if errors.Is(err, sql.ErrNoRows) {
+				return repositories.ErrResourceNotFound
+			}
+			if err != nil {
+				return err
+			}
+
+			allocations, err := findAllocations(ctx, tx, tenantID, requirement.ResourceID(), start, end)
+			if err != nil {
+				return err
+			}
+
+			if entities.PeakUsage(allocations, start, end)+requirement.Quantity() > capacity {
+				return repositories.ErrResourceUnavailable
+			}
+		}
+
+		result, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO appointments (client_id, staff_id, service_id, scheduled_at, status, created_at, location_id, tenant_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+			appointment.ClientID(),
+			appointment.StaffID(),
+			appointment.ServiceID(),
+			appointment.ScheduledAt(),
+			appointment.Status(),
+			appointment.CreatedAt(),
+			nullableID(appointment.LocationID()),
+			tenantID,
+		)
+		if err != nil {
+			return err
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		for _, requirement := range sorted {
+			_, err := tx.ExecContext(
+				ctx,
+				"INSERT INTO appointment_resources (appointment_id, resource_id, quantity, starts_at, ends_at, tenant_id) VALUES (?, ?, ?, ?, ?, ?)",
+				id,
+				requirement.ResourceID(),
+				requirement.Quantity(),
+				start,
+				end,
+				tenantID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		appointment.SetID(int(id))
+		return nil
+	})
+}
@@ -0,0 +1,160 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBookingMySQLRepository_Book(t *testing.T) {
+	scheduledTime := time.Now().AddDate(0, 1, 0).Truncate(time.Minute)
+	endTime := scheduledTime.Add(time.Hour)
+	allocationColumns := []string{"resource_id", "quantity", "starts_at", "ends_at"}
+
+	lockStaff := "SELECT id FROM users WHERE id = \\? AND tenant_id = \\? FOR UPDATE"
+	staffConflict := "SELECT COUNT\\(\\*\\) FROM appointments a\\s+INNER JOIN services s.+LEFT JOIN location_travel_times outbound.+LEFT JOIN location_travel_times inbound"
+	lockResource := "SELECT capacity FROM resources WHERE id = \\? AND tenant_id = \\? FOR UPDATE"
+	allocations := "SELECT ar.resource_id, ar.quantity, ar.starts_at, ar.ends_at FROM appointment_resources ar"
+	insertAppointment := "INSERT INTO appointments"
+	insertResource := "INSERT INTO appointment_resources"
+
+	tests := []struct {
+		name       string
+		locationID int
+		mockFn     func(sqlmock.Sqlmock)
+		wantErr    error
+		wantID     int
+	}{
+		{
+			name: "agendamento reservado com recurso livre",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockStaff).WithArgs(2, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectQuery(staffConflict).WithArgs(0, 0, 2, testTenantID, endTime, scheduledTime).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(lockResource).WithArgs(7, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(2))
+				mock.ExpectQuery(allocations).WithArgs(7, endTime, scheduledTime, testTenantID).WillReturnRows(
+					sqlmock.NewRows(allocationColumns).AddRow(7, 1, scheduledTime, endTime),
+				)
+				mock.ExpectExec(insertAppointment).WillReturnResult(sqlmock.NewResult(15, 1))
+				mock.ExpectExec(insertResource).WithArgs(15, 7, 1, scheduledTime, endTime, testTenantID).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			wantID: 15,
+		},
+		{
+			name: "profissional ocupado no horário",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockStaff).WithArgs(2, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectQuery(staffConflict).WithArgs(0, 0, 2, testTenantID, endTime, scheduledTime).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+			wantErr: repositories.ErrStaffUnavailable,
+		},
+		{
+			name:       "deslocamento entre unidades conta como ocupado",
+			locationID: 5,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockStaff).WithArgs(2, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectQuery(staffConflict).WithArgs(5, 5, 2, testTenantID, endTime, scheduledTime).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+			},
+			wantErr: repositories.ErrStaffUnavailable,
+		},
+		{
+			name: "profissional inexistente",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockStaff).WithArgs(2, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			wantErr: repositories.ErrStaffNotFound,
+		},
+		{
+			name: "recurso inexistente",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockStaff).WithArgs(2, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectQuery(staffConflict).WithArgs(0, 0, 2, testTenantID, endTime, scheduledTime).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(lockResource).WithArgs(7, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"capacity"}))
+				mock.ExpectRollback()
+			},
+			wantErr: repositories.ErrResourceNotFound,
+		},
+		{
+			name: "recurso sem capacidade no horário",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockStaff).WithArgs(2, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectQuery(staffConflict).WithArgs(0, 0, 2, testTenantID, endTime, scheduledTime).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(lockResource).WithArgs(7, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(1))
+				mock.ExpectQuery(allocations).WithArgs(7, endTime, scheduledTime, testTenantID).WillReturnRows(
+					sqlmock.NewRows(allocationColumns).AddRow(7, 1, scheduledTime.Add(-30*time.Minute), scheduledTime.Add(30*time.Minute)),
+				)
+				mock.ExpectRollback()
+			},
+			wantErr: repositories.ErrResourceUnavailable,
+		},
+		{
+			name: "erro ao gravar agendamento desfaz a transação",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockStaff).WithArgs(2, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+				mock.ExpectQuery(staffConflict).WithArgs(0, 0, 2, testTenantID, endTime, scheduledTime).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(lockResource).WithArgs(7, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(1))
+				mock.ExpectQuery(allocations).WithArgs(7, endTime, scheduledTime, testTenantID).WillReturnRows(sqlmock.NewRows(allocationColumns))
+				mock.ExpectExec(insertAppointment).WillReturnError(errors.New("database insert error"))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("database insert error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			appointment, err := entities.NewAppointment(1, 2, 3, scheduledTime)
+			if err != nil {
+				t.Fatalf("erro ao criar agendamento: %v", err)
+			}
+			appointment.SetLocationID(tt.locationID)
+			requirement, _ := entities.NewResourceRequirement(3, 7, 1)
+
+			repo := NewBookingMySQLRepository(db)
+			err = repo.Book(testCtx, appointment, time.Hour, []*entities.ResourceRequirement{requirement})
+
+			if tt.wantErr != nil {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("erro esperado '%v', obtido '%v'", tt.wantErr, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("erro inesperado: %v", err)
+				}
+				if appointment.ID() != tt.wantID {
+					t.Errorf("ID esperado %d, obtido %d", tt.wantID, appointment.ID())
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+type BusinessSettingsMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewBusinessSettingsMySQLRepository(db *sql.DB) *BusinessSettingsMySQLRepository {
+	return &BusinessSettingsMySQLRepository{db: db}
+}
+
+func (r *BusinessSettingsMySQLRepository) Find(ctx context.Context) (*entities.BusinessSettings, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT slot_step_minutes, timezone, currency, cancellation_notice_minutes, booking_horizon_days, branding_name, updated_at
+		FROM business_settings
+		WHERE tenant_id = ?
+	`
+
+	var slotStep, notice, horizon int
+	var timezone, currency, branding string
+	var updatedAt time.Time
+
+	err = r.db.QueryRowContext(ctx, query, tenantID).Scan(&slotStep, &timezone, &currency, &notice, &horizon, &branding, &updatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := entities.NewBusinessSettings(slotStep, timezone, currency, notice, horizon, branding)
+	if err != nil {
+		return nil, err
+	}
+	settings.SetUpdatedAt(updatedAt)
+
+	return settings, nil
+}
+
+func (r *BusinessSettingsMySQLRepository) Save(ctx context.Context, settings *entities.BusinessSettings) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	settings.SetUpdatedAt(time.Now())
+
+	query := `
+		INSERT INTO business_settings (slot_step_minutes, timezone, currency, cancellation_notice_minutes, booking_horizon_days, branding_name, updated_at, tenant_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			slot_step_minutes = VALUES(slot_step_minutes),
+			timezone = VALUES(timezone),
+			currency = VALUES(currency),
+			cancellation_notice_minutes = VALUES(cancellation_notice_minutes),
+			booking_horizon_days = VALUES(booking_horizon_days),
+			branding_name = VALUES(branding_name),
+			updated_at = VALUES(updated_at)
+	`
+	_, err = r.db.ExecContext(ctx, query,
+		int(settings.SlotStep()/time.Minute),
+		settings.Timezone().String(),
+		settings.Currency(),
+		int(settings.CancellationNotice()/time.Minute),
+		settings.BookingHorizonDays(),
+		settings.BrandingName(),
+		settings.UpdatedAt(),
+		tenantID,
+	)
+	return err
+}
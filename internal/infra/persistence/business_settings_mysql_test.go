@@ -0,0 +1,114 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestBusinessSettingsMySQLRepository_Find(t *testing.T) {
+	updatedTime := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	query := "SELECT slot_step_minutes, timezone, currency, cancellation_notice_minutes, booking_horizon_days, branding_name, updated_at\\s+FROM business_settings\\s+WHERE tenant_id = \\?"
+	columns := []string{"slot_step_minutes", "timezone", "currency", "cancellation_notice_minutes", "booking_horizon_days", "branding_name", "updated_at"}
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    func(*testing.T, *entities.BusinessSettings)
+		wantErr bool
+	}{
+		{
+			name: "configuração encontrada",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(30, "America/Sao_Paulo", "BRL", 120, 60, "Studio", updatedTime)
+				mock.ExpectQuery(query).WithArgs(testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, s *entities.BusinessSettings) {
+				if s.SlotStep() != 30*time.Minute || s.Timezone().String() != "America/Sao_Paulo" || s.BookingHorizonDays() != 60 {
+					t.Errorf("configuração com valores incorretos: passo=%v fuso=%s horizonte=%d", s.SlotStep(), s.Timezone(), s.BookingHorizonDays())
+				}
+				if !s.UpdatedAt().Equal(updatedTime) {
+					t.Errorf("UpdatedAt esperado %v, obtido %v", updatedTime, s.UpdatedAt())
+				}
+			},
+		},
+		{
+			name: "tenant sem configuração",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(testTenantID).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			want: func(t *testing.T, s *entities.BusinessSettings) {
+				if s != nil {
+					t.Errorf("esperado nil, obtido %+v", s)
+				}
+			},
+		},
+		{
+			name: "erro no banco de dados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(testTenantID).WillReturnError(errors.New("database connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewBusinessSettingsMySQLRepository(db)
+			got, err := repo.Find(testCtx)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			tt.want(t, got)
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestBusinessSettingsMySQLRepository_Save(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("INSERT INTO business_settings .* ON DUPLICATE KEY UPDATE").
+		WithArgs(30, "America/Sao_Paulo", "BRL", 120, 60, "Studio", sqlmock.AnyArg(), testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	settings, _ := entities.NewBusinessSettings(30, "America/Sao_Paulo", "BRL", 120, 60, "Studio")
+	repo := NewBusinessSettingsMySQLRepository(db)
+	if err := repo.Save(testCtx, settings); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if settings.UpdatedAt().IsZero() {
+		t.Error("UpdatedAt não deve ser zero após salvar")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+type ExternalIdentityMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewExternalIdentityMySQLRepository(db *sql.DB) *ExternalIdentityMySQLRepository {
+	return &ExternalIdentityMySQLRepository{db: db}
+}
+
+func (r *ExternalIdentityMySQLRepository) FindBySubject(ctx context.Context, provider, subject string) (*entities.ExternalIdentity, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, user_id, provider, subject, email, created_at
+		FROM external_identities
+		WHERE provider = ? AND subject = ? AND tenant_id = ?
+	`
+
+	var id, userID int
+	var providerDB, subjectDB, email string
+	var createdAt time.Time
+
+	err = r.db.QueryRowContext(ctx, query, provider, subject, tenantID).Scan(&id, &userID, &providerDB, &subjectDB, &email, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entities.RebuildExternalIdentity(id, userID, providerDB, subjectDB, email, createdAt), nil
+}
+
+func (r *ExternalIdentityMySQLRepository) Create(ctx context.Context, identity *entities.ExternalIdentity) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO external_identities (user_id, provider, subject, email, created_at, tenant_id)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		identity.UserID(),
+		identity.Provider(),
+		identity.Subject(),
+		identity.Email(),
+		identity.CreatedAt(),
+		tenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	identity.SetID(int(id))
+
+	return nil
+}
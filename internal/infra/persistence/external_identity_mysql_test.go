@@ -0,0 +1,82 @@
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestExternalIdentityMySQLRepository_FindBySubject(t *testing.T) {
+	query := "SELECT id, user_id, provider, subject, email, created_at\\s+FROM external_identities\\s+WHERE provider = \\? AND subject = \\? AND tenant_id = \\?"
+	columns := []string{"id", "user_id", "provider", "subject", "email", "created_at"}
+	createdAt := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+
+	t.Run("vínculo encontrado", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("erro ao criar mock do banco: %v", err)
+		}
+		defer db.Close()
+
+		rows := sqlmock.NewRows(columns).AddRow(4, 7, "google", "sub-123", "maria@gmail.com", createdAt)
+		mock.ExpectQuery(query).WithArgs("google", "sub-123", testTenantID).WillReturnRows(rows)
+
+		repo := NewExternalIdentityMySQLRepository(db)
+		identity, err := repo.FindBySubject(testCtx, "google", "sub-123")
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if identity == nil || identity.UserID() != 7 || identity.Email() != "maria@gmail.com" {
+			t.Errorf("identidade com valores incorretos: %+v", identity)
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("expectativas do mock não foram atendidas: %v", err)
+		}
+	})
+
+	t.Run("sem vínculo", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("erro ao criar mock do banco: %v", err)
+		}
+		defer db.Close()
+
+		mock.ExpectQuery(query).WithArgs("google", "sub-123", testTenantID).WillReturnRows(sqlmock.NewRows(columns))
+
+		repo := NewExternalIdentityMySQLRepository(db)
+		identity, err := repo.FindBySubject(testCtx, "google", "sub-123")
+		if err != nil || identity != nil {
+			t.Errorf("esperado nil, nil; obtido %+v, %v", identity, err)
+		}
+	})
+}
+
+func TestExternalIdentityMySQLRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	identity, _ := entities.NewExternalIdentity(7, "google", "sub-123", "maria@gmail.com")
+
+	mock.ExpectExec("INSERT INTO external_identities \\(user_id, provider, subject, email, created_at, tenant_id\\)").
+		WithArgs(7, "google", "sub-123", "maria@gmail.com", sqlmock.AnyArg(), testTenantID).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+
+	repo := NewExternalIdentityMySQLRepository(db)
+	if err := repo.Create(testCtx, identity); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if identity.ID() != 4 {
+		t.Errorf("ID esperado 4, obtido %d", identity.ID())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+type LocationMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewLocationMySQLRepository(db *sql.DB) *LocationMySQLRepository {
+	return &LocationMySQLRepository{db: db}
+}
+
+func (r *LocationMySQLRepository) FindByID(ctx context.Context, id int) (*entities.Location, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, name, address, created_at FROM locations WHERE id = ? AND tenant_id = ?"
+	row := r.db.QueryRowContext(ctx, query, id, tenantID)
+
+	var locationID int
+	var name, address string
+	var createdAt time.Time
+
+	err = row.Scan(&locationID, &name, &address, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := entities.NewLocation(locationID, name, address)
+	if err != nil {
+		return nil, err
+	}
+	location.SetCreatedAt(createdAt)
+
+	return location, nil
+}
+
+func (r *LocationMySQLRepository) FindAll(ctx context.Context) ([]*entities.Location, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, name, address, created_at FROM locations WHERE tenant_id = ? ORDER BY name"
+	rows, err := r.db.QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locations []*entities.Location
+	for rows.Next() {
+		var id int
+		var name, address string
+		var createdAt time.Time
+
+		err := rows.Scan(&id, &name, &address, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		location, err := entities.NewLocation(id, name, address)
+		if err != nil {
+			return nil, err
+		}
+		location.SetCreatedAt(createdAt)
+		locations = append(locations, location)
+	}
+
+	return locations, nil
+}
+
+func (r *LocationMySQLRepository) Save(ctx context.Context, location *entities.Location) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO locations (name, address, created_at, tenant_id) VALUES (?, ?, ?, ?)"
+	result, err := r.db.ExecContext(ctx, query, location.Name(), location.Address(), location.CreatedAt(), tenantID)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	location.SetID(int(id))
+
+	return nil
+}
+
+func (r *LocationMySQLRepository) AddService(ctx context.Context, locationID, serviceID int) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT IGNORE INTO location_services (location_id, service_id, tenant_id) VALUES (?, ?, ?)"
+	_, err = r.db.ExecContext(ctx, query, locationID, serviceID, tenantID)
+	return err
+}
+
+func (r *LocationMySQLRepository) OffersService(ctx context.Context, locationID, serviceID int) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := "SELECT COUNT(*) FROM location_services WHERE location_id = ? AND service_id = ? AND tenant_id = ?"
+	var count int
+	err = r.db.QueryRowContext(ctx, query, locationID, serviceID, tenantID).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// TravelTime usa o trajeto cadastrado no sentido pedido e, na falta dele, o
+// sentido inverso. Sem nenhum cadastro o deslocamento é considerado zero.
+func (r *LocationMySQLRepository) TravelTime(ctx context.Context, fromLocationID, toLocationID int) (time.Duration, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+		SELECT minutes FROM location_travel_times
+		WHERE ((from_location_id = ? AND to_location_id = ?) OR (from_location_id = ? AND to_location_id = ?))
+		AND tenant_id = ?
+		ORDER BY from_location_id = ? DESC
+		LIMIT 1
+	`
+	var minutes int
+	err = r.db.QueryRowContext(ctx, query, fromLocationID, toLocationID, toLocationID, fromLocationID, tenantID, fromLocationID).Scan(&minutes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(minutes) * time.Minute, nil
+}
+
+func (r *LocationMySQLRepository) SetTravelTime(ctx context.Context, fromLocationID, toLocationID int, travel time.Duration) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO location_travel_times (from_location_id, to_location_id, minutes, tenant_id) VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE minutes = VALUES(minutes)
+	`
+	_, err = r.db.ExecContext(ctx, query, fromLocationID, toLocationID, int(travel/time.Minute), tenantID)
+	return err
+}
+
+func nullableID(id int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(id), Valid: id != 0}
+}
@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLocationMySQLRepository_FindByID(t *testing.T) {
+	createdTime := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	query := "SELECT id, name, address, created_at FROM locations WHERE id = \\?"
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "name", "address", "created_at"}).
+		AddRow(1, "Centro", "Rua A, 100", createdTime)
+	mock.ExpectQuery(query).WithArgs(1, testTenantID).WillReturnRows(rows)
+
+	repo := NewLocationMySQLRepository(db)
+	location, err := repo.FindByID(testCtx, 1)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if location.ID() != 1 || location.Name() != "Centro" || location.Address() != "Rua A, 100" {
+		t.Errorf("unidade com valores incorretos: id=%d nome=%s endereço=%s", location.ID(), location.Name(), location.Address())
+	}
+	if !location.CreatedAt().Equal(createdTime) {
+		t.Errorf("CreatedAt esperado %v, obtido %v", createdTime, location.CreatedAt())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestLocationMySQLRepository_OffersService(t *testing.T) {
+	query := "SELECT COUNT\\(\\*\\) FROM location_services WHERE location_id = \\? AND service_id = \\?"
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "serviço oferecido na unidade",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1, 3, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			want: true,
+		},
+		{
+			name: "serviço não oferecido na unidade",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1, 3, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			},
+			want: false,
+		},
+		{
+			name: "erro no banco de dados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1, 3, testTenantID).WillReturnError(errors.New("database connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewLocationMySQLRepository(db)
+			got, err := repo.OffersService(testCtx, 1, 3)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("esperado %v, obtido %v", tt.want, got)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestLocationMySQLRepository_TravelTime(t *testing.T) {
+	query := "SELECT minutes FROM location_travel_times"
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    time.Duration
+		wantErr bool
+	}{
+		{
+			name: "trajeto cadastrado",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1, 2, 2, 1, 1, testTenantID).WillReturnRows(sqlmock.NewRows([]string{"minutes"}).AddRow(25))
+			},
+			want: 25 * time.Minute,
+		},
+		{
+			name: "trajeto sem cadastro não tem deslocamento",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1, 2, 2, 1, 1, testTenantID).WillReturnError(sql.ErrNoRows)
+			},
+			want: 0,
+		},
+		{
+			name: "erro no banco de dados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1, 2, 2, 1, 1, testTenantID).WillReturnError(errors.New("database connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewLocationMySQLRepository(db)
+			got, err := repo.TravelTime(testCtx, 1, 2)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("esperado %v, obtido %v", tt.want, got)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package persistence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+// pruneEvery define de quantas em quantas falhas os contadores obsoletos são
+// removidos, para que IPs que não voltam não fiquem para sempre na memória.
+const pruneEvery = 256
+
+type loginAttemptKey struct {
+	tenantID int
+	scope    string
+	key      string
+}
+
+type loginAttemptRecord struct {
+	failures      int
+	lastFailureAt time.Time
+	lockedUntil   *time.Time
+}
+
+// LoginAttemptMemoryRepository mantém os contadores na memória do processo.
+// Serve para uma única instância; com várias, cada uma contaria só as falhas
+// que recebeu.
+type LoginAttemptMemoryRepository struct {
+	mu      sync.Mutex
+	records map[loginAttemptKey]*loginAttemptRecord
+	writes  int
+}
+
+func NewLoginAttemptMemoryRepository() *LoginAttemptMemoryRepository {
+	return &LoginAttemptMemoryRepository{records: map[loginAttemptKey]*loginAttemptRecord{}}
+}
+
+func (r *LoginAttemptMemoryRepository) Find(ctx context.Context, scope, key string) (*entities.LoginAttempts, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, ok := r.records[loginAttemptKey{tenantID, scope, key}]
+	if !ok {
+		return nil, nil
+	}
+
+	return entities.RebuildLoginAttempts(scope, key, record.failures, record.lastFailureAt, record.lockedUntil), nil
+}
+
+func (r *LoginAttemptMemoryRepository) RecordFailure(ctx context.Context, scope, key string, at, since time.Time) (int, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.writes++
+	if r.writes%pruneEvery == 0 {
+		r.prune(at, since)
+	}
+
+	id := loginAttemptKey{tenantID, scope, key}
+	record, ok := r.records[id]
+	if !ok {
+		record = &loginAttemptRecord{}
+		r.records[id] = record
+	}
+
+	if record.lastFailureAt.Before(since) {
+		record.failures = 0
+	}
+	record.failures++
+	record.lastFailureAt = at
+
+	return record.failures, nil
+}
+
+func (r *LoginAttemptMemoryRepository) Lock(ctx context.Context, scope, key string, until time.Time) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if record, ok := r.records[loginAttemptKey{tenantID, scope, key}]; ok {
+		record.lockedUntil = &until
+	}
+	return nil
+}
+
+func (r *LoginAttemptMemoryRepository) Reset(ctx context.Context, scope, key string) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.records, loginAttemptKey{tenantID, scope, key})
+	return nil
+}
+
+// prune descarta contadores cujas falhas já não contam e que não estão
+// bloqueados; a próxima falha recomeçaria do zero de qualquer forma.
+func (r *LoginAttemptMemoryRepository) prune(now, since time.Time) {
+	for id, record := range r.records {
+		locked := record.lockedUntil != nil && now.Before(*record.lockedUntil)
+		if record.lastFailureAt.Before(since) && !locked {
+			delete(r.records, id)
+		}
+	}
+}
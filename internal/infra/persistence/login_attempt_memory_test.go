@@ -0,0 +1,58 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+func TestLoginAttemptMemoryRepository(t *testing.T) {
+	repo := NewLoginAttemptMemoryRepository()
+	now := time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC)
+	since := now.Add(-time.Hour)
+
+	for i := 1; i <= 3; i++ {
+		failures, err := repo.RecordFailure(testCtx, entities.LoginScopeAccount, "ana@gmail.com", now, since)
+		if err != nil {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+		if failures != i {
+			t.Errorf("falhas esperadas %d, obtidas %d", i, failures)
+		}
+	}
+
+	// o mesmo email em outro tenant é outra conta
+	otherTenant := tenant.WithID(context.Background(), testTenantID+1)
+	if failures, _ := repo.RecordFailure(otherTenant, entities.LoginScopeAccount, "ana@gmail.com", now, since); failures != 1 {
+		t.Errorf("contador deveria ser separado por tenant, obtidas %d falhas", failures)
+	}
+
+	until := now.Add(time.Minute)
+	if err := repo.Lock(testCtx, entities.LoginScopeAccount, "ana@gmail.com", until); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	attempts, _ := repo.Find(testCtx, entities.LoginScopeAccount, "ana@gmail.com")
+	if attempts == nil || !attempts.IsLocked(now) {
+		t.Fatal("conta deveria estar bloqueada")
+	}
+
+	// falhas fora da janela não contam
+	later := now.Add(2 * time.Hour)
+	if failures, _ := repo.RecordFailure(testCtx, entities.LoginScopeAccount, "ana@gmail.com", later, later.Add(-time.Hour)); failures != 1 {
+		t.Errorf("contagem deveria recomeçar, obtidas %d falhas", failures)
+	}
+
+	if err := repo.Reset(testCtx, entities.LoginScopeAccount, "ana@gmail.com"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if attempts, _ := repo.Find(testCtx, entities.LoginScopeAccount, "ana@gmail.com"); attempts != nil {
+		t.Error("contador deveria ter sido removido")
+	}
+
+	if _, err := repo.Find(context.Background(), entities.LoginScopeAccount, "ana@gmail.com"); err == nil {
+		t.Error("esperado erro sem tenant no contexto")
+	}
+}
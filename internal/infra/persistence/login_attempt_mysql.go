@@ -0,0 +1,110 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/infra/database"
+)
+
+type LoginAttemptMySQLRepository struct {
+	db *sql.DB
+	tm database.TransactionManager
+}
+
+func NewLoginAttemptMySQLRepository(db *sql.DB) *LoginAttemptMySQLRepository {
+	return &LoginAttemptMySQLRepository{db: db, tm: database.NewTransactionManager(db)}
+}
+
+func (r *LoginAttemptMySQLRepository) Find(ctx context.Context, scope, key string) (*entities.LoginAttempts, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT failures, last_failure_at, locked_until
+		FROM login_attempts
+		WHERE scope = ? AND attempt_key = ? AND tenant_id = ?
+	`
+
+	var failures int
+	var lastFailureAt time.Time
+	var lockedUntil sql.NullTime
+
+	err = r.db.QueryRowContext(ctx, query, scope, key, tenantID).Scan(&failures, &lastFailureAt, &lockedUntil)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var locked *time.Time
+	if lockedUntil.Valid {
+		locked = &lockedUntil.Time
+	}
+
+	return entities.RebuildLoginAttempts(scope, key, failures, lastFailureAt, locked), nil
+}
+
+// RecordFailure incrementa e lê o contador na mesma transação; a linha fica
+// travada pelo upsert, então instâncias concorrentes não perdem falhas.
+func (r *LoginAttemptMySQLRepository) RecordFailure(ctx context.Context, scope, key string, at, since time.Time) (int, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// failures é atribuído antes de last_failure_at, então o IF compara com a
+	// falha anterior
+	upsert := `
+		INSERT INTO login_attempts (scope, attempt_key, failures, last_failure_at, tenant_id)
+		VALUES (?, ?, 1, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			failures = IF(last_failure_at >= ?, failures + 1, 1),
+			last_failure_at = VALUES(last_failure_at)
+	`
+
+	var failures int
+	err = r.tm.WithTransaction(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, upsert, scope, key, at, tenantID, since); err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx,
+			"SELECT failures FROM login_attempts WHERE scope = ? AND attempt_key = ? AND tenant_id = ?",
+			scope, key, tenantID,
+		).Scan(&failures)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return failures, nil
+}
+
+func (r *LoginAttemptMySQLRepository) Lock(ctx context.Context, scope, key string, until time.Time) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE login_attempts SET locked_until = ? WHERE scope = ? AND attempt_key = ? AND tenant_id = ?`
+	_, err = r.db.ExecContext(ctx, query, until, scope, key, tenantID)
+	return err
+}
+
+func (r *LoginAttemptMySQLRepository) Reset(ctx context.Context, scope, key string) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `DELETE FROM login_attempts WHERE scope = ? AND attempt_key = ? AND tenant_id = ?`
+	_, err = r.db.ExecContext(ctx, query, scope, key, tenantID)
+	return err
+}
@@ -0,0 +1,147 @@
+package persistence
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLoginAttemptMySQLRepository_Find(t *testing.T) {
+	query := "SELECT failures, last_failure_at, locked_until\\s+FROM login_attempts\\s+WHERE scope = \\? AND attempt_key = \\? AND tenant_id = \\?"
+	columns := []string{"failures", "last_failure_at", "locked_until"}
+	lastFailureAt := time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC)
+	lockedUntil := lastFailureAt.Add(15 * time.Minute)
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    func(*testing.T, *entities.LoginAttempts)
+		wantErr bool
+	}{
+		{
+			name: "conta bloqueada",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(10, lastFailureAt, lockedUntil)
+				mock.ExpectQuery(query).WithArgs(entities.LoginScopeAccount, "ana@gmail.com", testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, attempts *entities.LoginAttempts) {
+				if attempts.Failures() != 10 {
+					t.Errorf("falhas esperadas 10, obtidas %d", attempts.Failures())
+				}
+				if !attempts.IsLocked(lastFailureAt) || attempts.IsLocked(lockedUntil) {
+					t.Error("bloqueio deveria valer até locked_until")
+				}
+			},
+		},
+		{
+			name: "sem falhas registradas",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WillReturnError(sql.ErrNoRows)
+			},
+			want: func(t *testing.T, attempts *entities.LoginAttempts) {
+				if attempts != nil {
+					t.Error("esperado nil quando não há falhas")
+				}
+			},
+		},
+		{
+			name: "erro no banco",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WillReturnError(errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewLoginAttemptMySQLRepository(db)
+			attempts, err := repo.Find(testCtx, entities.LoginScopeAccount, "ana@gmail.com")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("erro = %v, esperava erro = %v", err, tt.wantErr)
+			}
+			if tt.want != nil {
+				tt.want(t, attempts)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoginAttemptMySQLRepository_RecordFailure(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	at := time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC)
+	since := at.Add(-time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO login_attempts \\(scope, attempt_key, failures, last_failure_at, tenant_id\\)\\s+VALUES \\(\\?, \\?, 1, \\?, \\?\\)\\s+ON DUPLICATE KEY UPDATE\\s+failures = IF\\(last_failure_at >= \\?, failures \\+ 1, 1\\)").
+		WithArgs(entities.LoginScopeIP, "10.0.0.1", at, testTenantID, since).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery("SELECT failures FROM login_attempts WHERE scope = \\? AND attempt_key = \\? AND tenant_id = \\?").
+		WithArgs(entities.LoginScopeIP, "10.0.0.1", testTenantID).
+		WillReturnRows(sqlmock.NewRows([]string{"failures"}).AddRow(4))
+	mock.ExpectCommit()
+
+	repo := NewLoginAttemptMySQLRepository(db)
+	failures, err := repo.RecordFailure(testCtx, entities.LoginScopeIP, "10.0.0.1", at, since)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if failures != 4 {
+		t.Errorf("falhas esperadas 4, obtidas %d", failures)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestLoginAttemptMySQLRepository_LockAndReset(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	until := time.Date(2026, 2, 1, 10, 15, 0, 0, time.UTC)
+
+	mock.ExpectExec("UPDATE login_attempts SET locked_until = \\? WHERE scope = \\? AND attempt_key = \\? AND tenant_id = \\?").
+		WithArgs(until, entities.LoginScopeAccount, "ana@gmail.com", testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM login_attempts WHERE scope = \\? AND attempt_key = \\? AND tenant_id = \\?").
+		WithArgs(entities.LoginScopeAccount, "ana@gmail.com", testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewLoginAttemptMySQLRepository(db)
+	if err := repo.Lock(testCtx, entities.LoginScopeAccount, "ana@gmail.com", until); err != nil {
+		t.Fatalf("erro inesperado ao bloquear: %v", err)
+	}
+	if err := repo.Reset(testCtx, entities.LoginScopeAccount, "ana@gmail.com"); err != nil {
+		t.Fatalf("erro inesperado ao desbloquear: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+type RefreshTokenMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewRefreshTokenMySQLRepository(db *sql.DB) *RefreshTokenMySQLRepository {
+	return &RefreshTokenMySQLRepository{db: db}
+}
+
+func (r *RefreshTokenMySQLRepository) Create(ctx context.Context, token *entities.RefreshToken) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO refresh_tokens (user_id, token_hash, family_id, expires_at, created_at, tenant_id)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		token.UserID(),
+		token.TokenHash(),
+		token.FamilyID(),
+		token.ExpiresAt(),
+		token.CreatedAt(),
+		tenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	token.SetID(int(id))
+
+	return nil
+}
+
+func (r *RefreshTokenMySQLRepository) FindByHash(ctx context.Context, tokenHash string) (*entities.RefreshToken, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, user_id, token_hash, family_id, expires_at, revoked_at, created_at
+		FROM refresh_tokens
+		WHERE token_hash = ? AND tenant_id = ?
+	`
+
+	var id, userID int
+	var hash, familyID string
+	var expiresAt, createdAt time.Time
+	var revokedAt sql.NullTime
+
+	err = r.db.QueryRowContext(ctx, query, tokenHash, tenantID).Scan(&id, &userID, &hash, &familyID, &expiresAt, &revokedAt, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var revoked *time.Time
+	if revokedAt.Valid {
+		revoked = &revokedAt.Time
+	}
+
+	return entities.RebuildRefreshToken(id, userID, hash, familyID, expiresAt, revoked, createdAt), nil
+}
+
+// Revoke só marca tokens ainda ativos, então duas renovações simultâneas com
+// o mesmo token não conseguem ambas ter sucesso.
+func (r *RefreshTokenMySQLRepository) Revoke(ctx context.Context, id int) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := `UPDATE refresh_tokens SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL AND tenant_id = ?`
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *RefreshTokenMySQLRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE refresh_tokens SET revoked_at = ? WHERE family_id = ? AND revoked_at IS NULL AND tenant_id = ?`
+	_, err = r.db.ExecContext(ctx, query, time.Now(), familyID, tenantID)
+	return err
+}
+
+func (r *RefreshTokenMySQLRepository) RevokeAllForUser(ctx context.Context, userID int) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE refresh_tokens SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL AND tenant_id = ?`
+	_, err = r.db.ExecContext(ctx, query, time.Now(), userID, tenantID)
+	return err
+}
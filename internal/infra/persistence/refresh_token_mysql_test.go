@@ -0,0 +1,204 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRefreshTokenMySQLRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	expiresAt := time.Date(2026, 1, 20, 10, 0, 0, 0, time.UTC)
+	token, _ := entities.NewRefreshToken(3, "hash-do-token", "familia-1", expiresAt)
+
+	mock.ExpectExec("INSERT INTO refresh_tokens \\(user_id, token_hash, family_id, expires_at, created_at, tenant_id\\)").
+		WithArgs(3, "hash-do-token", "familia-1", expiresAt, sqlmock.AnyArg(), testTenantID).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+
+	repo := NewRefreshTokenMySQLRepository(db)
+	if err := repo.Create(testCtx, token); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if token.ID() != 12 {
+		t.Errorf("ID esperado 12, obtido %d", token.ID())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestRefreshTokenMySQLRepository_FindByHash(t *testing.T) {
+	query := "SELECT id, user_id, token_hash, family_id, expires_at, revoked_at, created_at\\s+FROM refresh_tokens\\s+WHERE token_hash = \\? AND tenant_id = \\?"
+	columns := []string{"id", "user_id", "token_hash", "family_id", "expires_at", "revoked_at", "created_at"}
+	createdAt := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(30 * 24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    func(*testing.T, *entities.RefreshToken)
+		wantErr bool
+	}{
+		{
+			name: "token ativo",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(12, 3, "hash", "familia-1", expiresAt, nil, createdAt)
+				mock.ExpectQuery(query).WithArgs("hash", testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, token *entities.RefreshToken) {
+				if token.ID() != 12 || token.UserID() != 3 || token.FamilyID() != "familia-1" {
+					t.Errorf("token com valores incorretos: id=%d usuário=%d família=%s", token.ID(), token.UserID(), token.FamilyID())
+				}
+				if token.IsRevoked() {
+					t.Error("token não deveria estar revogado")
+				}
+			},
+		},
+		{
+			name: "token revogado",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(12, 3, "hash", "familia-1", expiresAt, createdAt.Add(time.Hour), createdAt)
+				mock.ExpectQuery(query).WithArgs("hash", testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, token *entities.RefreshToken) {
+				if !token.IsRevoked() {
+					t.Error("token deveria estar revogado")
+				}
+			},
+		},
+		{
+			name: "token inexistente",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs("hash", testTenantID).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			want: func(t *testing.T, token *entities.RefreshToken) {
+				if token != nil {
+					t.Errorf("esperado nil, obtido %+v", token)
+				}
+			},
+		},
+		{
+			name: "erro no banco de dados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs("hash", testTenantID).WillReturnError(errors.New("database connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewRefreshTokenMySQLRepository(db)
+			token, err := repo.FindByHash(testCtx, "hash")
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByHash() erro = %v, esperado erro = %v", err, tt.wantErr)
+			}
+			if tt.want != nil {
+				tt.want(t, token)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestRefreshTokenMySQLRepository_Revoke(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		want         bool
+	}{
+		{name: "token ativo é revogado", rowsAffected: 1, want: true},
+		{name: "token já revogado", rowsAffected: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectExec("UPDATE refresh_tokens SET revoked_at = \\? WHERE id = \\? AND revoked_at IS NULL AND tenant_id = \\?").
+				WithArgs(sqlmock.AnyArg(), 12, testTenantID).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			repo := NewRefreshTokenMySQLRepository(db)
+			got, err := repo.Revoke(testCtx, 12)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Revoke() = %v, esperado %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestRefreshTokenMySQLRepository_RevokeFamily(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE refresh_tokens SET revoked_at = \\? WHERE family_id = \\? AND revoked_at IS NULL AND tenant_id = \\?").
+		WithArgs(sqlmock.AnyArg(), "familia-1", testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	repo := NewRefreshTokenMySQLRepository(db)
+	if err := repo.RevokeFamily(testCtx, "familia-1"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestRefreshTokenMySQLRepository_RevokeAllForUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE refresh_tokens SET revoked_at = \\? WHERE user_id = \\? AND revoked_at IS NULL AND tenant_id = \\?").
+		WithArgs(sqlmock.AnyArg(), 3, testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := NewRefreshTokenMySQLRepository(db)
+	if err := repo.RevokeAllForUser(testCtx, 3); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
@@ -0,0 +1,219 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/infra/database"
+)
+
+type ResourceMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewResourceMySQLRepository(db *sql.DB) *ResourceMySQLRepository {
+	return &ResourceMySQLRepository{db: db}
+}
+
+func (r *ResourceMySQLRepository) FindByID(ctx context.Context, id int) (*entities.Resource, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, name, capacity, created_at FROM resources WHERE id = ? AND tenant_id = ?"
+	row := r.db.QueryRowContext(ctx, query, id, tenantID)
+
+	var resourceID, capacity int
+	var name string
+	var createdAt time.Time
+
+	err = row.Scan(&resourceID, &name, &capacity, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repositories.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := entities.NewResource(resourceID, name, capacity)
+	if err != nil {
+		return nil, err
+	}
+	resource.SetCreatedAt(createdAt)
+
+	return resource, nil
+}
+
+func (r *ResourceMySQLRepository) FindAll(ctx context.Context) ([]*entities.Resource, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, name, capacity, created_at FROM resources WHERE tenant_id = ? ORDER BY name"
+	rows, err := r.db.QueryContext(ctx, query, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var resources []*entities.Resource
+	for rows.Next() {
+		var id, capacity int
+		var name string
+		var createdAt time.Time
+
+		err := rows.Scan(&id, &name, &capacity, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		resource, err := entities.NewResource(id, name, capacity)
+		if err != nil {
+			return nil, err
+		}
+		resource.SetCreatedAt(createdAt)
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
+
+func (r *ResourceMySQLRepository) Save(ctx context.Context, resource *entities.Resource) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO resources (name, capacity, created_at, tenant_id) VALUES (?, ?, ?, ?)"
+	result, err := r.db.ExecContext(ctx, query, resource.Name(), resource.Capacity(), resource.CreatedAt(), tenantID)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	resource.SetID(int(id))
+
+	return nil
+}
+
+func (r *ResourceMySQLRepository) Update(ctx context.Context, resource *entities.Resource) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE resources SET name = ?, capacity = ? WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query, resource.Name(), resource.Capacity(), resource.ID(), tenantID)
+	return err
+}
+
+func (r *ResourceMySQLRepository) Delete(ctx context.Context, id int) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "DELETE FROM resources WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query, id, tenantID)
+	return err
+}
+
+func (r *ResourceMySQLRepository) FindRequirementsByServiceID(ctx context.Context, serviceID int) ([]*entities.ResourceRequirement, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT service_id, resource_id, quantity FROM service_resources WHERE service_id = ? AND tenant_id = ?"
+	rows, err := r.db.QueryContext(ctx, query, serviceID, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requirements []*entities.ResourceRequirement
+	for rows.Next() {
+		var resourceID, quantity int
+
+		err := rows.Scan(&serviceID, &resourceID, &quantity)
+		if err != nil {
+			return nil, err
+		}
+
+		requirement, err := entities.NewResourceRequirement(serviceID, resourceID, quantity)
+		if err != nil {
+			return nil, err
+		}
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements, nil
+}
+
+func (r *ResourceMySQLRepository) SaveRequirement(ctx context.Context, requirement *entities.ResourceRequirement) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO service_resources (service_id, resource_id, quantity, tenant_id) VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE quantity = VALUES(quantity)
+	`
+	_, err = r.db.ExecContext(ctx, query, requirement.ServiceID(), requirement.ResourceID(), requirement.Quantity(), tenantID)
+	return err
+}
+
+func (r *ResourceMySQLRepository) FindAllocationsByDate(ctx context.Context, resourceID int, date time.Time) ([]*entities.ResourceAllocation, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dayStart := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	return findAllocations(ctx, r.db, tenantID, resourceID, dayStart, dayEnd)
+}
+
+func findAllocations(ctx context.Context, q database.SqlExecer, tenantID, resourceID int, start, end time.Time) ([]*entities.ResourceAllocation, error) {
+	query := `
+		SELECT ar.resource_id, ar.quantity, ar.starts_at, ar.ends_at FROM appointment_resources ar
+		INNER JOIN appointments a ON a.id = ar.appointment_id
+		WHERE ar.resource_id = ? AND a.status = 'scheduled' AND ar.starts_at < ? AND ar.ends_at > ? AND ar.tenant_id = ?
+	`
+	rows, err := q.QueryContext(ctx, query, resourceID, end, start, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var allocations []*entities.ResourceAllocation
+	for rows.Next() {
+		var id, quantity int
+		var startsAt, endsAt time.Time
+
+		err := rows.Scan(&id, &quantity, &startsAt, &endsAt)
+		if err != nil {
+			return nil, err
+		}
+
+		allocation, err := entities.NewResourceAllocation(id, quantity, startsAt, endsAt)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation)
+	}
+
+	return allocations, nil
+}
@@ -0,0 +1,215 @@
+package persistence
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestResourceMySQLRepository_FindByID(t *testing.T) {
+	createdTime := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	query := "SELECT id, name, capacity, created_at FROM resources WHERE id = \\?"
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    func(*testing.T, *entities.Resource)
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "recurso encontrado com sucesso",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "capacity", "created_at"}).
+					AddRow(1, "Sala 1", 2, createdTime)
+				mock.ExpectQuery(query).WithArgs(1, testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, r *entities.Resource) {
+				if r.ID() != 1 || r.Name() != "Sala 1" || r.Capacity() != 2 {
+					t.Errorf("recurso com valores incorretos: id=%d nome=%s capacidade=%d", r.ID(), r.Name(), r.Capacity())
+				}
+				if !r.CreatedAt().Equal(createdTime) {
+					t.Errorf("CreatedAt esperado %v, obtido %v", createdTime, r.CreatedAt())
+				}
+			},
+		},
+		{
+			name: "recurso não encontrado",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(1, testTenantID).WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: true,
+			errMsg:  "recurso não encontrado",
+		},
+		{
+			name: "capacidade inválida no banco",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"id", "name", "capacity", "created_at"}).
+					AddRow(1, "Sala 1", 0, createdTime)
+				mock.ExpectQuery(query).WithArgs(1, testTenantID).WillReturnRows(rows)
+			},
+			wantErr: true,
+			errMsg:  "a capacidade deve ser maior que zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewResourceMySQLRepository(db)
+			got, err := repo.FindByID(testCtx, 1)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				if err.Error() != tt.errMsg {
+					t.Errorf("erro esperado '%s', obtido '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if tt.want != nil {
+				tt.want(t, got)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestResourceMySQLRepository_Save(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("INSERT INTO resources \\(name, capacity, created_at, tenant_id\\) VALUES \\(\\?, \\?, \\?, \\?\\)").
+		WithArgs("Laser", 1, sqlmock.AnyArg(), testTenantID).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+
+	resource, _ := entities.NewResource(0, "Laser", 1)
+	repo := NewResourceMySQLRepository(db)
+	if err := repo.Save(testCtx, resource); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if resource.ID() != 4 {
+		t.Errorf("ID esperado 4, obtido %d", resource.ID())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestResourceMySQLRepository_FindRequirementsByServiceID(t *testing.T) {
+	query := "SELECT service_id, resource_id, quantity FROM service_resources WHERE service_id = \\?"
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    int
+		wantErr bool
+	}{
+		{
+			name: "requisitos encontrados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows([]string{"service_id", "resource_id", "quantity"}).
+					AddRow(3, 7, 1).
+					AddRow(3, 8, 2)
+				mock.ExpectQuery(query).WithArgs(3, testTenantID).WillReturnRows(rows)
+			},
+			want: 2,
+		},
+		{
+			name: "erro no banco de dados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(3, testTenantID).WillReturnError(errors.New("database connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewResourceMySQLRepository(db)
+			got, err := repo.FindRequirementsByServiceID(testCtx, 3)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("esperado %d requisitos, obtido %d", tt.want, len(got))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestResourceMySQLRepository_FindAllocationsByDate(t *testing.T) {
+	date := time.Date(2025, 12, 25, 15, 0, 0, 0, time.UTC)
+	dayStart := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+	dayEnd := time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"resource_id", "quantity", "starts_at", "ends_at"}).
+		AddRow(7, 1, date, date.Add(time.Hour))
+	mock.ExpectQuery("SELECT ar.resource_id, ar.quantity, ar.starts_at, ar.ends_at FROM appointment_resources ar").
+		WithArgs(7, dayEnd, dayStart, testTenantID).
+		WillReturnRows(rows)
+
+	repo := NewResourceMySQLRepository(db)
+	got, err := repo.FindAllocationsByDate(testCtx, 7, date)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(got) != 1 || got[0].Quantity() != 1 {
+		t.Errorf("alocações incorretas: %+v", got)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
@@ -1,9 +1,13 @@
 package persistence
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 
 	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/tenant"
 )
 
 type ServiceMySQLRepository struct {
@@ -14,15 +18,23 @@ func NewServiceMySQLRepository(db *sql.DB) *ServiceMySQLRepository {
 	return &ServiceMySQLRepository{db: db}
 }
 
-func (r *ServiceMySQLRepository) FindByID(id int) (*entities.Service, error) {
-	query := "SELECT id, staff_id, name, duration, price FROM services WHERE id = ?"
-	row := r.db.QueryRow(query, id)
+func (r *ServiceMySQLRepository) FindByID(ctx context.Context, id int) (*entities.Service, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, staff_id, name, duration, price FROM services WHERE id = ? AND tenant_id = ?"
+	row := r.db.QueryRowContext(ctx, query, id, tenantID)
 
 	var serviceID, staffID, duration int
 	var name string
 	var price float64
 
-	err := row.Scan(&serviceID, &staffID, &name, &duration, &price)
+	err = row.Scan(&serviceID, &staffID, &name, &duration, &price)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repositories.ErrServiceNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -34,9 +46,14 @@ func (r *ServiceMySQLRepository) FindByID(id int) (*entities.Service, error) {
 	return service, nil
 }
 
-func (r *ServiceMySQLRepository) FindAllByStaffID(staffID int) ([]*entities.Service, error) {
-	query := "SELECT id, staff_id, name, duration, price FROM services WHERE staff_id = ?"
-	rows, err := r.db.Query(query, staffID)
+func (r *ServiceMySQLRepository) FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.Service, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, staff_id, name, duration, price FROM services WHERE staff_id = ? AND tenant_id = ?"
+	rows, err := r.db.QueryContext(ctx, query, staffID, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -63,10 +80,15 @@ func (r *ServiceMySQLRepository) FindAllByStaffID(staffID int) ([]*entities.Serv
 	return services, nil
 }
 
-func (r *ServiceMySQLRepository) Exists(id int) (bool, error) {
-	query := "SELECT COUNT(*) FROM services WHERE id = ?"
+func (r *ServiceMySQLRepository) Exists(ctx context.Context, id int) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := "SELECT COUNT(*) FROM services WHERE id = ? AND tenant_id = ?"
 	var count int
-	err := r.db.QueryRow(query, id).Scan(&count)
+	err = r.db.QueryRowContext(ctx, query, id, tenantID).Scan(&count)
 	if err != nil {
 		return false, err
 	}
@@ -26,7 +26,7 @@ func TestServiceMySQLRepository_FindByID(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"id", "staff_id", "name", "duration", "price"}).
 					AddRow(1, 101, "Corte de Cabelo", 30, 50.0)
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE id = ?").
-					WithArgs(1).
+					WithArgs(1, testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, service *entities.Service) {
@@ -53,7 +53,7 @@ func TestServiceMySQLRepository_FindByID(t *testing.T) {
 			serviceID: 2,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE id = ?").
-					WithArgs(2).
+					WithArgs(2, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			wantErr: true,
@@ -64,11 +64,11 @@ func TestServiceMySQLRepository_FindByID(t *testing.T) {
 			serviceID: 999,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE id = ?").
-					WithArgs(999).
+					WithArgs(999, testTenantID).
 					WillReturnError(sql.ErrNoRows)
 			},
 			wantErr: true,
-			errMsg:  sql.ErrNoRows.Error(),
+			errMsg:  "serviço não encontrado",
 		},
 		{
 			name:      "erro na criação da entidade - nome vazio",
@@ -77,7 +77,7 @@ func TestServiceMySQLRepository_FindByID(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"id", "staff_id", "name", "duration", "price"}).
 					AddRow(3, 102, "", 45, 75.0)
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE id = ?").
-					WithArgs(3).
+					WithArgs(3, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -90,7 +90,7 @@ func TestServiceMySQLRepository_FindByID(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"id", "staff_id", "name", "duration", "price"}).
 					AddRow(4, 103, "Massagem", 0, 100.0)
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE id = ?").
-					WithArgs(4).
+					WithArgs(4, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -103,7 +103,7 @@ func TestServiceMySQLRepository_FindByID(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"id", "staff_id", "name", "duration", "price"}).
 					AddRow(5, 104, "Manicure", 30, -20.0)
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE id = ?").
-					WithArgs(5).
+					WithArgs(5, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -122,7 +122,7 @@ func TestServiceMySQLRepository_FindByID(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewServiceMySQLRepository(db)
-			got, err := repo.FindByID(tt.serviceID)
+			got, err := repo.FindByID(testCtx, tt.serviceID)
 
 			if tt.wantErr {
 				if err == nil {
@@ -166,7 +166,7 @@ func TestServiceMySQLRepository_FindAllByStaffID(t *testing.T) {
 					AddRow(1, 101, "Corte de Cabelo", 30, 50.0).
 					AddRow(2, 101, "Barba", 15, 25.0)
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE staff_id = ?").
-					WithArgs(101).
+					WithArgs(101, testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, services []*entities.Service) {
@@ -193,7 +193,7 @@ func TestServiceMySQLRepository_FindAllByStaffID(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"id", "staff_id", "name", "duration", "price"})
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE staff_id = ?").
-					WithArgs(999).
+					WithArgs(999, testTenantID).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, services []*entities.Service) {
@@ -208,7 +208,7 @@ func TestServiceMySQLRepository_FindAllByStaffID(t *testing.T) {
 			staffID: 102,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE staff_id = ?").
-					WithArgs(102).
+					WithArgs(102, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			wantErr: true,
@@ -221,7 +221,7 @@ func TestServiceMySQLRepository_FindAllByStaffID(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"id", "staff_id", "name", "duration", "price"}).
 					AddRow("invalid_id", 103, "Massagem", 60, 120.0)
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE staff_id = ?").
-					WithArgs(103).
+					WithArgs(103, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -233,7 +233,7 @@ func TestServiceMySQLRepository_FindAllByStaffID(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"id", "staff_id", "name", "duration", "price"}).
 					AddRow(3, 104, "", 45, 75.0)
 				mock.ExpectQuery("SELECT id, staff_id, name, duration, price FROM services WHERE staff_id = ?").
-					WithArgs(104).
+					WithArgs(104, testTenantID).
 					WillReturnRows(rows)
 			},
 			wantErr: true,
@@ -252,7 +252,7 @@ func TestServiceMySQLRepository_FindAllByStaffID(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewServiceMySQLRepository(db)
-			got, err := repo.FindAllByStaffID(tt.staffID)
+			got, err := repo.FindAllByStaffID(testCtx, tt.staffID)
 
 			if tt.wantErr {
 				if err == nil {
@@ -294,7 +294,7 @@ func TestServiceMySQLRepository_Exists(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM services WHERE id = ?").
-					WithArgs(1).
+					WithArgs(1, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -306,7 +306,7 @@ func TestServiceMySQLRepository_Exists(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(0)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM services WHERE id = ?").
-					WithArgs(999).
+					WithArgs(999, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    false,
@@ -318,7 +318,7 @@ func TestServiceMySQLRepository_Exists(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM services WHERE id = ?").
-					WithArgs(2).
+					WithArgs(2, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -329,7 +329,7 @@ func TestServiceMySQLRepository_Exists(t *testing.T) {
 			serviceID: 3,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM services WHERE id = ?").
-					WithArgs(3).
+					WithArgs(3, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			want:    false,
@@ -342,7 +342,7 @@ func TestServiceMySQLRepository_Exists(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow("invalid_number")
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM services WHERE id = ?").
-					WithArgs(4).
+					WithArgs(4, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    false,
@@ -361,7 +361,7 @@ func TestServiceMySQLRepository_Exists(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewServiceMySQLRepository(db)
-			got, err := repo.Exists(tt.serviceID)
+			got, err := repo.Exists(testCtx, tt.serviceID)
 
 			if tt.wantErr {
 				if err == nil {
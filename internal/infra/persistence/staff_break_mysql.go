@@ -0,0 +1,147 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+type StaffBreakMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewStaffBreakMySQLRepository(db *sql.DB) *StaffBreakMySQLRepository {
+	return &StaffBreakMySQLRepository{db: db}
+}
+
+func (r *StaffBreakMySQLRepository) FindByID(ctx context.Context, id int) (*entities.StaffBreak, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, staff_id, weekday, start_time, end_time, duration_minutes FROM staff_breaks WHERE id = ? AND tenant_id = ?"
+	row := r.db.QueryRowContext(ctx, query, id, tenantID)
+
+	var breakID, staffID, duration int
+	var weekday string
+	var startTime, endTime time.Time
+
+	err = row.Scan(&breakID, &staffID, &weekday, &startTime, &endTime, &duration)
+	if err != nil {
+		return nil, err
+	}
+
+	staffBreak, err := entities.NewStaffBreak(staffID, entities.Weekday(weekday), startTime, endTime, duration)
+	if err != nil {
+		return nil, err
+	}
+	staffBreak.SetID(breakID)
+
+	return staffBreak, nil
+}
+
+func (r *StaffBreakMySQLRepository) FindAllByStaffID(ctx context.Context, staffID int) ([]*entities.StaffBreak, error) {
+	query := "SELECT id, staff_id, weekday, start_time, end_time, duration_minutes FROM staff_breaks WHERE staff_id = ? AND tenant_id = ?"
+	return r.findAll(ctx, query, staffID)
+}
+
+func (r *StaffBreakMySQLRepository) FindByWeekday(ctx context.Context, staffID int, weekday entities.Weekday) ([]*entities.StaffBreak, error) {
+	query := "SELECT id, staff_id, weekday, start_time, end_time, duration_minutes FROM staff_breaks WHERE staff_id = ? AND weekday = ? AND tenant_id = ? ORDER BY start_time"
+	return r.findAll(ctx, query, staffID, string(weekday))
+}
+
+// findAll acrescenta o tenant do contexto como último argumento da consulta.
+func (r *StaffBreakMySQLRepository) findAll(ctx context.Context, query string, args ...any) ([]*entities.StaffBreak, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, tenantID)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var breaks []*entities.StaffBreak
+	for rows.Next() {
+		var breakID, staffID, duration int
+		var weekday string
+		var startTime, endTime time.Time
+
+		err := rows.Scan(&breakID, &staffID, &weekday, &startTime, &endTime, &duration)
+		if err != nil {
+			return nil, err
+		}
+
+		staffBreak, err := entities.NewStaffBreak(staffID, entities.Weekday(weekday), startTime, endTime, duration)
+		if err != nil {
+			return nil, err
+		}
+		staffBreak.SetID(breakID)
+		breaks = append(breaks, staffBreak)
+	}
+
+	return breaks, nil
+}
+
+func (r *StaffBreakMySQLRepository) Save(ctx context.Context, staffBreak *entities.StaffBreak) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "INSERT INTO staff_breaks (staff_id, weekday, start_time, end_time, duration_minutes, tenant_id) VALUES (?, ?, ?, ?, ?, ?)"
+	result, err := r.db.ExecContext(ctx, query,
+		staffBreak.StaffID(),
+		string(staffBreak.Weekday()),
+		staffBreak.StartTime(),
+		staffBreak.EndTime(),
+		staffBreak.DurationMinutes(),
+		tenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	staffBreak.SetID(int(id))
+
+	return nil
+}
+
+func (r *StaffBreakMySQLRepository) Update(ctx context.Context, staffBreak *entities.StaffBreak) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE staff_breaks SET weekday = ?, start_time = ?, end_time = ?, duration_minutes = ? WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query,
+		string(staffBreak.Weekday()),
+		staffBreak.StartTime(),
+		staffBreak.EndTime(),
+		staffBreak.DurationMinutes(),
+		staffBreak.ID(),
+		tenantID,
+	)
+	return err
+}
+
+func (r *StaffBreakMySQLRepository) Delete(ctx context.Context, id int) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "DELETE FROM staff_breaks WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query, id, tenantID)
+	return err
+}
@@ -0,0 +1,285 @@
+package persistence
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestNewStaffBreakMySQLRepository(t *testing.T) {
+	repo := NewStaffBreakMySQLRepository(&sql.DB{})
+	if repo == nil {
+		t.Fatal("repositório não deve ser nil")
+	}
+	if repo.db == nil {
+		t.Error("db do repositório não deve ser nil")
+	}
+}
+
+func TestStaffBreakMySQLRepository_FindByID(t *testing.T) {
+	startTime := time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC)
+	endTime := time.Date(2025, 12, 25, 14, 0, 0, 0, time.UTC)
+	columns := []string{"id", "staff_id", "weekday", "start_time", "end_time", "duration_minutes"}
+	query := "SELECT id, staff_id, weekday, start_time, end_time, duration_minutes FROM staff_breaks WHERE id = \\?"
+
+	tests := []struct {
+		name    string
+		breakID int
+		mockFn  func(sqlmock.Sqlmock)
+		want    func(*testing.T, *entities.StaffBreak)
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "pausa flutuante encontrada com sucesso",
+			breakID: 1,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(1, 2, "monday", startTime, endTime, 30)
+				mock.ExpectQuery(query).WithArgs(1, testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, b *entities.StaffBreak) {
+				if b.ID() != 1 {
+					t.Errorf("ID esperado 1, obtido %d", b.ID())
+				}
+				if b.StaffID() != 2 {
+					t.Errorf("StaffID esperado 2, obtido %d", b.StaffID())
+				}
+				if b.DurationMinutes() != 30 {
+					t.Errorf("DurationMinutes esperado 30, obtido %d", b.DurationMinutes())
+				}
+				if !b.IsFloating() {
+					t.Error("pausa deveria ser flutuante")
+				}
+			},
+		},
+		{
+			name:    "pausa não encontrada",
+			breakID: 999,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(999, testTenantID).WillReturnError(sql.ErrNoRows)
+			},
+			wantErr: true,
+			errMsg:  sql.ErrNoRows.Error(),
+		},
+		{
+			name:    "erro ao criar entidade - weekday inválido",
+			breakID: 3,
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(3, 2, "invalid_day", startTime, endTime, 0)
+				mock.ExpectQuery(query).WithArgs(3, testTenantID).WillReturnRows(rows)
+			},
+			wantErr: true,
+			errMsg:  "dia da semana inválido: invalid_day",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewStaffBreakMySQLRepository(db)
+			got, err := repo.FindByID(testCtx, tt.breakID)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				if err.Error() != tt.errMsg {
+					t.Errorf("erro esperado '%s', obtido '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if tt.want != nil {
+				tt.want(t, got)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestStaffBreakMySQLRepository_FindByWeekday(t *testing.T) {
+	startTime := time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC)
+	endTime := time.Date(2025, 12, 25, 13, 0, 0, 0, time.UTC)
+	columns := []string{"id", "staff_id", "weekday", "start_time", "end_time", "duration_minutes"}
+	query := "SELECT id, staff_id, weekday, start_time, end_time, duration_minutes FROM staff_breaks WHERE staff_id = \\? AND weekday = \\? AND tenant_id = \\? ORDER BY start_time"
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    int
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "pausas encontradas",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).
+					AddRow(1, 2, "monday", startTime, endTime, 0).
+					AddRow(2, 2, "monday", startTime.Add(3*time.Hour), endTime.Add(3*time.Hour), 15)
+				mock.ExpectQuery(query).WithArgs(2, "monday", testTenantID).WillReturnRows(rows)
+			},
+			want: 2,
+		},
+		{
+			name: "erro no banco de dados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(2, "monday", testTenantID).WillReturnError(errors.New("database connection error"))
+			},
+			wantErr: true,
+			errMsg:  "database connection error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewStaffBreakMySQLRepository(db)
+			got, err := repo.FindByWeekday(testCtx, 2, entities.Monday)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				if err.Error() != tt.errMsg {
+					t.Errorf("erro esperado '%s', obtido '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if len(got) != tt.want {
+				t.Errorf("esperado %d pausas, obtido %d", tt.want, len(got))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestStaffBreakMySQLRepository_Save(t *testing.T) {
+	startTime := time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC)
+	endTime := time.Date(2025, 12, 25, 14, 0, 0, 0, time.UTC)
+	query := "INSERT INTO staff_breaks \\(staff_id, weekday, start_time, end_time, duration_minutes, tenant_id\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?\\)"
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		wantID  int
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name: "pausa salva com sucesso",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).
+					WithArgs(2, "monday", startTime, endTime, 30, testTenantID).
+					WillReturnResult(sqlmock.NewResult(10, 1))
+			},
+			wantID: 10,
+		},
+		{
+			name: "erro no banco de dados durante inserção",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(query).
+					WithArgs(2, "monday", startTime, endTime, 30, testTenantID).
+					WillReturnError(errors.New("database insert error"))
+			},
+			wantErr: true,
+			errMsg:  "database insert error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			staffBreak, err := entities.NewStaffBreak(2, entities.Monday, startTime, endTime, 30)
+			if err != nil {
+				t.Fatalf("erro ao criar pausa: %v", err)
+			}
+
+			repo := NewStaffBreakMySQLRepository(db)
+			err = repo.Save(testCtx, staffBreak)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("erro esperado, mas não obtive nenhum")
+				}
+				if err.Error() != tt.errMsg {
+					t.Errorf("erro esperado '%s', obtido '%s'", tt.errMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+
+			if staffBreak.ID() != tt.wantID {
+				t.Errorf("ID esperado %d, obtido %d", tt.wantID, staffBreak.ID())
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestStaffBreakMySQLRepository_Delete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("DELETE FROM staff_breaks WHERE id = \\?").
+		WithArgs(1, testTenantID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	repo := NewStaffBreakMySQLRepository(db)
+	if err := repo.Delete(testCtx, 1); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+const testTenantID = 1
+
+var testCtx = tenant.WithID(context.Background(), testTenantID)
+
+func TestTenantIsolation_ReadsAreScopedByTenant(t *testing.T) {
+	otherTenant := tenant.WithID(context.Background(), 2)
+
+	tests := []struct {
+		name   string
+		mockFn func(sqlmock.Sqlmock)
+		call   func(*sql.DB) (bool, error)
+	}{
+		{
+			name: "serviço de outro tenant não é encontrado",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM services WHERE id = \\? AND tenant_id = \\?").
+					WithArgs(5, 2).
+					WillReturnError(sql.ErrNoRows)
+			},
+			call: func(db *sql.DB) (bool, error) {
+				service, err := NewServiceMySQLRepository(db).FindByID(otherTenant, 5)
+				return service != nil, err
+			},
+		},
+		{
+			name: "agendamento de outro tenant não é encontrado",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM appointments WHERE id = \\? AND tenant_id = \\?").
+					WithArgs(5, 2).
+					WillReturnError(sql.ErrNoRows)
+			},
+			call: func(db *sql.DB) (bool, error) {
+				appointment, err := NewAppointmentMySQLRepository(db).FindByID(otherTenant, 5)
+				return appointment != nil, err
+			},
+		},
+		{
+			name: "horário de outro tenant não é encontrado",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM available_slots WHERE id = \\? AND tenant_id = \\?").
+					WithArgs(5, 2).
+					WillReturnError(sql.ErrNoRows)
+			},
+			call: func(db *sql.DB) (bool, error) {
+				slot, err := NewAvailableSlotMySQLRepository(db).FindByID(otherTenant, 5)
+				return slot != nil, err
+			},
+		},
+		{
+			name: "usuário de outro tenant não é encontrado",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("FROM users\\s+WHERE id = \\? AND tenant_id = \\?").
+					WithArgs(5, 2).
+					WillReturnError(sql.ErrNoRows)
+			},
+			call: func(db *sql.DB) (bool, error) {
+				user, err := NewUserMySQLRepository(db).FindByID(otherTenant, 5)
+				return user != nil, err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			found, _ := tt.call(db)
+			if found {
+				t.Error("registro de outro tenant não deveria ser retornado")
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestTenantIsolation_WritesCarryTenant(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	ctx := tenant.WithID(context.Background(), 3)
+	start := time.Date(0, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec("INSERT INTO available_slots").
+		WithArgs(1, "monday", sqlmock.AnyArg(), sqlmock.AnyArg(), nil, 3).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	slot, err := entities.NewAvailableSlot(1, entities.Monday, start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("erro ao criar horário: %v", err)
+	}
+
+	if err := NewAvailableSlotMySQLRepository(db).Save(ctx, slot); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestTenantIsolation_MissingTenant(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(*sql.DB) error
+	}{
+		{
+			name: "usuário",
+			call: func(db *sql.DB) error {
+				_, err := NewUserMySQLRepository(db).List(context.Background(), 10, 0)
+				return err
+			},
+		},
+		{
+			name: "serviço",
+			call: func(db *sql.DB) error {
+				_, err := NewServiceMySQLRepository(db).FindAllByStaffID(context.Background(), 1)
+				return err
+			},
+		},
+		{
+			name: "agendamento",
+			call: func(db *sql.DB) error {
+				return NewAppointmentMySQLRepository(db).Delete(context.Background(), 1)
+			},
+		},
+		{
+			name: "horário disponível",
+			call: func(db *sql.DB) error {
+				_, err := NewAvailableSlotMySQLRepository(db).FindAllByStaffID(context.Background(), 1)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			err = tt.call(db)
+			if !errors.Is(err, tenant.ErrMissingTenant) {
+				t.Errorf("erro esperado '%v', obtido '%v'", tenant.ErrMissingTenant, err)
+			}
+
+			// nenhuma consulta pode chegar ao banco sem tenant
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"scheduling/internal/domain/entities"
+)
+
+type TenantMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewTenantMySQLRepository(db *sql.DB) *TenantMySQLRepository {
+	return &TenantMySQLRepository{db: db}
+}
+
+func (r *TenantMySQLRepository) FindByID(ctx context.Context, id int) (*entities.Tenant, error) {
+	query := "SELECT id, name, slug, created_at FROM tenants WHERE id = ?"
+	return r.find(ctx, query, id)
+}
+
+func (r *TenantMySQLRepository) FindBySlug(ctx context.Context, slug string) (*entities.Tenant, error) {
+	query := "SELECT id, name, slug, created_at FROM tenants WHERE slug = ?"
+	return r.find(ctx, query, slug)
+}
+
+func (r *TenantMySQLRepository) find(ctx context.Context, query string, arg any) (*entities.Tenant, error) {
+	var id int
+	var name, slug string
+	var createdAt time.Time
+
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(&id, &name, &slug, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := entities.NewTenant(id, name, slug)
+	if err != nil {
+		return nil, err
+	}
+	tenant.SetCreatedAt(createdAt)
+
+	return tenant, nil
+}
+
+func (r *TenantMySQLRepository) Save(ctx context.Context, tenant *entities.Tenant) error {
+	query := "INSERT INTO tenants (name, slug, created_at) VALUES (?, ?, ?)"
+	result, err := r.db.ExecContext(ctx, query, tenant.Name(), tenant.Slug(), tenant.CreatedAt())
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	tenant.SetID(int(id))
+
+	return nil
+}
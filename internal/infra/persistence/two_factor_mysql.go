@@ -0,0 +1,162 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+	"scheduling/internal/infra/database"
+)
+
+type TwoFactorMySQLRepository struct {
+	db *sql.DB
+	tm database.TransactionManager
+}
+
+func NewTwoFactorMySQLRepository(db *sql.DB) *TwoFactorMySQLRepository {
+	return &TwoFactorMySQLRepository{db: db, tm: database.NewTransactionManager(db)}
+}
+
+func (r *TwoFactorMySQLRepository) FindByUserID(ctx context.Context, userID int) (*entities.TOTPCredential, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT user_id, secret, enabled_at, last_used_step, created_at
+		FROM user_totp
+		WHERE user_id = ? AND tenant_id = ?
+	`
+
+	var id int
+	var secret string
+	var enabledAt sql.NullTime
+	var lastUsedStep int64
+	var createdAt time.Time
+
+	err = r.db.QueryRowContext(ctx, query, userID, tenantID).Scan(&id, &secret, &enabledAt, &lastUsedStep, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var enabled *time.Time
+	if enabledAt.Valid {
+		enabled = &enabledAt.Time
+	}
+
+	return entities.RebuildTOTPCredential(id, secret, enabled, lastUsedStep, createdAt), nil
+}
+
+// Save grava a credencial do usuário, substituindo um cadastro anterior que
+// não chegou a ser confirmado. A última janela usada só é alterada por
+// MarkStepUsed, para que uma gravação não permita reusar um código.
+func (r *TwoFactorMySQLRepository) Save(ctx context.Context, credential *entities.TOTPCredential) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO user_totp (user_id, secret, enabled_at, last_used_step, created_at, tenant_id)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE secret = VALUES(secret), enabled_at = VALUES(enabled_at)
+	`
+	_, err = r.db.ExecContext(ctx, query,
+		credential.UserID(),
+		credential.Secret(),
+		credential.EnabledAt(),
+		credential.LastUsedStep(),
+		credential.CreatedAt(),
+		tenantID,
+	)
+	return err
+}
+
+func (r *TwoFactorMySQLRepository) Delete(ctx context.Context, userID int) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.tm.WithTransaction(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = ? AND tenant_id = ?", userID, tenantID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "DELETE FROM user_totp WHERE user_id = ? AND tenant_id = ?", userID, tenantID)
+		return err
+	})
+}
+
+// MarkStepUsed só avança a janela, então um código já aceito (ou de uma
+// janela anterior a ele) não passa uma segunda vez.
+func (r *TwoFactorMySQLRepository) MarkStepUsed(ctx context.Context, userID int, step int64) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := `UPDATE user_totp SET last_used_step = ? WHERE user_id = ? AND last_used_step < ? AND tenant_id = ?`
+	result, err := r.db.ExecContext(ctx, query, step, userID, step, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *TwoFactorMySQLRepository) ReplaceRecoveryCodes(ctx context.Context, userID int, codeHashes []string) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.tm.WithTransaction(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM recovery_codes WHERE user_id = ? AND tenant_id = ?", userID, tenantID); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, hash := range codeHashes {
+			_, err := tx.ExecContext(ctx,
+				"INSERT INTO recovery_codes (user_id, code_hash, created_at, tenant_id) VALUES (?, ?, ?, ?)",
+				userID, hash, now, tenantID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *TwoFactorMySQLRepository) UseRecoveryCode(ctx context.Context, userID int, codeHash string) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := `UPDATE recovery_codes SET used_at = ? WHERE user_id = ? AND code_hash = ? AND used_at IS NULL AND tenant_id = ?`
+	result, err := r.db.ExecContext(ctx, query, time.Now(), userID, codeHash, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
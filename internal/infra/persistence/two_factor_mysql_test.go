@@ -0,0 +1,210 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestTwoFactorMySQLRepository_FindByUserID(t *testing.T) {
+	query := "SELECT user_id, secret, enabled_at, last_used_step, created_at\\s+FROM user_totp\\s+WHERE user_id = \\? AND tenant_id = \\?"
+	columns := []string{"user_id", "secret", "enabled_at", "last_used_step", "created_at"}
+	createdAt := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    func(*testing.T, *entities.TOTPCredential)
+		wantErr bool
+	}{
+		{
+			name: "credencial confirmada",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(3, "SEGREDO", createdAt.Add(time.Minute), 120, createdAt)
+				mock.ExpectQuery(query).WithArgs(3, testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, credential *entities.TOTPCredential) {
+				if credential.UserID() != 3 || credential.Secret() != "SEGREDO" || credential.LastUsedStep() != 120 {
+					t.Errorf("credencial com valores incorretos: %+v", credential)
+				}
+				if !credential.IsEnabled() {
+					t.Error("credencial deveria estar ativa")
+				}
+			},
+		},
+		{
+			name: "cadastro pendente",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(3, "SEGREDO", nil, 0, createdAt)
+				mock.ExpectQuery(query).WithArgs(3, testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, credential *entities.TOTPCredential) {
+				if credential.IsEnabled() {
+					t.Error("credencial não deveria estar ativa")
+				}
+			},
+		},
+		{
+			name: "sem credencial",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(3, testTenantID).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			want: func(t *testing.T, credential *entities.TOTPCredential) {
+				if credential != nil {
+					t.Errorf("esperado nil, obtido %+v", credential)
+				}
+			},
+		},
+		{
+			name: "erro no banco de dados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs(3, testTenantID).WillReturnError(errors.New("database connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewTwoFactorMySQLRepository(db)
+			credential, err := repo.FindByUserID(testCtx, 3)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByUserID() erro = %v, esperado erro = %v", err, tt.wantErr)
+			}
+			if tt.want != nil {
+				tt.want(t, credential)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestTwoFactorMySQLRepository_Save(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	credential, _ := entities.NewTOTPCredential(3, "SEGREDO")
+
+	mock.ExpectExec("INSERT INTO user_totp \\(user_id, secret, enabled_at, last_used_step, created_at, tenant_id\\)").
+		WithArgs(3, "SEGREDO", nil, int64(0), sqlmock.AnyArg(), testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewTwoFactorMySQLRepository(db)
+	if err := repo.Save(testCtx, credential); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestTwoFactorMySQLRepository_MarkStepUsed(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		want         bool
+	}{
+		{name: "janela nova é aceita", rowsAffected: 1, want: true},
+		{name: "janela já usada", rowsAffected: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectExec("UPDATE user_totp SET last_used_step = \\? WHERE user_id = \\? AND last_used_step < \\? AND tenant_id = \\?").
+				WithArgs(int64(120), 3, int64(120), testTenantID).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			repo := NewTwoFactorMySQLRepository(db)
+			got, err := repo.MarkStepUsed(testCtx, 3, 120)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("MarkStepUsed() = %v, esperado %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestTwoFactorMySQLRepository_ReplaceRecoveryCodes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM recovery_codes WHERE user_id = \\? AND tenant_id = \\?").
+		WithArgs(3, testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 10))
+	for _, hash := range []string{"hash-1", "hash-2"} {
+		mock.ExpectExec("INSERT INTO recovery_codes \\(user_id, code_hash, created_at, tenant_id\\)").
+			WithArgs(3, hash, sqlmock.AnyArg(), testTenantID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+	mock.ExpectCommit()
+
+	repo := NewTwoFactorMySQLRepository(db)
+	if err := repo.ReplaceRecoveryCodes(testCtx, 3, []string{"hash-1", "hash-2"}); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestTwoFactorMySQLRepository_UseRecoveryCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE recovery_codes SET used_at = \\? WHERE user_id = \\? AND code_hash = \\? AND used_at IS NULL AND tenant_id = \\?").
+		WithArgs(sqlmock.AnyArg(), 3, "hash-1", testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := NewTwoFactorMySQLRepository(db)
+	used, err := repo.UseRecoveryCode(testCtx, 3, "hash-1")
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if used {
+		t.Error("código já usado não deveria ser aceito")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
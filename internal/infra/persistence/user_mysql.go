@@ -3,7 +3,10 @@ package persistence
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/repositories"
+	"scheduling/internal/domain/tenant"
 	"scheduling/internal/domain/valueobject"
 	"time"
 )
@@ -17,9 +20,14 @@ func NewUserMySQLRepository(db *sql.DB) *UserMySQLRepository {
 }
 
 func (r *UserMySQLRepository) Create(ctx context.Context, user *entities.User) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
 	query := `
-		INSERT INTO users (name, email, password, role, created_at)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO users (name, email, password, role, created_at, email_verified_at, locale, tenant_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	`
 	now := time.Now()
 
@@ -28,43 +36,54 @@ func (r *UserMySQLRepository) Create(ctx context.Context, user *entities.User) e
 		query,
 		user.Name(),
 		user.Email(),
-		user.Password(),
+		user.PasswordHash(),
 		user.Role(),
 		now,
+		user.EmailVerifiedAt(),
+		string(user.Locale()),
+		tenantID,
 	)
 
 	if err != nil {
 		return err
 	}
 
-	_, err = result.LastInsertId()
+	id, err := result.LastInsertId()
 	if err != nil {
 		return err
 	}
+	user.SetID(int(id))
 
 	return nil
 }
 
 func (r *UserMySQLRepository) FindByID(ctx context.Context, id int) (*entities.User, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
-		SELECT id, name, email, password, role, created_at 
+		SELECT id, name, email, password, role, created_at, email_verified_at, locale
 		FROM users 
-		WHERE id = ?
+		WHERE id = ? AND tenant_id = ?
 	`
 
-	row := r.db.QueryRowContext(ctx, query, id)
+	row := r.db.QueryRowContext(ctx, query, id, tenantID)
 
 	var userID int
-	var name, email, password, role string
-	var createdAt sql.NullTime
+	var name, email, password, role, locale string
+	var createdAt, emailVerifiedAt sql.NullTime
 
-	err := row.Scan(
+	err = row.Scan(
 		&userID, 
 		&name, 
 		&email, 
 		&password, 
 		&role, 
 		&createdAt,
+		&emailVerifiedAt,
+		&locale,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -83,15 +102,25 @@ func (r *UserMySQLRepository) FindByID(ctx context.Context, id int) (*entities.U
 	if createdAt.Valid {
 		user.SetCreatedAt(createdAt.Time)
 	}
+	if emailVerifiedAt.Valid {
+		user.MarkEmailVerified(emailVerifiedAt.Time)
+	}
+	// idiomas que deixaram de ser atendidos ficam sem preferência
+	_ = user.SetLocale(locale)
 
 	return user, nil
 }
 
 func (r *UserMySQLRepository) Update(ctx context.Context, user *entities.User) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE users 
 		SET name = ?, email = ?, role = ?
-		WHERE id = ?
+		WHERE id = ? AND tenant_id = ?
 	`
 
 	result, err := r.db.ExecContext(
@@ -101,6 +130,7 @@ func (r *UserMySQLRepository) Update(ctx context.Context, user *entities.User) e
 		user.Email(),
 		user.Role(),
 		user.ID(),
+		tenantID,
 	)
 	if err != nil {
 		return err
@@ -119,14 +149,19 @@ func (r *UserMySQLRepository) Update(ctx context.Context, user *entities.User) e
 }
 
 func (r *UserMySQLRepository) Delete(ctx context.Context, id int) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE users 
 		SET deleted_at = ?, updated_at = ?
-		WHERE id = ? AND deleted_at IS NULL
+		WHERE id = ? AND deleted_at IS NULL AND tenant_id = ?
 	`
 	
 	now := time.Now()
-	result, err := r.db.ExecContext(ctx, query, now, now, id)
+	result, err := r.db.ExecContext(ctx, query, now, now, id, tenantID)
 	if err != nil {
 		return err
 	}
@@ -144,15 +179,20 @@ func (r *UserMySQLRepository) Delete(ctx context.Context, id int) error {
 }
 
 func (r *UserMySQLRepository) List(ctx context.Context, limit, offset int) ([]*entities.User, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
 		SELECT id, name, email, role, created_at, updated_at 
 		FROM users 
-		WHERE deleted_at IS NULL
+		WHERE deleted_at IS NULL AND tenant_id = ?
 		ORDER BY created_at DESC 
 		LIMIT ? OFFSET ?
 	`
 	
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, tenantID, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -191,9 +231,14 @@ func (r *UserMySQLRepository) List(ctx context.Context, limit, offset int) ([]*e
 }
 
 func (r *UserMySQLRepository) Count(ctx context.Context) (int64, error) {
-	query := "SELECT COUNT(*) FROM users WHERE deleted_at IS NULL"
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := "SELECT COUNT(*) FROM users WHERE deleted_at IS NULL AND tenant_id = ?"
 	var count int64
-	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	err = r.db.QueryRowContext(ctx, query, tenantID).Scan(&count)
 	if err != nil {
 		return 0, err
 	}
@@ -201,9 +246,14 @@ func (r *UserMySQLRepository) Count(ctx context.Context) (int64, error) {
 }
 
 func (r *UserMySQLRepository) Exists(ctx context.Context, id int) (bool, error) {
-	query := "SELECT COUNT(*) FROM users WHERE id = ?"
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := "SELECT COUNT(*) FROM users WHERE id = ? AND tenant_id = ?"
 	var count int
-	err := r.db.QueryRowContext(ctx, query, id).Scan(&count)
+	err = r.db.QueryRowContext(ctx, query, id, tenantID).Scan(&count)
 	if err != nil {
 		return false, err
 	}
@@ -212,12 +262,95 @@ func (r *UserMySQLRepository) Exists(ctx context.Context, id int) (bool, error)
 }
 
 func (r *UserMySQLRepository) EmailExist(ctx context.Context, email string) (bool, error) {
-	query := "SELECT COUNT(*) FROM users WHERE email = ? LIMIT 1"
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	query := "SELECT COUNT(*) FROM users WHERE email = ? AND tenant_id = ? LIMIT 1"
 	var count int
-	err := r.db.QueryRowContext(ctx, query, email).Scan(&count)
+	err = r.db.QueryRowContext(ctx, query, email, tenantID).Scan(&count)
 	if err != nil {
 		return false, err
 	}
 
 	return count > 0, nil
 }
+
+func (r *UserMySQLRepository) FindByEmail(ctx context.Context, email string) (entities.User, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return entities.User{}, err
+	}
+
+	query := `
+		SELECT id, name, email, password, role, created_at, email_verified_at, locale
+		FROM users
+		WHERE email = ? AND tenant_id = ?
+	`
+
+	var userID int
+	var name, emailDB, passwordHash, role, locale string
+	var createdAt, emailVerifiedAt sql.NullTime
+
+	err = r.db.QueryRowContext(ctx, query, email, tenantID).Scan(&userID, &name, &emailDB, &passwordHash, &role, &createdAt, &emailVerifiedAt, &locale)
+	if errors.Is(err, sql.ErrNoRows) {
+		return entities.User{}, repositories.ErrUserNotFound
+	}
+	if err != nil {
+		return entities.User{}, err
+	}
+
+	emailVO, err := valueobject.NewEmail(emailDB)
+	if err != nil {
+		return entities.User{}, err
+	}
+
+	user := entities.RebuildUser(userID, name, emailVO, role)
+	user.SetPasswordHash(passwordHash)
+
+	if createdAt.Valid {
+		user.SetCreatedAt(createdAt.Time)
+	}
+	if emailVerifiedAt.Valid {
+		user.MarkEmailVerified(emailVerifiedAt.Time)
+	}
+	// idiomas que deixaram de ser atendidos ficam sem preferência
+	_ = user.SetLocale(locale)
+
+	return *user, nil
+}
+
+func (r *UserMySQLRepository) UpdatePasswordHash(ctx context.Context, id int, hash string) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE users SET password = ? WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query, hash, id, tenantID)
+	return err
+}
+
+// MarkEmailVerified preserva a data da primeira verificação.
+func (r *UserMySQLRepository) MarkEmailVerified(ctx context.Context, id int) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE users SET email_verified_at = ? WHERE id = ? AND email_verified_at IS NULL AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query, time.Now(), id, tenantID)
+	return err
+}
+
+func (r *UserMySQLRepository) UpdateLocale(ctx context.Context, id int, locale string) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE users SET locale = ? WHERE id = ? AND tenant_id = ?"
+	_, err = r.db.ExecContext(ctx, query, locale, id, tenantID)
+	return err
+}
@@ -1,19 +1,23 @@
 package persistence
 
 import (
-	"context"
 	"database/sql"
 	"errors"
 	"testing"
 	"time"
 
 	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/i18n"
+	"scheduling/internal/domain/repositories"
 	"scheduling/internal/domain/valueobject"
 
 	"github.com/DATA-DOG/go-sqlmock"
 )
 
 func TestUserMySQLRepository_Create(t *testing.T) {
+	// a senha chega ao repositório já com hash; o texto puro nunca é gravado
+	storedHash := "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$aGFzaA"
+
 	tests := []struct {
 		name    string
 		user    func() *entities.User
@@ -26,11 +30,12 @@ func TestUserMySQLRepository_Create(t *testing.T) {
 			user: func() *entities.User {
 				email, _ := valueobject.NewEmail("joao@gmail.com")
 				user, _ := entities.NewUser(1, "João da Silva", email.String(), "123456", "admin")
+				user.SetPasswordHash(storedHash)
 				return user
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("INSERT INTO users \\(name, email, password, role, created_at\\) VALUES \\(\\?, \\?, \\?, \\?, \\?\\)").
-					WithArgs("João da Silva", "joao@gmail.com", "123456", "admin", sqlmock.AnyArg()).
+				mock.ExpectExec("INSERT INTO users \\(name, email, password, role, created_at, email_verified_at, locale, tenant_id\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?, \\?, \\?\\)").
+					WithArgs("João da Silva", "joao@gmail.com", storedHash, "admin", sqlmock.AnyArg(), nil, "", testTenantID).
 					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			wantErr: false,
@@ -40,11 +45,12 @@ func TestUserMySQLRepository_Create(t *testing.T) {
 			user: func() *entities.User {
 				email, _ := valueobject.NewEmail("joao@gmail.com")
 				user, _ := entities.NewUser(0, "João da Silva", email.String(), "123456", "admin")
+				user.SetPasswordHash(storedHash)
 				return user
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("INSERT INTO users \\(name, email, password, role, created_at\\) VALUES \\(\\?, \\?, \\?, \\?, \\?\\)").
-					WithArgs("João da Silva", "joao@gmail.com", "123456", "admin", sqlmock.AnyArg()).
+				mock.ExpectExec("INSERT INTO users \\(name, email, password, role, created_at, email_verified_at, locale, tenant_id\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?, \\?, \\?\\)").
+					WithArgs("João da Silva", "joao@gmail.com", storedHash, "admin", sqlmock.AnyArg(), nil, "", testTenantID).
 					WillReturnError(errors.New("database error"))
 			},
 			wantErr: true,
@@ -55,11 +61,12 @@ func TestUserMySQLRepository_Create(t *testing.T) {
 			user: func() *entities.User {
 				email, _ := valueobject.NewEmail("joao@gmail.com")
 				user, _ := entities.NewUser(0, "João da Silva", email.String(), "123456", "admin")
+				user.SetPasswordHash(storedHash)
 				return user
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectExec("INSERT INTO users \\(name, email, password, role, created_at\\) VALUES \\(\\?, \\?, \\?, \\?, \\?\\)").
-					WithArgs("João da Silva", "joao@gmail.com", "123456", "admin", sqlmock.AnyArg()).
+				mock.ExpectExec("INSERT INTO users \\(name, email, password, role, created_at, email_verified_at, locale, tenant_id\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?, \\?, \\?\\)").
+					WithArgs("João da Silva", "joao@gmail.com", storedHash, "admin", sqlmock.AnyArg(), nil, "", testTenantID).
 					WillReturnResult(sqlmock.NewErrorResult(errors.New("last insert id error")))
 			},
 			wantErr: true,
@@ -79,7 +86,7 @@ func TestUserMySQLRepository_Create(t *testing.T) {
 
 			repo := NewUserMySQLRepository(db)
 			user := tt.user()
-			err = repo.Create(context.Background(), user)
+			err = repo.Create(testCtx, user)
 
 			if tt.wantErr {
 				if err == nil {
@@ -107,7 +114,7 @@ func TestUserMySQLRepository_Create(t *testing.T) {
 }
 
 func TestUserMySQLRepository_FindByID(t *testing.T) {
-    expectedQuery := "SELECT id, name, email, password, role, created_at FROM users WHERE id = \\?"
+    expectedQuery := "SELECT id, name, email, password, role, created_at, email_verified_at, locale FROM users WHERE id = \\?"
 
     tests := []struct {
         name    string
@@ -121,11 +128,11 @@ func TestUserMySQLRepository_FindByID(t *testing.T) {
             name:   "usuário encontrado com sucesso",
             userID: 1,
             mockFn: func(mock sqlmock.Sqlmock) {
-                rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "created_at"}).
-                    AddRow(1, "João Silva", "joao@email.com", "123456", "client", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+                rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "created_at", "email_verified_at", "locale"}).
+                    AddRow(1, "João Silva", "joao@email.com", "123456", "client", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), "")
 
                 mock.ExpectQuery(expectedQuery).
-                    WithArgs(1).
+                    WithArgs(1, testTenantID).
                     WillReturnRows(rows)
             },
             want: func(t *testing.T, user *entities.User) {
@@ -145,6 +152,9 @@ func TestUserMySQLRepository_FindByID(t *testing.T) {
                 if !user.CreatedAt().Equal(expectedTime) {
                     t.Errorf("createdAt esperado %v, obtido %v", expectedTime, user.CreatedAt())
                 }
+                if !user.IsEmailVerified() {
+                    t.Error("email deveria estar verificado")
+                }
             },
             wantErr: false,
         },
@@ -152,11 +162,11 @@ func TestUserMySQLRepository_FindByID(t *testing.T) {
             name:   "usuário encontrado sem created_at",
             userID: 2,
             mockFn: func(mock sqlmock.Sqlmock) {
-                rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "created_at"}).
-                    AddRow(2, "Maria Santos", "maria@email.com", "123456", "admin", nil)
+                rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "created_at", "email_verified_at", "locale"}).
+                    AddRow(2, "Maria Santos", "maria@email.com", "123456", "admin", nil, nil, "en")
 
                 mock.ExpectQuery(expectedQuery).
-                    WithArgs(2).
+                    WithArgs(2, testTenantID).
                     WillReturnRows(rows)
             },
             want: func(t *testing.T, user *entities.User) {
@@ -175,6 +185,9 @@ func TestUserMySQLRepository_FindByID(t *testing.T) {
                 if !user.CreatedAt().IsZero() {
                     t.Errorf("createdAt esperado zero value, obtido %v", user.CreatedAt())
                 }
+                if user.IsEmailVerified() {
+                    t.Error("email não deveria estar verificado")
+                }
             },
             wantErr: false,
         },
@@ -183,7 +196,7 @@ func TestUserMySQLRepository_FindByID(t *testing.T) {
             userID: 3,
             mockFn: func(mock sqlmock.Sqlmock) {
                 mock.ExpectQuery(expectedQuery).
-                    WithArgs(3).
+                    WithArgs(3, testTenantID).
                     WillReturnError(errors.New("database connection error"))
             },
             wantErr: true,
@@ -193,10 +206,10 @@ func TestUserMySQLRepository_FindByID(t *testing.T) {
             name:   "email inválido retornado do banco",
             userID: 4,
             mockFn: func(mock sqlmock.Sqlmock) {
-                rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "created_at"}).
-                    AddRow(4, "Pedro Lima", "email-invalido", "", "client", time.Now())
+                rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "created_at", "email_verified_at", "locale"}).
+                    AddRow(4, "Pedro Lima", "email-invalido", "", "client", time.Now(), nil, "")
                 mock.ExpectQuery(expectedQuery).
-                    WithArgs(4).
+                    WithArgs(4, testTenantID).
                     WillReturnRows(rows)
             },
             wantErr: true,
@@ -214,7 +227,7 @@ func TestUserMySQLRepository_FindByID(t *testing.T) {
             tt.mockFn(mock)
 
             repo := NewUserMySQLRepository(db)
-            got, err := repo.FindByID(context.Background(), tt.userID)
+            got, err := repo.FindByID(testCtx, tt.userID)
 
             if tt.wantErr {
                 if err == nil {
@@ -256,7 +269,7 @@ func TestUserMySQLRepository_Exists(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE id = \\?").
-					WithArgs(1).
+					WithArgs(1, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -268,7 +281,7 @@ func TestUserMySQLRepository_Exists(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(0)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE id = \\?").
-					WithArgs(999).
+					WithArgs(999, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    false,
@@ -280,7 +293,7 @@ func TestUserMySQLRepository_Exists(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE id = \\?").
-					WithArgs(2).
+					WithArgs(2, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -291,7 +304,7 @@ func TestUserMySQLRepository_Exists(t *testing.T) {
 			userID: 3,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE id = \\?").
-					WithArgs(3).
+					WithArgs(3, testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			want:    false,
@@ -304,7 +317,7 @@ func TestUserMySQLRepository_Exists(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow("invalid_number")
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE id = \\?").
-					WithArgs(4).
+					WithArgs(4, testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    false,
@@ -323,7 +336,7 @@ func TestUserMySQLRepository_Exists(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewUserMySQLRepository(db)
-			got, err := repo.Exists(context.Background(), tt.userID)
+			got, err := repo.Exists(testCtx, tt.userID)
 
 			if tt.wantErr {
 				if err == nil {
@@ -365,7 +378,7 @@ func TestUserMySQLRepository_EmailExist(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(1)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE email = \\?").
-					WithArgs("joao@gmail.com").
+					WithArgs("joao@gmail.com", testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -377,7 +390,7 @@ func TestUserMySQLRepository_EmailExist(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(0)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE email = \\?").
-					WithArgs("joao@gmail.com").
+					WithArgs("joao@gmail.com", testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    false,
@@ -389,7 +402,7 @@ func TestUserMySQLRepository_EmailExist(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE email = \\?").
-					WithArgs("maria@gmail.com").
+					WithArgs("maria@gmail.com", testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    true,
@@ -400,7 +413,7 @@ func TestUserMySQLRepository_EmailExist(t *testing.T) {
 			email: "joao@gmail.com",
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE email = \\?").
-					WithArgs("joao@gmail.com").
+					WithArgs("joao@gmail.com", testTenantID).
 					WillReturnError(errors.New("database connection error"))
 			},
 			want:    false,
@@ -413,7 +426,7 @@ func TestUserMySQLRepository_EmailExist(t *testing.T) {
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"count"}).AddRow("invalid_number")
 				mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE email = \\?").
-					WithArgs("joao@gmail.com").
+					WithArgs("joao@gmail.com", testTenantID).
 					WillReturnRows(rows)
 			},
 			want:    false,
@@ -432,7 +445,7 @@ func TestUserMySQLRepository_EmailExist(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewUserMySQLRepository(db)
-			got, err := repo.EmailExist(context.Background(), tt.email)
+			got, err := repo.EmailExist(testCtx, tt.email)
 
 			if tt.wantErr {
 				if err == nil {
@@ -476,7 +489,7 @@ func TestUserMySQLRepository_Update(t *testing.T) {
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE users SET name = \\?, email = \\?, role = \\? WHERE id = \\?").
-					WithArgs("João Silva Atualizado", "joao.novo@email.com", "admin", 1).
+					WithArgs("João Silva Atualizado", "joao.novo@email.com", "admin", 1, testTenantID).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
@@ -490,7 +503,7 @@ func TestUserMySQLRepository_Update(t *testing.T) {
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE users SET name = \\?, email = \\?, role = \\? WHERE id = \\?").
-					WithArgs("João Silva", "joao@email.com", "admin", 1).
+					WithArgs("João Silva", "joao@email.com", "admin", 1, testTenantID).
 					WillReturnError(errors.New("update error"))
 			},
 			wantErr: true,
@@ -505,7 +518,7 @@ func TestUserMySQLRepository_Update(t *testing.T) {
 			},
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE users SET name = \\?, email = \\?, role = \\? WHERE id = \\?").
-					WithArgs("João Silva", "joao@email.com", "admin", 999).
+					WithArgs("João Silva", "joao@email.com", "admin", 999, testTenantID).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			wantErr: true,
@@ -525,7 +538,7 @@ func TestUserMySQLRepository_Update(t *testing.T) {
 
 			repo := NewUserMySQLRepository(db)
 			user := tt.user()
-			err = repo.Update(context.Background(), user)
+			err = repo.Update(testCtx, user)
 
 			if tt.wantErr {
 				if err == nil {
@@ -561,7 +574,7 @@ func TestUserMySQLRepository_Delete(t *testing.T) {
 			userID: 1,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE users SET deleted_at = \\?, updated_at = \\? WHERE id = \\? AND deleted_at IS NULL").
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 1, testTenantID).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 			},
 			wantErr: false,
@@ -571,7 +584,7 @@ func TestUserMySQLRepository_Delete(t *testing.T) {
 			userID: 2,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE users SET deleted_at = \\?, updated_at = \\? WHERE id = \\? AND deleted_at IS NULL").
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 2).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 2, testTenantID).
 					WillReturnError(errors.New("delete error"))
 			},
 			wantErr: true,
@@ -582,7 +595,7 @@ func TestUserMySQLRepository_Delete(t *testing.T) {
 			userID: 999,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				mock.ExpectExec("UPDATE users SET deleted_at = \\?, updated_at = \\? WHERE id = \\? AND deleted_at IS NULL").
-					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 999).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 999, testTenantID).
 					WillReturnResult(sqlmock.NewResult(0, 0))
 			},
 			wantErr: true,
@@ -601,7 +614,7 @@ func TestUserMySQLRepository_Delete(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewUserMySQLRepository(db)
-			err = repo.Delete(context.Background(), tt.userID)
+			err = repo.Delete(testCtx, tt.userID)
 
 			if tt.wantErr {
 				if err == nil {
@@ -642,8 +655,8 @@ func TestUserMySQLRepository_List(t *testing.T) {
 				rows := sqlmock.NewRows([]string{"id", "name", "email", "role", "created_at", "updated_at"}).
 					AddRow(1, "João Silva", "joao@email.com", "admin", time.Now(), time.Now()).
 					AddRow(2, "Maria Santos", "maria@email.com", "client", time.Now(), time.Now())
-				mock.ExpectQuery("SELECT id, name, email, role, created_at, updated_at FROM users WHERE deleted_at IS NULL ORDER BY created_at DESC LIMIT \\? OFFSET \\?").
-					WithArgs(10, 0).
+				mock.ExpectQuery("SELECT id, name, email, role, created_at, updated_at FROM users WHERE deleted_at IS NULL AND tenant_id = \\? ORDER BY created_at DESC LIMIT \\? OFFSET \\?").
+					WithArgs(testTenantID, 10, 0).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, users []*entities.User) {
@@ -665,8 +678,8 @@ func TestUserMySQLRepository_List(t *testing.T) {
 			offset: 0,
 			mockFn: func(mock sqlmock.Sqlmock) {
 				rows := sqlmock.NewRows([]string{"id", "name", "email", "role", "created_at", "updated_at"})
-				mock.ExpectQuery("SELECT id, name, email, role, created_at, updated_at FROM users WHERE deleted_at IS NULL ORDER BY created_at DESC LIMIT \\? OFFSET \\?").
-					WithArgs(10, 0).
+				mock.ExpectQuery("SELECT id, name, email, role, created_at, updated_at FROM users WHERE deleted_at IS NULL AND tenant_id = \\? ORDER BY created_at DESC LIMIT \\? OFFSET \\?").
+					WithArgs(testTenantID, 10, 0).
 					WillReturnRows(rows)
 			},
 			want: func(t *testing.T, users []*entities.User) {
@@ -681,8 +694,8 @@ func TestUserMySQLRepository_List(t *testing.T) {
 			limit:  10,
 			offset: 0,
 			mockFn: func(mock sqlmock.Sqlmock) {
-				mock.ExpectQuery("SELECT id, name, email, role, created_at, updated_at FROM users WHERE deleted_at IS NULL ORDER BY created_at DESC LIMIT \\? OFFSET \\?").
-					WithArgs(10, 0).
+				mock.ExpectQuery("SELECT id, name, email, role, created_at, updated_at FROM users WHERE deleted_at IS NULL AND tenant_id = \\? ORDER BY created_at DESC LIMIT \\? OFFSET \\?").
+					WithArgs(testTenantID, 10, 0).
 					WillReturnError(errors.New("query error"))
 			},
 			wantErr: true,
@@ -701,7 +714,7 @@ func TestUserMySQLRepository_List(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewUserMySQLRepository(db)
-			got, err := repo.List(context.Background(), tt.limit, tt.offset)
+			got, err := repo.List(testCtx, tt.limit, tt.offset)
 
 			if tt.wantErr {
 				if err == nil {
@@ -769,7 +782,7 @@ func TestUserMySQLRepository_Count(t *testing.T) {
 			tt.mockFn(mock)
 
 			repo := NewUserMySQLRepository(db)
-			got, err := repo.Count(context.Background())
+			got, err := repo.Count(testCtx)
 
 			if tt.wantErr {
 				if err == nil {
@@ -836,4 +849,124 @@ func TestNewUserMySQLRepository(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestUserMySQLRepository_FindByEmail(t *testing.T) {
+	createdTime := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	storedHash := "$2a$12$abcdefghijklmnopqrstuv"
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "created_at", "email_verified_at", "locale"}).
+		AddRow(3, "Maria", "maria@gmail.com", storedHash, "client", createdTime, nil, "en")
+	mock.ExpectQuery("SELECT id, name, email, password, role, created_at, email_verified_at, locale\\s+FROM users\\s+WHERE email = \\? AND tenant_id = \\?").
+		WithArgs("maria@gmail.com", testTenantID).
+		WillReturnRows(rows)
+
+	repo := NewUserMySQLRepository(db)
+	user, err := repo.FindByEmail(testCtx, "maria@gmail.com")
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if user.ID() != 3 || user.Email() != "maria@gmail.com" {
+		t.Errorf("usuário com valores incorretos: id=%d email=%s", user.ID(), user.Email())
+	}
+	if user.PasswordHash() != storedHash {
+		t.Errorf("PasswordHash esperado '%s', obtido '%s'", storedHash, user.PasswordHash())
+	}
+	if user.Locale() != i18n.En {
+		t.Errorf("idioma esperado '%s', obtido '%s'", i18n.En, user.Locale())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestUserMySQLRepository_UpdatePasswordHash(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE users SET password = \\? WHERE id = \\? AND tenant_id = \\?").
+		WithArgs("novo-hash", 3, testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewUserMySQLRepository(db)
+	if err := repo.UpdatePasswordHash(testCtx, 3, "novo-hash"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestUserMySQLRepository_MarkEmailVerified(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE users SET email_verified_at = \\? WHERE id = \\? AND email_verified_at IS NULL AND tenant_id = \\?").
+		WithArgs(sqlmock.AnyArg(), 3, testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewUserMySQLRepository(db)
+	if err := repo.MarkEmailVerified(testCtx, 3); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestUserMySQLRepository_UpdateLocale(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE users SET locale = \\? WHERE id = \\? AND tenant_id = \\?").
+		WithArgs("en", 3, testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewUserMySQLRepository(db)
+	if err := repo.UpdateLocale(testCtx, 3, "en"); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestUserMySQLRepository_FindByEmail_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT id, name, email, password, role, created_at, email_verified_at, locale\\s+FROM users").
+		WithArgs("ninguem@gmail.com", testTenantID).
+		WillReturnError(sql.ErrNoRows)
+
+	repo := NewUserMySQLRepository(db)
+	_, err = repo.FindByEmail(testCtx, "ninguem@gmail.com")
+	if !errors.Is(err, repositories.ErrUserNotFound) {
+		t.Errorf("esperado ErrUserNotFound, obtido %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
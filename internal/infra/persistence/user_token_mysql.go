@@ -0,0 +1,117 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"scheduling/internal/domain/entities"
+	"scheduling/internal/domain/tenant"
+)
+
+type UserTokenMySQLRepository struct {
+	db *sql.DB
+}
+
+func NewUserTokenMySQLRepository(db *sql.DB) *UserTokenMySQLRepository {
+	return &UserTokenMySQLRepository{db: db}
+}
+
+func (r *UserTokenMySQLRepository) Create(ctx context.Context, token *entities.UserToken) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO user_tokens (user_id, purpose, token_hash, expires_at, created_at, tenant_id)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	result, err := r.db.ExecContext(ctx, query,
+		token.UserID(),
+		token.Purpose(),
+		token.TokenHash(),
+		token.ExpiresAt(),
+		token.CreatedAt(),
+		tenantID,
+	)
+	if err != nil {
+		return err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	token.SetID(int(id))
+
+	return nil
+}
+
+func (r *UserTokenMySQLRepository) FindByHash(ctx context.Context, purpose, tokenHash string) (*entities.UserToken, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, user_id, purpose, token_hash, expires_at, used_at, created_at
+		FROM user_tokens
+		WHERE token_hash = ? AND purpose = ? AND tenant_id = ?
+	`
+
+	var id, userID int
+	var storedPurpose, hash string
+	var expiresAt, createdAt time.Time
+	var usedAt sql.NullTime
+
+	err = r.db.QueryRowContext(ctx, query, tokenHash, purpose, tenantID).Scan(&id, &userID, &storedPurpose, &hash, &expiresAt, &usedAt, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var used *time.Time
+	if usedAt.Valid {
+		used = &usedAt.Time
+	}
+
+	return entities.RebuildUserToken(id, userID, storedPurpose, hash, expiresAt, used, createdAt), nil
+}
+
+// Consume só marca tokens ainda não usados e dentro da validade, então o
+// mesmo link não pode ser aproveitado por duas requisições simultâneas.
+func (r *UserTokenMySQLRepository) Consume(ctx context.Context, id int) (bool, error) {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	query := `UPDATE user_tokens SET used_at = ? WHERE id = ? AND used_at IS NULL AND expires_at > ? AND tenant_id = ?`
+	result, err := r.db.ExecContext(ctx, query, now, id, now, tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *UserTokenMySQLRepository) InvalidateAll(ctx context.Context, userID int, purpose string) error {
+	tenantID, err := tenant.Require(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `UPDATE user_tokens SET used_at = ? WHERE user_id = ? AND purpose = ? AND used_at IS NULL AND tenant_id = ?`
+	_, err = r.db.ExecContext(ctx, query, time.Now(), userID, purpose, tenantID)
+	return err
+}
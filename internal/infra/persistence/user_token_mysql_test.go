@@ -0,0 +1,184 @@
+package persistence
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"scheduling/internal/domain/entities"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUserTokenMySQLRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	expiresAt := time.Date(2026, 1, 20, 10, 0, 0, 0, time.UTC)
+	token, _ := entities.NewUserToken(3, entities.TokenPurposePasswordReset, "hash-do-token", expiresAt)
+
+	mock.ExpectExec("INSERT INTO user_tokens \\(user_id, purpose, token_hash, expires_at, created_at, tenant_id\\)").
+		WithArgs(3, entities.TokenPurposePasswordReset, "hash-do-token", expiresAt, sqlmock.AnyArg(), testTenantID).
+		WillReturnResult(sqlmock.NewResult(8, 1))
+
+	repo := NewUserTokenMySQLRepository(db)
+	if err := repo.Create(testCtx, token); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if token.ID() != 8 {
+		t.Errorf("ID esperado 8, obtido %d", token.ID())
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
+
+func TestUserTokenMySQLRepository_FindByHash(t *testing.T) {
+	query := "SELECT id, user_id, purpose, token_hash, expires_at, used_at, created_at\\s+FROM user_tokens\\s+WHERE token_hash = \\? AND purpose = \\? AND tenant_id = \\?"
+	columns := []string{"id", "user_id", "purpose", "token_hash", "expires_at", "used_at", "created_at"}
+	createdAt := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+	expiresAt := createdAt.Add(time.Hour)
+	purpose := entities.TokenPurposeEmailVerification
+
+	tests := []struct {
+		name    string
+		mockFn  func(sqlmock.Sqlmock)
+		want    func(*testing.T, *entities.UserToken)
+		wantErr bool
+	}{
+		{
+			name: "token pendente",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(8, 3, purpose, "hash", expiresAt, nil, createdAt)
+				mock.ExpectQuery(query).WithArgs("hash", purpose, testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, token *entities.UserToken) {
+				if token.ID() != 8 || token.UserID() != 3 || token.Purpose() != purpose {
+					t.Errorf("token com valores incorretos: id=%d usuário=%d finalidade=%s", token.ID(), token.UserID(), token.Purpose())
+				}
+				if token.IsUsed() {
+					t.Error("token não deveria estar usado")
+				}
+			},
+		},
+		{
+			name: "token usado",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				rows := sqlmock.NewRows(columns).AddRow(8, 3, purpose, "hash", expiresAt, createdAt.Add(time.Minute), createdAt)
+				mock.ExpectQuery(query).WithArgs("hash", purpose, testTenantID).WillReturnRows(rows)
+			},
+			want: func(t *testing.T, token *entities.UserToken) {
+				if !token.IsUsed() {
+					t.Error("token deveria estar usado")
+				}
+			},
+		},
+		{
+			name: "token inexistente",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs("hash", purpose, testTenantID).WillReturnRows(sqlmock.NewRows(columns))
+			},
+			want: func(t *testing.T, token *entities.UserToken) {
+				if token != nil {
+					t.Errorf("esperado nil, obtido %+v", token)
+				}
+			},
+		},
+		{
+			name: "erro no banco de dados",
+			mockFn: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).WithArgs("hash", purpose, testTenantID).WillReturnError(errors.New("database connection error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			tt.mockFn(mock)
+
+			repo := NewUserTokenMySQLRepository(db)
+			token, err := repo.FindByHash(testCtx, purpose, "hash")
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FindByHash() erro = %v, esperado erro = %v", err, tt.wantErr)
+			}
+			if tt.want != nil {
+				tt.want(t, token)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserTokenMySQLRepository_Consume(t *testing.T) {
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		want         bool
+	}{
+		{name: "token pendente é consumido", rowsAffected: 1, want: true},
+		{name: "token já usado ou expirado", rowsAffected: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("erro ao criar mock do banco: %v", err)
+			}
+			defer db.Close()
+
+			mock.ExpectExec("UPDATE user_tokens SET used_at = \\? WHERE id = \\? AND used_at IS NULL AND expires_at > \\? AND tenant_id = \\?").
+				WithArgs(sqlmock.AnyArg(), 8, sqlmock.AnyArg(), testTenantID).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+
+			repo := NewUserTokenMySQLRepository(db)
+			got, err := repo.Consume(testCtx, 8)
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Consume() = %v, esperado %v", got, tt.want)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("expectativas do mock não foram atendidas: %v", err)
+			}
+		})
+	}
+}
+
+func TestUserTokenMySQLRepository_InvalidateAll(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("erro ao criar mock do banco: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec("UPDATE user_tokens SET used_at = \\? WHERE user_id = \\? AND purpose = \\? AND used_at IS NULL AND tenant_id = \\?").
+		WithArgs(sqlmock.AnyArg(), 3, entities.TokenPurposePasswordReset, testTenantID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := NewUserTokenMySQLRepository(db)
+	if err := repo.InvalidateAll(testCtx, 3, entities.TokenPurposePasswordReset); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expectativas do mock não foram atendidas: %v", err)
+	}
+}
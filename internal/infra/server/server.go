@@ -0,0 +1,354 @@
+// Package server controla o ciclo de vida do servidor HTTP: timeouts,
+// TLS opcional, tarefas de fundo e o encerramento gracioso, que espera as
+// requisições em andamento antes de fechar o banco e demais recursos.
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAddr            = ":8080"
+	defaultReadTimeout     = 15 * time.Second
+	defaultWriteTimeout    = 30 * time.Second
+	defaultIdleTimeout     = 60 * time.Second
+	defaultShutdownTimeout = 20 * time.Second
+)
+
+type Config struct {
+	Addr         string
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+	IdleTimeout  time.Duration
+	// ShutdownTimeout é o prazo para drenar as requisições em andamento e
+	// parar as tarefas de fundo depois do sinal de encerramento.
+	ShutdownTimeout time.Duration
+	// TLSCertFile e TLSKeyFile ativam HTTPS quando definidos.
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Addr:            defaultAddr,
+		ReadTimeout:     defaultReadTimeout,
+		WriteTimeout:    defaultWriteTimeout,
+		IdleTimeout:     defaultIdleTimeout,
+		ShutdownTimeout: defaultShutdownTimeout,
+	}
+}
+
+// ConfigFromEnv lê HTTP_ADDR, os timeouts HTTP_READ_TIMEOUT,
+// HTTP_WRITE_TIMEOUT, HTTP_IDLE_TIMEOUT e HTTP_SHUTDOWN_TIMEOUT (durações no
+// formato do Go, ex.: "30s") e o par HTTP_TLS_CERT_FILE/HTTP_TLS_KEY_FILE.
+func ConfigFromEnv() (Config, error) {
+	config := DefaultConfig()
+	if addr := os.Getenv("HTTP_ADDR"); addr != "" {
+		config.Addr = addr
+	}
+
+	durations := []struct {
+		key    string
+		target *time.Duration
+	}{
+		{"HTTP_READ_TIMEOUT", &config.ReadTimeout},
+		{"HTTP_WRITE_TIMEOUT", &config.WriteTimeout},
+		{"HTTP_IDLE_TIMEOUT", &config.IdleTimeout},
+		{"HTTP_SHUTDOWN_TIMEOUT", &config.ShutdownTimeout},
+	}
+	for _, duration := range durations {
+		value := os.Getenv(duration.key)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed < 0 {
+			return Config{}, fmt.Errorf("%s inválido: %q", duration.key, value)
+		}
+		*duration.target = parsed
+	}
+
+	config.TLSCertFile = os.Getenv("HTTP_TLS_CERT_FILE")
+	config.TLSKeyFile = os.Getenv("HTTP_TLS_KEY_FILE")
+	if (config.TLSCertFile == "") != (config.TLSKeyFile == "") {
+		return Config{}, errors.New("HTTP_TLS_CERT_FILE e HTTP_TLS_KEY_FILE devem ser definidos juntos")
+	}
+	return config, nil
+}
+
+// Worker é uma tarefa de fundo que roda até ctx ser cancelado no
+// encerramento do servidor.
+type Worker func(ctx context.Context) error
+
+// WorkerStatus é o estado de uma tarefa de fundo. Err guarda o erro com que
+// a tarefa terminou antes do encerramento.
+type WorkerStatus struct {
+	Name    string
+	Running bool
+	Err     error
+}
+
+type worker struct {
+	name   string
+	run    Worker
+	status WorkerStatus
+}
+
+type hook struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+type Server struct {
+	logger  *slog.Logger
+	config  Config
+	handler http.Handler
+
+	mu       sync.Mutex
+	workers  []*worker
+	hooks    []hook
+	draining bool
+}
+
+func New(logger *slog.Logger, handler http.Handler, config Config) *Server {
+	return &Server{logger: logger, config: config, handler: handler}
+}
+
+// Go registra uma tarefa de fundo, iniciada junto com o servidor e parada
+// no encerramento, antes dos hooks de OnShutdown.
+func (s *Server) Go(name string, run Worker) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.workers = append(s.workers, &worker{name: name, run: run, status: WorkerStatus{Name: name}})
+}
+
+// OnShutdown registra um hook executado depois de drenadas as requisições e
+// paradas as tarefas de fundo, na ordem inversa do registro (o banco,
+// registrado primeiro, fecha por último).
+func (s *Server) OnShutdown(name string, fn func(ctx context.Context) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hooks = append(s.hooks, hook{name: name, fn: fn})
+}
+
+func (s *Server) Workers() []WorkerStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make([]WorkerStatus, 0, len(s.workers))
+	for _, w := range s.workers {
+		statuses = append(statuses, w.status)
+	}
+	return statuses
+}
+
+// Draining indica que o encerramento começou; novas requisições ainda são
+// atendidas, mas o servidor não deve receber mais tráfego.
+func (s *Server) Draining() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.draining
+}
+
+// CheckWorkers falha se alguma tarefa de fundo não está rodando. Serve de
+// verificação de prontidão.
+func (s *Server) CheckWorkers(ctx context.Context) error {
+	var stopped []string
+	for _, status := range s.Workers() {
+		if !status.Running {
+			stopped = append(stopped, status.Name)
+		}
+	}
+	if len(stopped) > 0 {
+		return fmt.Errorf("tarefas de fundo paradas: %s", strings.Join(stopped, ", "))
+	}
+	return nil
+}
+
+// CheckServing falha a partir do início do encerramento, para que o
+// balanceador deixe de enviar tráfego enquanto as requisições são drenadas.
+func (s *Server) CheckServing(ctx context.Context) error {
+	if s.Draining() {
+		return errors.New("servidor em encerramento")
+	}
+	return nil
+}
+
+// Run escuta em Config.Addr até ctx ser cancelado (ex.: por
+// signal.NotifyContext com SIGTERM) e então encerra o servidor.
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.config.Addr)
+	if err != nil {
+		return fmt.Errorf("erro ao escutar em %s: %w", s.config.Addr, err)
+	}
+	return s.Serve(ctx, listener)
+}
+
+// Serve atende as conexões de listener até ctx ser cancelado. Então para de
+// aceitar conexões, espera as requisições em andamento e as tarefas de fundo
+// por até ShutdownTimeout e executa os hooks de OnShutdown.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	httpServer := &http.Server{
+		Handler:           s.handler,
+		ReadTimeout:       s.config.ReadTimeout,
+		ReadHeaderTimeout: s.config.ReadTimeout,
+		WriteTimeout:      s.config.WriteTimeout,
+		IdleTimeout:       s.config.IdleTimeout,
+		ErrorLog:          slog.NewLogLogger(s.logger.Handler(), slog.LevelWarn),
+	}
+
+	// o certificado é carregado antes de iniciar as tarefas de fundo, para
+	// que um arquivo inválido impeça a subida
+	if s.config.TLSCertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(s.config.TLSCertFile, s.config.TLSKeyFile)
+		if err != nil {
+			listener.Close()
+			return fmt.Errorf("erro ao carregar o certificado TLS: %w", err)
+		}
+		httpServer.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	workersDone := s.startWorkers(workersCtx)
+
+	serveErr := make(chan error, 1)
+	go func() {
+		if httpServer.TLSConfig != nil {
+			serveErr <- httpServer.ServeTLS(listener, "", "")
+			return
+		}
+		serveErr <- httpServer.Serve(listener)
+	}()
+
+	s.logger.Info("Servidor HTTP iniciado", "addr", listener.Addr().String(), "tls", s.config.TLSCertFile != "")
+
+	select {
+	case err := <-serveErr:
+		// o servidor parou sozinho: encerra o resto antes de devolver o erro
+		stopWorkers()
+		<-workersDone
+		s.runHooks()
+		return fmt.Errorf("erro no servidor HTTP: %w", err)
+	case <-ctx.Done():
+	}
+
+	return s.shutdown(httpServer, stopWorkers, workersDone)
+}
+
+func (s *Server) shutdown(httpServer *http.Server, stopWorkers context.CancelFunc, workersDone <-chan struct{}) error {
+	s.mu.Lock()
+	s.draining = true
+	s.mu.Unlock()
+
+	s.logger.Info("Encerrando o servidor HTTP", "timeout", s.config.ShutdownTimeout.String())
+
+	deadline, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout)
+	defer cancel()
+
+	var shutdownErr error
+	if err := httpServer.Shutdown(deadline); err != nil {
+		// prazo esgotado: as conexões restantes são cortadas
+		httpServer.Close()
+		shutdownErr = fmt.Errorf("requisições em andamento não terminaram no prazo: %w", err)
+		s.logger.Error(
+			"Erro ao drenar as requisições",
+			"error", err.Error(),
+			"operation", "server.shutdown",
+		)
+	}
+
+	stopWorkers()
+	select {
+	case <-workersDone:
+	case <-deadline.Done():
+		s.logger.Error(
+			"Tarefas de fundo não pararam no prazo",
+			"error", deadline.Err().Error(),
+			"operation", "server.shutdown",
+		)
+		if shutdownErr == nil {
+			shutdownErr = errors.New("tarefas de fundo não pararam no prazo")
+		}
+	}
+
+	s.runHooks()
+	s.logger.Info("Servidor HTTP encerrado")
+	return shutdownErr
+}
+
+func (s *Server) startWorkers(ctx context.Context) <-chan struct{} {
+	s.mu.Lock()
+	workers := append([]*worker(nil), s.workers...)
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, w := range workers {
+		s.setWorkerStatus(w, true, nil)
+
+		wg.Add(1)
+		go func(w *worker) {
+			defer wg.Done()
+
+			err := w.run(ctx)
+			if err != nil && ctx.Err() == nil {
+				s.logger.Error(
+					"Tarefa de fundo parou com erro",
+					"error", err.Error(),
+					"worker", w.name,
+					"operation", "server.worker",
+				)
+			}
+			s.setWorkerStatus(w, false, err)
+		}(w)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	return done
+}
+
+func (s *Server) setWorkerStatus(w *worker, running bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.status.Running = running
+	w.status.Err = err
+}
+
+// runHooks tem prazo próprio: mesmo que a drenagem tenha esgotado o tempo,
+// o banco e os demais recursos ainda são fechados.
+func (s *Server) runHooks() {
+	s.mu.Lock()
+	hooks := append([]hook(nil), s.hooks...)
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout)
+	defer cancel()
+
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if err := hooks[i].fn(ctx); err != nil {
+			s.logger.Error(
+				"Erro no hook de encerramento",
+				"error", err.Error(),
+				"hook", hooks[i].name,
+				"operation", "server.shutdown",
+			)
+		}
+	}
+}
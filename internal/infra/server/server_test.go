@@ -0,0 +1,391 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func listen(t *testing.T) net.Listener {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("falha ao escutar: %v", err)
+	}
+	return listener
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condição não atingida no prazo")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestServer_GracefulShutdown(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte("agendado"))
+	})
+
+	var mu sync.Mutex
+	var events []string
+	record := func(event string) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, event)
+	}
+
+	config := DefaultConfig()
+	config.ShutdownTimeout = 5 * time.Second
+	srv := New(discardLogger(), handler, config)
+	srv.Go("lembretes", func(ctx context.Context) error {
+		<-ctx.Done()
+		record("worker parado")
+		return ctx.Err()
+	})
+	srv.OnShutdown("banco", func(ctx context.Context) error {
+		record("banco fechado")
+		return nil
+	})
+	srv.OnShutdown("cache", func(ctx context.Context) error {
+		record("cache fechado")
+		return errors.New("falha ignorada")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	listener := listen(t)
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(ctx, listener) }()
+
+	body := make(chan string, 1)
+	go func() {
+		resp, err := http.Get("http://" + listener.Addr().String())
+		if err != nil {
+			body <- "erro: " + err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		body <- string(data)
+	}()
+
+	<-started
+	if workers := srv.Workers(); len(workers) != 1 || !workers[0].Running {
+		t.Errorf("tarefa de fundo deveria estar rodando: %+v", workers)
+	}
+
+	// o sinal chega com a requisição em andamento
+	cancel()
+	waitFor(t, srv.Draining)
+	close(release)
+
+	if got := <-body; got != "agendado" {
+		t.Errorf("requisição em andamento deveria terminar, obtido %q", got)
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("erro inesperado no encerramento: %v", err)
+	}
+
+	want := []string{"worker parado", "cache fechado", "banco fechado"}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("ordem de encerramento esperada %v, obtida %v", want, events)
+	}
+	if workers := srv.Workers(); workers[0].Running {
+		t.Errorf("tarefa de fundo deveria estar parada: %+v", workers)
+	}
+
+	if _, err := net.Dial("tcp", listener.Addr().String()); err == nil {
+		t.Error("servidor não deveria aceitar conexões depois do encerramento")
+	}
+}
+
+func TestServer_ShutdownDeadline(t *testing.T) {
+	started := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-r.Context().Done()
+	})
+
+	config := DefaultConfig()
+	config.ShutdownTimeout = 100 * time.Millisecond
+	srv := New(discardLogger(), handler, config)
+
+	hookCalled := false
+	srv.OnShutdown("banco", func(ctx context.Context) error {
+		hookCalled = true
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	listener := listen(t)
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(ctx, listener) }()
+
+	go http.Get("http://" + listener.Addr().String())
+	<-started
+	cancel()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Error("esperava erro pelo prazo de drenagem esgotado")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("encerramento não respeitou o prazo")
+	}
+	if !hookCalled {
+		t.Error("hooks devem rodar mesmo com o prazo esgotado")
+	}
+}
+
+func TestServer_WorkerError(t *testing.T) {
+	srv := New(discardLogger(), http.NotFoundHandler(), DefaultConfig())
+	srv.Go("fila", func(ctx context.Context) error {
+		return errors.New("conexão perdida")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(ctx, listen(t)) }()
+
+	waitFor(t, func() bool { return srv.Workers()[0].Err != nil })
+
+	status := srv.Workers()[0]
+	if status.Running || status.Err == nil || status.Name != "fila" {
+		t.Errorf("tarefa deveria constar como parada com erro: %+v", status)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("erro inesperado no encerramento: %v", err)
+	}
+}
+
+func TestServer_TLS(t *testing.T) {
+	certFile, keyFile, pool := writeCertificate(t)
+
+	config := DefaultConfig()
+	config.TLSCertFile = certFile
+	config.TLSKeyFile = keyFile
+	srv := New(discardLogger(), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Proto))
+	}), config)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	listener := listen(t)
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(ctx, listener) }()
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}}
+	resp, err := client.Get("https://" + listener.Addr().String())
+	if err != nil {
+		t.Fatalf("falha na requisição HTTPS: %v", err)
+	}
+	resp.Body.Close()
+	if resp.TLS == nil {
+		t.Error("resposta deveria vir por TLS")
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("erro inesperado no encerramento: %v", err)
+	}
+}
+
+func TestServer_InvalidCertificate(t *testing.T) {
+	config := DefaultConfig()
+	config.TLSCertFile = filepath.Join(t.TempDir(), "cert.pem")
+	config.TLSKeyFile = filepath.Join(t.TempDir(), "key.pem")
+
+	hookCalled := false
+	srv := New(discardLogger(), http.NotFoundHandler(), config)
+	srv.OnShutdown("banco", func(ctx context.Context) error {
+		hookCalled = true
+		return nil
+	})
+
+	if err := srv.Serve(context.Background(), listen(t)); err == nil {
+		t.Error("esperava erro com certificado inexistente")
+	}
+	if hookCalled {
+		t.Error("hooks não deveriam rodar quando o servidor nem iniciou")
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    Config
+		wantErr bool
+	}{
+		{
+			name: "padrões",
+			want: DefaultConfig(),
+		},
+		{
+			name: "valores definidos",
+			env: map[string]string{
+				"HTTP_ADDR":             ":9090",
+				"HTTP_READ_TIMEOUT":     "5s",
+				"HTTP_WRITE_TIMEOUT":    "10s",
+				"HTTP_IDLE_TIMEOUT":     "2m",
+				"HTTP_SHUTDOWN_TIMEOUT": "45s",
+				"HTTP_TLS_CERT_FILE":    "/certs/tls.crt",
+				"HTTP_TLS_KEY_FILE":     "/certs/tls.key",
+			},
+			want: Config{
+				Addr:            ":9090",
+				ReadTimeout:     5 * time.Second,
+				WriteTimeout:    10 * time.Second,
+				IdleTimeout:     2 * time.Minute,
+				ShutdownTimeout: 45 * time.Second,
+				TLSCertFile:     "/certs/tls.crt",
+				TLSKeyFile:      "/certs/tls.key",
+			},
+		},
+		{
+			name:    "duração inválida",
+			env:     map[string]string{"HTTP_READ_TIMEOUT": "cinco segundos"},
+			wantErr: true,
+		},
+		{
+			name:    "duração negativa",
+			env:     map[string]string{"HTTP_SHUTDOWN_TIMEOUT": "-1s"},
+			wantErr: true,
+		},
+		{
+			name:    "certificado sem chave",
+			env:     map[string]string{"HTTP_TLS_CERT_FILE": "/certs/tls.crt"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"HTTP_ADDR", "HTTP_READ_TIMEOUT", "HTTP_WRITE_TIMEOUT", "HTTP_IDLE_TIMEOUT", "HTTP_SHUTDOWN_TIMEOUT", "HTTP_TLS_CERT_FILE", "HTTP_TLS_KEY_FILE"} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			config, err := ConfigFromEnv()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("esperava erro")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if config != tt.want {
+				t.Errorf("configuração esperada %+v, obtida %+v", tt.want, config)
+			}
+		})
+	}
+}
+
+// writeCertificate grava um certificado autoassinado para 127.0.0.1 e
+// devolve os arquivos e o pool que confia nele.
+func writeCertificate(t *testing.T) (certFile, keyFile string, pool *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("falha ao gerar chave: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "scheduling-test"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("falha ao criar certificado: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("falha ao serializar chave: %v", err)
+	}
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("falha ao gravar certificado: %v", err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("falha ao gravar chave: %v", err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("falha ao ler certificado: %v", err)
+	}
+	pool = x509.NewCertPool()
+	pool.AddCert(certificate)
+	return certFile, keyFile, pool
+}
+
+func TestServer_ReadinessChecks(t *testing.T) {
+	srv := New(discardLogger(), http.NotFoundHandler(), DefaultConfig())
+	srv.Go("lembretes", func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+
+	if err := srv.CheckWorkers(context.Background()); err == nil {
+		t.Error("tarefas ainda não iniciadas deveriam falhar a verificação")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- srv.Serve(ctx, listen(t)) }()
+	waitFor(t, func() bool { return srv.CheckWorkers(context.Background()) == nil })
+
+	if err := srv.CheckServing(context.Background()); err != nil {
+		t.Errorf("servidor em execução deveria estar pronto: %v", err)
+	}
+
+	cancel()
+	<-done
+	if err := srv.CheckServing(context.Background()); err == nil {
+		t.Error("servidor encerrado não deveria estar pronto")
+	}
+	if err := srv.CheckWorkers(context.Background()); err == nil || !strings.Contains(err.Error(), "lembretes") {
+		t.Errorf("esperava a tarefa parada no erro, obtido %v", err)
+	}
+}
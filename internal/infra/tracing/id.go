@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type traceIDKey struct{}
+
+// WithTraceID faz o próximo span raiz iniciado a partir de ctx usar traceID,
+// ex.: o X-Trace-ID de um cliente que não envia traceparent.
+func WithTraceID(ctx context.Context, traceID trace.TraceID) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, traceID)
+}
+
+// ParseTraceID aceita o ID em hexadecimal (32 dígitos, como no traceparent)
+// ou no formato de UUID, usado pelo X-Trace-ID antes do tracing.
+func ParseTraceID(value string) (trace.TraceID, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if len(value) == 36 {
+		value = strings.ReplaceAll(value, "-", "")
+	}
+	traceID, err := trace.TraceIDFromHex(value)
+	if err != nil {
+		return trace.TraceID{}, false
+	}
+	return traceID, true
+}
+
+func NewTraceID() trace.TraceID {
+	var traceID trace.TraceID
+	for !traceID.IsValid() {
+		_, _ = rand.Read(traceID[:])
+	}
+	return traceID
+}
+
+func newSpanID() trace.SpanID {
+	var spanID trace.SpanID
+	for !spanID.IsValid() {
+		_, _ = rand.Read(spanID[:])
+	}
+	return spanID
+}
+
+// idGenerator gera IDs aleatórios, respeitando o trace pedido por
+// WithTraceID nos spans raiz.
+type idGenerator struct{}
+
+func (idGenerator) NewIDs(ctx context.Context) (trace.TraceID, trace.SpanID) {
+	if traceID, ok := ctx.Value(traceIDKey{}).(trace.TraceID); ok && traceID.IsValid() {
+		return traceID, newSpanID()
+	}
+	return NewTraceID(), newSpanID()
+}
+
+func (idGenerator) NewSpanID(ctx context.Context, traceID trace.TraceID) trace.SpanID {
+	return newSpanID()
+}
@@ -0,0 +1,110 @@
+// Package tracing configura o OpenTelemetry: o provedor de spans, o
+// exportador (OTLP ou stdout) e a propagação do W3C Trace Context.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// ExporterNone mantém os spans só para correlacionar logs e respostas.
+	ExporterNone = "none"
+	// ExporterOTLP envia os spans por OTLP/HTTP ao endereço de
+	// OTEL_EXPORTER_OTLP_ENDPOINT (padrão http://localhost:4318).
+	ExporterOTLP = "otlp"
+	// ExporterStdout escreve os spans no stdout, para uso local.
+	ExporterStdout = "stdout"
+
+	defaultServiceName = "scheduling"
+)
+
+type Config struct {
+	Exporter    string
+	ServiceName string
+}
+
+// ConfigFromEnv lê OTEL_TRACES_EXPORTER (none, otlp ou stdout; padrão none)
+// e OTEL_SERVICE_NAME. Endpoint, headers e amostragem seguem as variáveis
+// padrão do OpenTelemetry (OTEL_EXPORTER_OTLP_*, OTEL_TRACES_SAMPLER).
+func ConfigFromEnv() (Config, error) {
+	config := Config{Exporter: ExporterNone, ServiceName: defaultServiceName}
+
+	switch exporter := os.Getenv("OTEL_TRACES_EXPORTER"); exporter {
+	case "", ExporterNone:
+	case ExporterOTLP:
+		config.Exporter = ExporterOTLP
+	// console é o nome da especificação do OpenTelemetry
+	case ExporterStdout, "console":
+		config.Exporter = ExporterStdout
+	default:
+		return Config{}, fmt.Errorf("OTEL_TRACES_EXPORTER inválido: %q (valores aceitos: none, otlp, stdout)", exporter)
+	}
+
+	if name := os.Getenv("OTEL_SERVICE_NAME"); name != "" {
+		config.ServiceName = name
+	}
+	return config, nil
+}
+
+// Setup registra o provedor global de spans e o propagador do W3C Trace
+// Context. O provedor é instalado mesmo sem exportador, para que todo log e
+// toda resposta tenham um trace_id. A função devolvida envia os spans
+// pendentes e deve rodar no encerramento.
+func Setup(ctx context.Context, config Config) (func(ctx context.Context) error, error) {
+	provider, err := NewTracerProvider(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// NewTracerProvider cria o provedor com o exportador de config. Os spans
+// raiz usam o trace pedido por WithTraceID. options são acrescentadas por
+// último, ex.: um processador de testes.
+func NewTracerProvider(ctx context.Context, config Config, options ...sdktrace.TracerProviderOption) (*sdktrace.TracerProvider, error) {
+	res, err := resource.New(
+		ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(attribute.String("service.name", config.ServiceName)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao descrever o serviço para o tracing: %w", err)
+	}
+
+	defaults := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithIDGenerator(idGenerator{}),
+	}
+
+	switch config.Exporter {
+	case ExporterOTLP:
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao criar o exportador OTLP: %w", err)
+		}
+		defaults = append(defaults, sdktrace.WithBatcher(exporter))
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao criar o exportador stdout: %w", err)
+		}
+		// sem lote: no uso local o span aparece assim que termina
+		defaults = append(defaults, sdktrace.WithSyncer(exporter))
+	}
+
+	return sdktrace.NewTracerProvider(append(defaults, options...)...), nil
+}
@@ -0,0 +1,99 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name         string
+		exporter     string
+		serviceName  string
+		wantExporter string
+		wantService  string
+		wantErr      bool
+	}{
+		{name: "padrão", wantExporter: ExporterNone, wantService: "scheduling"},
+		{name: "otlp", exporter: "otlp", serviceName: "agenda", wantExporter: ExporterOTLP, wantService: "agenda"},
+		{name: "stdout", exporter: "stdout", wantExporter: ExporterStdout, wantService: "scheduling"},
+		{name: "console é o stdout", exporter: "console", wantExporter: ExporterStdout, wantService: "scheduling"},
+		{name: "exportador desconhecido", exporter: "jaeger", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_TRACES_EXPORTER", tt.exporter)
+			t.Setenv("OTEL_SERVICE_NAME", tt.serviceName)
+
+			config, err := ConfigFromEnv()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("erro inesperado: %v", err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if config.Exporter != tt.wantExporter || config.ServiceName != tt.wantService {
+				t.Errorf("configuração inesperada: %+v", config)
+			}
+		})
+	}
+}
+
+func TestParseTraceID(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+		ok    bool
+	}{
+		{name: "hexadecimal", value: "4bf92f3577b34da6a3ce929d0e0e4736", want: "4bf92f3577b34da6a3ce929d0e0e4736", ok: true},
+		{name: "maiúsculas", value: "4BF92F3577B34DA6A3CE929D0E0E4736", want: "4bf92f3577b34da6a3ce929d0e0e4736", ok: true},
+		{name: "uuid", value: "4bf92f35-77b3-4da6-a3ce-929d0e0e4736", want: "4bf92f3577b34da6a3ce929d0e0e4736", ok: true},
+		{name: "vazio", value: ""},
+		{name: "só zeros", value: "00000000000000000000000000000000"},
+		{name: "texto livre", value: "pedido-123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			traceID, ok := ParseTraceID(tt.value)
+			if ok != tt.ok {
+				t.Fatalf("ok esperado %v, obtido %v", tt.ok, ok)
+			}
+			if ok && traceID.String() != tt.want {
+				t.Errorf("trace esperado %s, obtido %s", tt.want, traceID)
+			}
+		})
+	}
+}
+
+func TestIDGenerator_RequestedTraceID(t *testing.T) {
+	provider, err := NewTracerProvider(context.Background(), Config{Exporter: ExporterNone, ServiceName: "scheduling"})
+	if err != nil {
+		t.Fatalf("erro ao criar o provedor: %v", err)
+	}
+	defer provider.Shutdown(context.Background())
+	tracer := provider.Tracer("test")
+
+	requested, _ := ParseTraceID("4bf92f3577b34da6a3ce929d0e0e4736")
+	ctx, root := tracer.Start(WithTraceID(context.Background(), requested), "root")
+	_, child := tracer.Start(ctx, "child")
+
+	if root.SpanContext().TraceID() != requested {
+		t.Errorf("span raiz deveria usar o trace pedido, obtido %s", root.SpanContext().TraceID())
+	}
+	if child.SpanContext().TraceID() != requested || child.SpanContext().SpanID() == root.SpanContext().SpanID() {
+		t.Errorf("span filho deveria herdar o trace com ID próprio: %+v", child.SpanContext())
+	}
+
+	_, other := tracer.Start(context.Background(), "other")
+	if !other.SpanContext().IsValid() || other.SpanContext().TraceID() == requested {
+		t.Errorf("sem pedido o trace deveria ser novo, obtido %s", other.SpanContext().TraceID())
+	}
+	if trace.SpanContextFromContext(ctx).TraceID() != requested {
+		t.Error("o trace deveria seguir no contexto")
+	}
+}
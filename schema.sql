@@ -1,10 +1,22 @@
+CREATE TABLE tenants (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(100) NOT NULL,
+    slug VARCHAR(63) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
 CREATE TABLE users (
     id INT PRIMARY KEY AUTO_INCREMENT,
     name VARCHAR(100) NOT NULL,
-    email VARCHAR(100) NOT NULL UNIQUE,
+    email VARCHAR(100) NOT NULL,
     password VARCHAR(255) NOT NULL,
     role ENUM('client', 'staff', 'admin') NOT NULL DEFAULT 'client',
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    email_verified_at DATETIME NULL,
+    locale VARCHAR(10) NOT NULL DEFAULT '',
+    tenant_id INT NOT NULL,
+    UNIQUE KEY uq_users_tenant_email (tenant_id, email),
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
 );
 
 CREATE TABLE services (
@@ -14,7 +26,39 @@ CREATE TABLE services (
     duration_minutes INT NOT NULL DEFAULT 30,
     price DECIMAL(10,2) NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    FOREIGN KEY (provider_id) REFERENCES users(id) ON DELETE CASCADE
+    tenant_id INT NOT NULL,
+    FOREIGN KEY (provider_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE locations (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(100) NOT NULL,
+    address VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tenant_id INT NOT NULL,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE location_services (
+    location_id INT NOT NULL,
+    service_id INT NOT NULL,
+    tenant_id INT NOT NULL,
+    PRIMARY KEY (location_id, service_id),
+    FOREIGN KEY (location_id) REFERENCES locations(id) ON DELETE CASCADE,
+    FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE location_travel_times (
+    from_location_id INT NOT NULL,
+    to_location_id INT NOT NULL,
+    minutes INT NOT NULL,
+    tenant_id INT NOT NULL,
+    PRIMARY KEY (from_location_id, to_location_id),
+    FOREIGN KEY (from_location_id) REFERENCES locations(id) ON DELETE CASCADE,
+    FOREIGN KEY (to_location_id) REFERENCES locations(id) ON DELETE CASCADE,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
 );
 
 CREATE TABLE appointments (
@@ -25,9 +69,13 @@ CREATE TABLE appointments (
     scheduled_at DATETIME NOT NULL,
     status ENUM('scheduled', 'completed', 'cancelled') NOT NULL DEFAULT 'scheduled',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    location_id INT NULL,
+    tenant_id INT NOT NULL,
     FOREIGN KEY (client_id) REFERENCES users(id),
     FOREIGN KEY (staff_id) REFERENCES users(id),
-    FOREIGN KEY (service_id) REFERENCES services(id)
+    FOREIGN KEY (service_id) REFERENCES services(id),
+    FOREIGN KEY (location_id) REFERENCES locations(id),
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
 );
 
 CREATE TABLE available_slots (
@@ -36,7 +84,173 @@ CREATE TABLE available_slots (
     weekday ENUM('sunday', 'monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday') NOT NULL,
     start_time TIME NOT NULL,
     end_time TIME NOT NULL,
-    FOREIGN KEY (staff_id) REFERENCES users(id)
+    location_id INT NULL,
+    tenant_id INT NOT NULL,
+    FOREIGN KEY (staff_id) REFERENCES users(id),
+    FOREIGN KEY (location_id) REFERENCES locations(id),
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE staff_breaks (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    staff_id INT NOT NULL,
+    weekday ENUM('sunday', 'monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday') NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    duration_minutes INT NOT NULL,
+    tenant_id INT NOT NULL,
+    FOREIGN KEY (staff_id) REFERENCES users(id),
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE resources (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(100) NOT NULL,
+    capacity INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tenant_id INT NOT NULL,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE service_resources (
+    service_id INT NOT NULL,
+    resource_id INT NOT NULL,
+    quantity INT NOT NULL DEFAULT 1,
+    tenant_id INT NOT NULL,
+    PRIMARY KEY (service_id, resource_id),
+    FOREIGN KEY (service_id) REFERENCES services(id) ON DELETE CASCADE,
+    FOREIGN KEY (resource_id) REFERENCES resources(id) ON DELETE CASCADE,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE appointment_resources (
+    appointment_id INT NOT NULL,
+    resource_id INT NOT NULL,
+    quantity INT NOT NULL DEFAULT 1,
+    starts_at DATETIME NOT NULL,
+    ends_at DATETIME NOT NULL,
+    tenant_id INT NOT NULL,
+    PRIMARY KEY (appointment_id, resource_id),
+    INDEX idx_appointment_resources_period (resource_id, starts_at, ends_at),
+    FOREIGN KEY (appointment_id) REFERENCES appointments(id) ON DELETE CASCADE,
+    FOREIGN KEY (resource_id) REFERENCES resources(id),
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE business_settings (
+    tenant_id INT PRIMARY KEY,
+    slot_step_minutes INT NOT NULL DEFAULT 15,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    currency CHAR(3) NOT NULL DEFAULT 'BRL',
+    cancellation_notice_minutes INT NOT NULL DEFAULT 1440,
+    booking_horizon_days INT NOT NULL DEFAULT 90,
+    branding_name VARCHAR(100) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE refresh_tokens (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    family_id CHAR(36) NOT NULL,
+    expires_at DATETIME NOT NULL,
+    revoked_at DATETIME NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tenant_id INT NOT NULL,
+    INDEX idx_refresh_tokens_family (family_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE external_identities (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    provider VARCHAR(50) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tenant_id INT NOT NULL,
+    UNIQUE (tenant_id, provider, subject),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE user_tokens (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    purpose ENUM('password_reset', 'email_verification', 'mfa_challenge') NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tenant_id INT NOT NULL,
+    INDEX idx_user_tokens_user (user_id, purpose),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE user_totp (
+    user_id INT PRIMARY KEY,
+    secret VARCHAR(64) NOT NULL,
+    enabled_at DATETIME NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tenant_id INT NOT NULL,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE recovery_codes (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    user_id INT NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    used_at DATETIME NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tenant_id INT NOT NULL,
+    INDEX idx_recovery_codes_user (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE login_attempts (
+    scope ENUM('account', 'ip') NOT NULL,
+    attempt_key VARCHAR(255) NOT NULL,
+    failures INT NOT NULL DEFAULT 0,
+    last_failure_at DATETIME NOT NULL,
+    locked_until DATETIME NULL,
+    tenant_id INT NOT NULL,
+    PRIMARY KEY (tenant_id, scope, attempt_key),
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE audit_log (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    actor_id INT NULL,
+    action VARCHAR(50) NOT NULL,
+    subject VARCHAR(255) NOT NULL DEFAULT '',
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tenant_id INT NOT NULL,
+    INDEX idx_audit_log_created (tenant_id, created_at),
+    FOREIGN KEY (actor_id) REFERENCES users(id) ON DELETE SET NULL,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
+);
+
+CREATE TABLE api_keys (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    scopes VARCHAR(255) NOT NULL,
+    created_by INT NULL,
+    expires_at DATETIME NULL,
+    last_used_at DATETIME NULL,
+    revoked_at DATETIME NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    tenant_id INT NOT NULL,
+    FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL,
+    FOREIGN KEY (tenant_id) REFERENCES tenants(id)
 );
 
 CREATE TABLE holidays (